@@ -0,0 +1,168 @@
+package keep
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/openpgp"
+)
+
+// AttachmentsDir is the hidden directory of the AccountDir where the attachments are stored.
+// The attachment f of the account finance/bank is stored in .attachments/finance/bank/f,
+// it is encrypted like the account so that gpg -d can decrypt it.
+const AttachmentsDir = ".attachments"
+
+// Attachment describes a file attached to an account.
+type Attachment struct {
+	Name  string
+	Size  int64
+	Added time.Time
+}
+
+// String returns the representation of an Attachment stored in the metadata: "<time> <size> <name>".
+func (at Attachment) String() string {
+	return formatTime(at.Added) + " " + strconv.FormatInt(at.Size, 10) + " " + at.Name
+}
+
+func parseAttachment(value string) (Attachment, error) {
+	parts := strings.SplitN(value, " ", 3)
+	if len(parts) != 3 {
+		return Attachment{}, fmt.Errorf("expected a time, a size and a name, got %q", value)
+	}
+	added, err := parseTime(parts[0])
+	if err != nil {
+		return Attachment{}, err
+	}
+	size, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return Attachment{}, err
+	}
+	return Attachment{Name: parts[2], Size: size, Added: added}, nil
+}
+
+func checkAttachmentName(name string) error {
+	if name == "" || name != filepath.Base(name) || strings.ContainsAny(name, `/\`) || isHidden(name) {
+		return fmt.Errorf("Invalid attachment name %q, it must be a file name that does not start with a dot", name)
+	}
+	return nil
+}
+
+// AttachmentPath returns the path of the encrypted file of the attachment.
+func (a *Account) AttachmentPath(name string) string {
+	return filepath.Join(a.config.AccountDir, AttachmentsDir, filepath.FromSlash(a.Name), name)
+}
+
+func (a *Account) attachmentIndex(name string) int {
+	for i, at := range a.Attachments {
+		if at.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// writeAttachment encrypts the content of the attachment to the recipients of the account.
+func (a *Account) writeAttachment(name string, content []byte) error {
+	recipients, err := a.recipients()
+	if err != nil {
+		return err
+	}
+	encrypted, err := a.encryptAttachment(name, content, recipients)
+	if err != nil {
+		return err
+	}
+	fpath := a.AttachmentPath(name)
+	err = os.MkdirAll(filepath.Dir(fpath), 0700)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fpath, encrypted, 0600)
+}
+
+// encryptAttachment returns the encrypted form of the content of the attachment for the space separated list of recipients.
+func (a *Account) encryptAttachment(name string, content []byte, recipients string) ([]byte, error) {
+	return a.config.encrypt(content, &openpgp.FileHints{IsBinary: true, FileName: name}, recipients)
+}
+
+// Attach encrypts what is read from r to the recipients of the account, replacing the attachment
+// with the same name if any, and saves the account to record it.
+func (a *Account) Attach(name string, r io.Reader) error {
+	err := checkAttachmentName(name)
+	if err != nil {
+		return err
+	}
+	err = a.config.checkAccountName(a.Name)
+	if err != nil {
+		return err
+	}
+	content, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	err = a.writeAttachment(name, content)
+	if err != nil {
+		return err
+	}
+	at := Attachment{Name: name, Size: int64(len(content)), Added: time.Now().UTC().Truncate(time.Second)}
+	if i := a.attachmentIndex(name); i >= 0 {
+		a.Attachments[i] = at
+	} else {
+		a.Attachments = append(a.Attachments, at)
+	}
+	return a.Save()
+}
+
+// ReadAttachment decrypts the attachment and verifies its signature.
+func (a *Account) ReadAttachment(name string) ([]byte, error) {
+	if a.attachmentIndex(name) < 0 {
+		return nil, fmt.Errorf("%s has no attachment named %q", a.Name, name)
+	}
+	md, err := a.config.decodeAccountFile(a.AttachmentPath(name))
+	if err != nil {
+		return nil, err
+	}
+	content, err := ioutil.ReadAll(md.UnverifiedBody)
+	if err != nil {
+		return nil, err
+	}
+	// The signature is only verified once the body has been read
+	if md.IsSigned && md.SignatureError != nil {
+		return nil, fmt.Errorf("A signature error has been detected in the attachment %s : %v", name, md.SignatureError)
+	}
+	return content, nil
+}
+
+// RemoveAttachment deletes the attachment and saves the account.
+func (a *Account) RemoveAttachment(name string) error {
+	i := a.attachmentIndex(name)
+	if i < 0 {
+		return fmt.Errorf("%s has no attachment named %q", a.Name, name)
+	}
+	err := os.Remove(a.AttachmentPath(name))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	a.Attachments = append(a.Attachments[:i], a.Attachments[i+1:]...)
+	return a.Save()
+}
+
+// reencryptAttachments encrypts the attachments again for the current recipients of the account.
+func (a *Account) reencryptAttachments() error {
+	for _, at := range a.Attachments {
+		content, err := a.ReadAttachment(at.Name)
+		if err != nil {
+			return err
+		}
+		err = a.writeAttachment(at.Name, content)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
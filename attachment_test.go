@@ -0,0 +1,61 @@
+package keep
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func Test_Account_Attach(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-attach")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = dir
+
+	content := []byte{0, 1, 2, 0xff, '\n', 'k', 'e', 'y'}
+	a := NewAccount(c, "servers/web", "root", "p", "")
+	err = a.Attach("id_rsa", bytes.NewReader(content))
+	if err != nil {
+		t.Fatal("An error occured while attaching the file", err)
+	}
+	if err := a.Attach("../id_rsa", bytes.NewReader(content)); err == nil {
+		t.Error("Expected an error for an attachment name outside of the account")
+	}
+
+	// The attachments are neither listed as accounts nor stored in clear
+	files, err := c.ListAccountFiles("")
+	if err != nil || len(files) != 1 {
+		t.Fatal("Expected only the account to be listed; got :", files, err)
+	}
+	encrypted, err := ioutil.ReadFile(a.AttachmentPath("id_rsa"))
+	if err != nil || bytes.Contains(encrypted, content) {
+		t.Fatal("Expected the attachment to be encrypted", err)
+	}
+
+	read, err := NewAccountFromFile(c, "servers/web")
+	if err != nil {
+		t.Fatal("An error occured while reading the account", err)
+	}
+	if len(read.Attachments) != 1 || read.Attachments[0].Name != "id_rsa" || read.Attachments[0].Size != int64(len(content)) {
+		t.Fatal("Expected the attachment to be listed in the account; got :", read.Attachments)
+	}
+	got, err := read.ReadAttachment("id_rsa")
+	if err != nil || !bytes.Equal(got, content) {
+		t.Errorf("got : %v %v - expected : %v", got, err, content)
+	}
+
+	err = read.RemoveAttachment("id_rsa")
+	if err != nil {
+		t.Fatal("An error occured while removing the attachment", err)
+	}
+	if _, err := os.Stat(a.AttachmentPath("id_rsa")); !os.IsNotExist(err) {
+		t.Error("Expected the attachment file to be removed")
+	}
+	if _, err := read.ReadAttachment("id_rsa"); err == nil || len(read.Attachments) != 0 {
+		t.Error("Expected the attachment to be removed from the account")
+	}
+}
@@ -0,0 +1,264 @@
+package keep
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	pgperrors "golang.org/x/crypto/openpgp/errors"
+)
+
+// AccountStrength is the strength of the password of an account.
+type AccountStrength struct {
+	Name     string
+	Strength Strength
+}
+
+// accounts decrypts and returns every account of the Config. The passphrase is requested once and
+// the accounts encrypted for other recipients, e.g. the folders of another team, are skipped.
+func (c *Config) accounts() ([]*Account, error) {
+	files, err := c.ListAccountFiles("")
+	if err != nil {
+		return nil, err
+	}
+	if !c.IsSymmetric() && c.secring == nil {
+		c.secring, err = c.EntityListWithSecretKey()
+		if err != nil {
+			return nil, err
+		}
+		defer func() { c.secring = nil }()
+	}
+	accounts := make([]*Account, 0, len(files))
+	for _, f := range files {
+		a, err := NewAccountFromFile(c, f.Name())
+		if err == pgperrors.ErrKeyIncorrect {
+			c.warnf("%s is not encrypted to a secret key of the profile, it is skipped", f.Name())
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("cannot read %s: %v", f.Name(), err)
+		}
+		accounts = append(accounts, a)
+	}
+	return accounts, nil
+}
+
+// AuditStrength decrypts every account and returns the strength of their password, the weakest first.
+func (c *Config) AuditStrength() ([]AccountStrength, error) {
+	accounts, err := c.accounts()
+	if err != nil {
+		return nil, err
+	}
+	strengths := make([]AccountStrength, 0, len(accounts))
+	for _, a := range accounts {
+		strengths = append(strengths, AccountStrength{a.Name, a.Strength()})
+	}
+	sort.SliceStable(strengths, func(i, j int) bool {
+		return strengths[i].Strength.Guesses < strengths[j].Strength.Guesses
+	})
+	return strengths, nil
+}
+
+// AccountRef identifies an account of a profile.
+type AccountRef struct {
+	Profile string
+	Name    string
+}
+
+// String returns "profile:name" or only the name if the profile is empty.
+func (r AccountRef) String() string {
+	if r.Profile == "" {
+		return r.Name
+	}
+	return r.Profile + ":" + r.Name
+}
+
+// ReuseGroup is a set of accounts sharing the same password, or similar passwords if Identical is false.
+type ReuseGroup struct {
+	Accounts  []AccountRef
+	Identical bool
+}
+
+const (
+	// maxSimilarDistance is the edit distance under which two passwords are considered similar.
+	maxSimilarDistance = 2
+	// minSimilarLength is the length under which passwords are only compared for equality.
+	minSimilarLength = 6
+)
+
+// levenshtein returns the edit distance between a and b.
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// passwordBase returns the lower cased password without its trailing digits and symbols,
+// "Summer2017!" and "summer2018" share the same base.
+func passwordBase(password string) string {
+	return strings.TrimRightFunc(strings.ToLower(password), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+}
+
+// similarPasswords returns true if a and b only differ slightly.
+func similarPasswords(a, b string) bool {
+	ra, rb := []rune(a), []rune(b)
+	if len(ra) < minSimilarLength || len(rb) < minSimilarLength {
+		return false
+	}
+	if base := passwordBase(a); len([]rune(base)) >= minSimilarLength-2 && base == passwordBase(b) {
+		return true
+	}
+	return levenshtein(ra, rb) <= maxSimilarDistance
+}
+
+// AuditReuse decrypts the accounts of every Config, indexed by profile name, and returns the groups
+// of accounts that share an identical password followed by the groups of accounts with similar passwords.
+func AuditReuse(configs map[string]*Config) ([]ReuseGroup, error) {
+	profiles := make([]string, 0, len(configs))
+	for p := range configs {
+		profiles = append(profiles, p)
+	}
+	sort.Strings(profiles)
+
+	// The accounts indexed by password, the passwords in the order they are found
+	byPassword := make(map[string][]AccountRef)
+	var passwords []string
+	for _, p := range profiles {
+		accounts, err := configs[p].accounts()
+		if err != nil {
+			return nil, fmt.Errorf("profile %s: %v", p, err)
+		}
+		for _, a := range accounts {
+			if a.Password.Len() == 0 {
+				continue
+			}
+			password := string(a.Password.Bytes())
+			if _, ok := byPassword[password]; !ok {
+				passwords = append(passwords, password)
+			}
+			byPassword[password] = append(byPassword[password], AccountRef{p, a.Name})
+		}
+	}
+
+	var groups []ReuseGroup
+	for _, password := range passwords {
+		if refs := byPassword[password]; len(refs) > 1 {
+			groups = append(groups, ReuseGroup{Accounts: refs, Identical: true})
+		}
+	}
+
+	// Cluster the distinct passwords that are similar to each other
+	cluster := make([]int, len(passwords))
+	for i := range cluster {
+		cluster[i] = i
+	}
+	var find func(i int) int
+	find = func(i int) int {
+		if cluster[i] != i {
+			cluster[i] = find(cluster[i])
+		}
+		return cluster[i]
+	}
+	for i := range passwords {
+		for j := i + 1; j < len(passwords); j++ {
+			if similarPasswords(passwords[i], passwords[j]) {
+				cluster[find(j)] = find(i)
+			}
+		}
+	}
+	members := make(map[int][]int)
+	var roots []int
+	for i := range passwords {
+		root := find(i)
+		if _, ok := members[root]; !ok {
+			roots = append(roots, root)
+		}
+		members[root] = append(members[root], i)
+	}
+	for _, root := range roots {
+		if len(members[root]) < 2 {
+			continue
+		}
+		var refs []AccountRef
+		for _, i := range members[root] {
+			refs = append(refs, byPassword[passwords[i]]...)
+		}
+		groups = append(groups, ReuseGroup{Accounts: refs})
+	}
+	return groups, nil
+}
+
+// AccountDate associates a date to an account.
+type AccountDate struct {
+	Name string
+	Date time.Time
+}
+
+// AuditAge decrypts every account and returns the ones whose password has not been changed
+// for longer than olderThan, the oldest first. The accounts whose password age is unknown,
+// the zero Date, are included.
+func (c *Config) AuditAge(olderThan time.Duration) ([]AccountDate, error) {
+	accounts, err := c.accounts()
+	if err != nil {
+		return nil, err
+	}
+	limit := time.Now().Add(-olderThan)
+	var old []AccountDate
+	for _, a := range accounts {
+		if a.PasswordChanged.Before(limit) {
+			old = append(old, AccountDate{a.Name, a.PasswordChanged})
+		}
+	}
+	sort.SliceStable(old, func(i, j int) bool {
+		return old[i].Date.Before(old[j].Date)
+	})
+	return old, nil
+}
+
+// ExpiringAccounts decrypts every account and returns the ones that expire within the given duration,
+// including the ones that have already expired. The result is sorted by expiry.
+func (c *Config) ExpiringAccounts(within time.Duration) ([]AccountDate, error) {
+	accounts, err := c.accounts()
+	if err != nil {
+		return nil, err
+	}
+	limit := time.Now().Add(within)
+	var expiring []AccountDate
+	for _, a := range accounts {
+		if isExpired(a.Expires, limit) {
+			expiring = append(expiring, AccountDate{a.Name, a.Expires})
+		}
+	}
+	sort.SliceStable(expiring, func(i, j int) bool {
+		return expiring[i].Date.Before(expiring[j].Date)
+	})
+	return expiring, nil
+}
@@ -0,0 +1,171 @@
+package keep
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+func Test_similarPasswords(t *testing.T) {
+	tests := []struct {
+		a, b    string
+		similar bool
+	}{
+		{"Summer2017!", "summer2018", true},
+		{"correcthorse", "correcthorse1", true},
+		{"kP3nQ8zR2wX5", "kP3nQ8zR2wX6", true},
+		{"kP3nQ8zR2wX5", "mZ7vB1cX9qL4", false},
+		{"abc1", "abc2", false},
+	}
+	for _, tt := range tests {
+		if got := similarPasswords(tt.a, tt.b); got != tt.similar {
+			t.Errorf("%q %q: expected %v; got : %v", tt.a, tt.b, tt.similar, got)
+		}
+	}
+}
+
+func Test_AuditReuse(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keep-reuse")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	accounts := map[string]map[string]string{
+		"personal": {"mail": "Summer2017!", "bank": "kP3nQ8zR2wX5yT7m", "forum": "mZ7vB1cX9qL4"},
+		"company":  {"vpn": "Summer2018", "wiki": "kP3nQ8zR2wX5yT7m"},
+	}
+	configs := make(map[string]*Config)
+	for profile, passwords := range accounts {
+		c := NewConfig(nil)
+		c.AccountDir = filepath.Join(dir, profile)
+		configs[profile] = c
+		for name, password := range passwords {
+			err = NewAccount(c, name, "u", password, "").Save()
+			if err != nil {
+				t.Fatal("An error occured while saving the account", err)
+			}
+		}
+	}
+
+	groups, err := AuditReuse(configs)
+	if err != nil {
+		t.Fatal("An error occured while auditing the accounts", err)
+	}
+	got := make(map[string]bool)
+	for _, g := range groups {
+		got[fmt.Sprint(g.Identical, g.Accounts)] = true
+	}
+	expected := []string{
+		"true [company:wiki personal:bank]",
+		"false [company:vpn personal:mail]",
+	}
+	if len(groups) != len(expected) {
+		t.Errorf("Expected %d groups; got : %v", len(expected), groups)
+	}
+	for _, e := range expected {
+		if !got[e] {
+			t.Errorf("Expected the group %s; got : %v", e, groups)
+		}
+	}
+}
+
+func Test_Config_AuditAge_ExpiringAccounts(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-age")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = dir
+
+	now := time.Now()
+	accounts := []*Account{
+		{config: c, Name: "old", Password: NewSecret([]byte("p")), PasswordChanged: now.Add(-200 * 24 * time.Hour)},
+		{config: c, Name: "recent", Password: NewSecret([]byte("p")), Expires: now.Add(10 * 24 * time.Hour)},
+		{config: c, Name: "expired", Password: NewSecret([]byte("p")), Expires: now.Add(-24 * time.Hour)},
+		{config: c, Name: "later", Password: NewSecret([]byte("p")), Expires: now.Add(100 * 24 * time.Hour)},
+	}
+	for _, a := range accounts {
+		if err := a.Save(); err != nil {
+			t.Fatal("An error occured while saving the account", err)
+		}
+	}
+
+	old, err := c.AuditAge(180 * 24 * time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(old) != 1 || old[0].Name != "old" {
+		t.Error("Expected only the old account; got :", old)
+	}
+	expiring, err := c.ExpiringAccounts(30 * 24 * time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(expiring) != 2 || expiring[0].Name != "expired" || expiring[1].Name != "recent" {
+		t.Error("Expected the expired and recent accounts; got :", expiring)
+	}
+}
+
+func Test_Config_accounts_OtherRecipients(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-accounts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = dir
+
+	for _, name := range []string{"mail", "bank"} {
+		if err := NewAccount(c, name, "u", "p", "n").Save(); err != nil {
+			t.Fatal("An error occured while saving the account", err)
+		}
+	}
+	// An account of another team, encrypted to a key the profile has no secret key for
+	buf := bytes.NewBuffer(nil)
+	aw, _ := armor.Encode(buf, "PGP MESSAGE", nil)
+	other := newTestEntity(t)
+	for _, i := range other.Identities {
+		// SHA-256, openpgp falls back to RIPEMD-160 which is not compiled in
+		i.SelfSignature.PreferredHash = []uint8{8}
+	}
+	w, err := openpgp.Encrypt(aw, openpgp.EntityList{other}, nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	w.Write([]byte("p\nu\nn"))
+	w.Close()
+	aw.Close()
+	if err := ioutil.WriteFile(filepath.Join(dir, "other"), buf.Bytes(), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	prompts, warnings := 0, 0
+	prompt := promptFromString(os.Getenv("GPGPASSPHRASE"))
+	c.PromptFunction = func(keys []openpgp.Key, symmetric bool) ([]byte, error) {
+		prompts++
+		return prompt(keys, symmetric)
+	}
+	c.Warnf = func(format string, a ...interface{}) { warnings++ }
+	accounts, err := c.accounts()
+	if err != nil {
+		t.Fatal("An error occured while reading the accounts", err)
+	}
+	if len(accounts) != 2 || warnings != 1 {
+		t.Errorf("Expected 2 accounts and the other one to be skipped; got : %d accounts, %d warnings", len(accounts), warnings)
+	}
+	if prompts != 1 {
+		t.Errorf("Expected the passphrase to be requested once; got : %d", prompts)
+	}
+	if c.secring != nil {
+		t.Error("Expected the decrypted keys to be dropped")
+	}
+}
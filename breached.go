@@ -0,0 +1,158 @@
+package keep
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// HIBPFile is a locally downloaded Pwned Passwords file from haveibeenpwned.com
+// in the SHA-1 ordered by hash format: one "HASH:COUNT" line per password.
+// The file is searched with a binary search so that it never has to be loaded in memory.
+type HIBPFile struct {
+	f    *os.File
+	size int64
+}
+
+// OpenHIBPFile opens a Pwned Passwords file, it must be closed after use.
+func OpenHIBPFile(path string) (*HIBPFile, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &HIBPFile{f: f, size: fi.Size()}, nil
+}
+
+// Close closes the underlying file.
+func (h *HIBPFile) Close() error {
+	return h.f.Close()
+}
+
+// Count returns the number of times the password appears in the breaches, 0 if it is not in the file.
+func (h *HIBPFile) Count(password string) (int, error) {
+	return h.countBytes([]byte(password))
+}
+
+// countBytes is Count for a password held in a Secret, it is hashed without being copied.
+func (h *HIBPFile) countBytes(password []byte) (int, error) {
+	sum := sha1.Sum(password)
+	return h.lookup(strings.ToUpper(hex.EncodeToString(sum[:])))
+}
+
+// lineAfter returns the first line starting at or after off and the offsets of its start and of the next line.
+func (h *HIBPFile) lineAfter(off int64) ([]byte, int64, int64, error) {
+	start := off
+	if off > 0 {
+		// Skip the end of the line containing off-1
+		i, err := h.indexNewline(off - 1)
+		if err != nil {
+			return nil, 0, 0, err
+		}
+		start = i + 1
+	}
+	if start >= h.size {
+		return nil, h.size, h.size, nil
+	}
+	end, err := h.indexNewline(start)
+	if err != nil {
+		return nil, 0, 0, err
+	}
+	line := make([]byte, end-start)
+	_, err = h.f.ReadAt(line, start)
+	if err != nil && err != io.EOF {
+		return nil, 0, 0, err
+	}
+	return bytes.TrimRight(line, "\r"), start, end + 1, nil
+}
+
+// indexNewline returns the offset of the first '\n' at or after off, or the size of the file if there is none.
+func (h *HIBPFile) indexNewline(off int64) (int64, error) {
+	buf := make([]byte, 128)
+	for off < h.size {
+		n, err := h.f.ReadAt(buf, off)
+		if i := bytes.IndexByte(buf[:n], '\n'); i >= 0 {
+			return off + int64(i), nil
+		}
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return 0, err
+		}
+		off += int64(n)
+	}
+	return h.size, nil
+}
+
+func (h *HIBPFile) lookup(hash string) (int, error) {
+	lo, hi := int64(0), h.size
+	for lo < hi {
+		line, start, next, err := h.lineAfter(lo + (hi-lo)/2)
+		if err != nil {
+			return 0, err
+		}
+		if start >= hi {
+			// No line starts in the second half
+			hi = lo + (hi-lo)/2
+			continue
+		}
+		parts := strings.SplitN(string(line), ":", 2)
+		switch lineHash := strings.ToUpper(parts[0]); {
+		case hash == lineHash:
+			if len(parts) != 2 {
+				return 0, fmt.Errorf("Invalid line at offset %d, expected HASH:COUNT", start)
+			}
+			count, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+			if err != nil {
+				return 0, fmt.Errorf("Invalid count at offset %d: %v", start, err)
+			}
+			return count, nil
+		case hash < lineHash:
+			hi = start
+		default:
+			lo = next
+		}
+	}
+	return 0, nil
+}
+
+// BreachedAccount is an account whose password appears in a Pwned Passwords file.
+type BreachedAccount struct {
+	Name string
+	// Count is the number of times the password has been seen in breaches.
+	Count int
+}
+
+// AuditBreached decrypts every account and returns the ones whose password
+// appears in the Pwned Passwords file, the most seen first.
+func (c *Config) AuditBreached(h *HIBPFile) ([]BreachedAccount, error) {
+	accounts, err := c.accounts()
+	if err != nil {
+		return nil, err
+	}
+	var breached []BreachedAccount
+	for _, a := range accounts {
+		count, err := h.countBytes(a.Password.Bytes())
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			breached = append(breached, BreachedAccount{a.Name, count})
+		}
+	}
+	sort.SliceStable(breached, func(i, j int) bool {
+		return breached[i].Count > breached[j].Count
+	})
+	return breached, nil
+}
@@ -0,0 +1,95 @@
+package keep
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+)
+
+// writeHIBPFile writes a Pwned Passwords file with the given passwords and a few hundred unrelated hashes.
+func writeHIBPFile(t *testing.T, dir string, counts map[string]int) string {
+	var lines []string
+	for p, count := range counts {
+		sum := sha1.Sum([]byte(p))
+		lines = append(lines, fmt.Sprintf("%s:%d", strings.ToUpper(hex.EncodeToString(sum[:])), count))
+	}
+	for i := 0; i < 500; i++ {
+		sum := sha1.Sum([]byte(fmt.Sprintf("filler-%d", i)))
+		lines = append(lines, fmt.Sprintf("%s:%d", strings.ToUpper(hex.EncodeToString(sum[:])), i+1))
+	}
+	sort.Strings(lines)
+	fpath := filepath.Join(dir, "pwned-passwords.txt")
+	err := ioutil.WriteFile(fpath, []byte(strings.Join(lines, "\r\n")), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fpath
+}
+
+func Test_HIBPFile_Count(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keep-hibp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	counts := map[string]int{"password": 3730471, "123456": 23547453, "hunter2": 17043}
+	h, err := OpenHIBPFile(writeHIBPFile(t, dir, counts))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+
+	for p, expected := range counts {
+		count, err := h.Count(p)
+		if err != nil || count != expected {
+			t.Errorf("%s: expected %d; got : %d %v", p, expected, count, err)
+		}
+	}
+	for i := 0; i < 500; i += 7 {
+		count, err := h.Count(fmt.Sprintf("filler-%d", i))
+		if err != nil || count != i+1 {
+			t.Errorf("filler-%d: expected %d; got : %d %v", i, i+1, count, err)
+		}
+	}
+	count, err := h.Count("kP3nQ8zR2wX5yT7m")
+	if err != nil || count != 0 {
+		t.Errorf("Expected an unknown password to not be found; got : %d %v", count, err)
+	}
+}
+
+func Test_Config_AuditBreached(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-breached")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = filepath.Join(dir, "passwords")
+
+	for name, password := range map[string]string{"safe": "kP3nQ8zR2wX5yT7m", "pwned": "password", "rare": "hunter2"} {
+		err = NewAccount(c, name, "u", password, "").Save()
+		if err != nil {
+			t.Fatal("An error occured while saving the account", err)
+		}
+	}
+	h, err := OpenHIBPFile(writeHIBPFile(t, dir, map[string]int{"password": 3730471, "hunter2": 17043}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer h.Close()
+
+	breached, err := c.AuditBreached(h)
+	if err != nil {
+		t.Fatal("An error occured while auditing the accounts", err)
+	}
+	expected := []BreachedAccount{{"pwned", 3730471}, {"rare", 17043}}
+	if fmt.Sprint(breached) != fmt.Sprint(expected) {
+		t.Errorf("got : %v - expected : %v", breached, expected)
+	}
+}
@@ -0,0 +1,116 @@
+// Package main provides docker-credential-keep, a docker credential helper storing
+// the registry credentials in keep accounts under the docker/ folder.
+//
+// Add "credsStore": "keep" to ~/.docker/config.json to use it, the profile is
+// chosen with the KEEP_PROFILE environment variable, the first one by default.
+// docker owns stdin and stdout, the passphrase comes from gpg-agent, GPGPASSPHRASE
+// or is requested on the controlling terminal.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/yml/keep"
+)
+
+const (
+	exitCodeOk    = 0
+	exitCodeNotOk = 1
+)
+
+const usage = `Usage: docker-credential-keep <store|get|erase|list|version>`
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, usage)
+		os.Exit(exitCodeNotOk)
+	}
+	// The protocol is spoken on stdout, the messages of keep go to stderr
+	out := os.Stdout
+	os.Stdout = os.Stderr
+
+	if os.Args[1] == "version" {
+		fmt.Fprintln(out, "docker-credential-keep (keep) 0.2")
+		os.Exit(exitCodeOk)
+	}
+	// The decrypted accounts and the passphrases must not end up in a core dump
+	if err := keep.DisableCoreDumps(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+	conf, err := loadConfig(os.Getenv("KEEP_PROFILE"))
+	if err == nil {
+		err = run(conf, os.Args[1], os.Stdin, out)
+	}
+	if err != nil {
+		// docker reads the error from stdout
+		fmt.Fprintln(out, err)
+		os.Exit(exitCodeNotOk)
+	}
+}
+
+// loadConfig returns the Config of the named profile or of the first profile if name is empty.
+func loadConfig(name string) (*keep.Config, error) {
+	store, err := keep.LoadProfileStore()
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range store {
+		if name == "" || p.Name == name {
+			return keep.NewConfig(&p), nil
+		}
+	}
+	return nil, fmt.Errorf("Profile (%s) not found", name)
+}
+
+// run executes the action of the helper, the input is read from in and the result written to out.
+func run(conf *keep.Config, action string, in io.Reader, out io.Writer) error {
+	switch action {
+	case "store":
+		cred := &keep.DockerCredential{}
+		err := json.NewDecoder(in).Decode(cred)
+		if err != nil {
+			return fmt.Errorf("An error occured while reading the credential : %v", err)
+		}
+		return conf.StoreDockerCredential(cred)
+	case "get":
+		serverURL, err := readServerURL(in)
+		if err != nil {
+			return err
+		}
+		cred, err := conf.GetDockerCredential(serverURL)
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(out).Encode(cred)
+	case "erase":
+		serverURL, err := readServerURL(in)
+		if err != nil {
+			return err
+		}
+		return conf.EraseDockerCredential(serverURL)
+	case "list":
+		registries, err := conf.ListDockerCredentials()
+		if err != nil {
+			return err
+		}
+		return json.NewEncoder(out).Encode(registries)
+	}
+	return fmt.Errorf("Unknown action %q, %s", action, usage)
+}
+
+func readServerURL(in io.Reader) (string, error) {
+	b, err := ioutil.ReadAll(in)
+	if err != nil {
+		return "", err
+	}
+	serverURL := strings.TrimSpace(string(b))
+	if serverURL == "" {
+		return "", fmt.Errorf("No server URL given")
+	}
+	return serverURL, nil
+}
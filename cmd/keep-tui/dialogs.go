@@ -0,0 +1,162 @@
+package main
+
+import (
+	tui "github.com/marcusolsson/tui-go"
+)
+
+// dialog is a view asking something to the user, the view it has been opened from is shown again once it is closed.
+type dialog struct {
+	*view
+	root    *views
+	message *tui.Label
+	// back is the view shown when the dialog is closed, focused is its widget focused again.
+	back    *view
+	focused tui.Widget
+}
+
+func newDialog(root *views, title string, body tui.Widget, focusables ...tui.Widget) *dialog {
+	d := &dialog{root: root, message: tui.NewLabel("")}
+	d.message.SetWordWrap(true)
+	box := tui.NewVBox(d.message, body, tui.NewSpacer())
+	box.SetTitle(title)
+	box.SetBorder(true)
+	box.SetSizePolicy(tui.Expanding, tui.Expanding)
+	d.view = newView(box, focusables...)
+	d.cancel = d.close
+	return d
+}
+
+// open shows the dialog with the message.
+func (d *dialog) open(message string) {
+	d.message.SetText(message)
+	if d.root.view != d.view {
+		d.back, d.focused = d.root.view, nil
+		for _, w := range d.back.focusables {
+			if w.IsFocused() {
+				d.focused = w
+			}
+		}
+	}
+	d.root.Show(d.view)
+}
+
+// close shows the view the dialog has been opened from, with the focus where it was.
+func (d *dialog) close() {
+	d.root.Show(d.back)
+	if d.focused != nil {
+		d.root.Focus(d.focused)
+	}
+}
+
+// confirmDialog is the view asking the user to confirm an action.
+type confirmDialog struct {
+	*dialog
+	onYes func()
+}
+
+func newConfirmDialog(root *views) *confirmDialog {
+	d := &confirmDialog{}
+	noBtn := tui.NewButton("[ No ]")
+	noBtn.OnActivated(func(b *tui.Button) { d.close() })
+	yesBtn := tui.NewButton("[ Yes ]")
+	yesBtn.OnActivated(func(b *tui.Button) {
+		d.close()
+		d.onYes()
+	})
+	// No is focused first so that Enter does not confirm by accident
+	d.dialog = newDialog(root, "Confirm", tui.NewHBox(noBtn, yesBtn, tui.NewSpacer()), noBtn, yesBtn)
+	return d
+}
+
+// Ask shows the question, onYes is run if the user confirms.
+func (d *confirmDialog) Ask(message string, onYes func()) {
+	d.onYes = onYes
+	d.open(message)
+}
+
+// choiceDialog is the view asking the user to choose an item of a list.
+type choiceDialog struct {
+	*dialog
+	list     *tui.List
+	onChosen func(i int)
+}
+
+func newChoiceDialog(root *views, title string) *choiceDialog {
+	d := &choiceDialog{list: tui.NewList()}
+	d.list.OnItemActivated(func(l *tui.List) {
+		d.close()
+		d.onChosen(l.Selected())
+	})
+	cancelBtn := tui.NewButton("[ Cancel ]")
+	cancelBtn.OnActivated(func(b *tui.Button) { d.close() })
+	body := tui.NewVBox(d.list, tui.NewHBox(cancelBtn, tui.NewSpacer()))
+	d.dialog = newDialog(root, title, body, d.list, cancelBtn)
+	return d
+}
+
+// Ask shows the items with the given one selected, onChosen is run with the index of the item chosen with Enter.
+func (d *choiceDialog) Ask(message string, items []string, selected int, onChosen func(i int)) {
+	d.list.RemoveItems()
+	d.list.AddItems(items...)
+	d.list.SetSelected(selected)
+	d.onChosen = onChosen
+	d.open(message)
+}
+
+// messageDialog is the view reporting an error to the user.
+type messageDialog struct {
+	*dialog
+}
+
+func newMessageDialog(root *views) *messageDialog {
+	d := &messageDialog{}
+	okBtn := tui.NewButton("[ OK ]")
+	okBtn.OnActivated(func(b *tui.Button) { d.close() })
+	d.dialog = newDialog(root, "Error", tui.NewHBox(okBtn, tui.NewSpacer()), okBtn)
+	return d
+}
+
+// Show shows the message until the user acknowledges it.
+func (d *messageDialog) Show(message string) {
+	d.open(message)
+}
+
+// passphraseDialog is the view requesting the passphrase.
+type passphraseDialog struct {
+	*dialog
+	entry    *passwordEntry
+	onUnlock func(passphrase string)
+	onCancel func()
+}
+
+func newPassphraseDialog(root *views) *passphraseDialog {
+	d := &passphraseDialog{entry: newPasswordEntry()}
+	unlock := func() {
+		passphrase := d.entry.Text()
+		d.entry.SetText("")
+		d.close()
+		d.onUnlock(passphrase)
+	}
+	d.entry.OnSubmit(func(e *tui.Entry) { unlock() })
+	unlockBtn := tui.NewButton("[ Unlock ]")
+	unlockBtn.OnActivated(func(b *tui.Button) { unlock() })
+	cancelBtn := tui.NewButton("[ Cancel ]")
+	cancelBtn.OnActivated(func(b *tui.Button) { d.cancel() })
+	body := tui.NewVBox(d.entry, tui.NewHBox(unlockBtn, cancelBtn, tui.NewSpacer()))
+	d.dialog = newDialog(root, "Passphrase", body, d.entry, unlockBtn, cancelBtn)
+	d.cancel = func() {
+		d.entry.SetText("")
+		d.close()
+		if d.onCancel != nil {
+			d.onCancel()
+		}
+	}
+	return d
+}
+
+// Ask requests the passphrase with the message, onUnlock is run with the passphrase entered
+// and onCancel, unless nil, when the dialog is canceled.
+func (d *passphraseDialog) Ask(message string, onUnlock func(passphrase string), onCancel func()) {
+	d.onUnlock, d.onCancel = onUnlock, onCancel
+	d.open(message)
+}
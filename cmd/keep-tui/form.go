@@ -0,0 +1,213 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	tui "github.com/marcusolsson/tui-go"
+	"github.com/yml/keep"
+)
+
+// generatedPasswordLength is the length of the passwords of the Generate button.
+const generatedPasswordLength = 20
+
+// accountForm is the view used to create an account or to edit the selected one.
+type accountForm struct {
+	*view
+	conf *keep.Config
+	// account is the account being edited, nil for a new one.
+	account *keep.Account
+
+	box           *tui.Box
+	nameEntry     *tui.Entry
+	usernameEntry *tui.Entry
+	passwordEntry *passwordEntry
+	showBtn       *tui.Button
+	passwordHint  *tui.Label
+	notesEntry    *tui.Entry
+	strengthBar   *tui.Progress
+	strengthLabel *tui.Label
+	// fields are the focusable widgets of the form, the name comes first.
+	fields []tui.Widget
+
+	// onSaved is called with the account once it has been written, onCanceled when the form is left.
+	onSaved    func(a *keep.Account)
+	onCanceled func()
+	// onError reports the problems preventing the account to be saved.
+	onError func(msg string)
+	// run runs the actions needing the passphrase and reports their errors.
+	run func(desc string, action func() error)
+}
+
+func newAccountForm(conf *keep.Config) *accountForm {
+	f := &accountForm{
+		conf:          conf,
+		nameEntry:     tui.NewEntry(),
+		usernameEntry: tui.NewEntry(),
+		passwordEntry: newPasswordEntry(),
+		showBtn:       tui.NewButton("[ show ]"),
+		passwordHint:  tui.NewLabel(""),
+		notesEntry:    tui.NewEntry(),
+		strengthBar:   tui.NewProgress(4),
+		strengthLabel: tui.NewLabel(""),
+	}
+	f.strengthLabel.SetWordWrap(true)
+	for _, e := range []*tui.Entry{f.nameEntry, f.usernameEntry, f.notesEntry} {
+		e.SetSizePolicy(tui.Expanding, tui.Maximum)
+	}
+	f.passwordEntry.OnChanged(func(e *tui.Entry) { f.updateStrength() })
+	f.usernameEntry.OnChanged(func(e *tui.Entry) { f.updateStrength() })
+
+	// The password is masked, the show button reveals it while it is typed
+	f.showBtn.OnActivated(func(b *tui.Button) { f.passwordEntry.revealed = !f.passwordEntry.revealed })
+	generateBtn := tui.NewButton("[ Generate ]")
+	generateBtn.OnActivated(func(b *tui.Button) {
+		password, err := keep.NewPassword(generatedPasswordLength)
+		if err != nil {
+			f.onError(fmt.Sprintf("Error: Could not generate a password : %s", err))
+			return
+		}
+		f.passwordEntry.SetText(string(password))
+		f.updateStrength()
+	})
+	saveBtn := tui.NewButton("[ Save ]")
+	saveBtn.OnActivated(func(b *tui.Button) { f.save() })
+	cancelBtn := tui.NewButton("[ Cancel ]")
+	cancelBtn.OnActivated(func(b *tui.Button) { f.onCanceled() })
+
+	row := func(label string, w ...tui.Widget) *tui.Box {
+		return tui.NewHBox(append([]tui.Widget{tui.NewLabel(fmt.Sprintf("%-10s: ", label))}, w...)...)
+	}
+	f.box = tui.NewVBox(
+		row("Name", f.nameEntry),
+		row("Username", f.usernameEntry),
+		row("Password", f.passwordEntry, f.showBtn, generateBtn),
+		f.passwordHint,
+		row("Strength", f.strengthBar),
+		f.strengthLabel,
+		row("Notes", f.notesEntry),
+		tui.NewHBox(saveBtn, cancelBtn, tui.NewSpacer()),
+		tui.NewSpacer(),
+	)
+	f.box.SetBorder(true)
+	f.box.SetSizePolicy(tui.Expanding, tui.Expanding)
+	f.fields = []tui.Widget{f.nameEntry, f.usernameEntry, f.passwordEntry, f.showBtn, generateBtn, f.notesEntry, saveBtn, cancelBtn}
+	f.view = newView(f.box, f.fields...)
+	f.view.cancel = func() { f.onCanceled() }
+	return f
+}
+
+// New resets the form to create an account.
+func (f *accountForm) New() {
+	f.account = nil
+	f.box.SetTitle("New account")
+	f.fill("", "", "")
+	f.passwordHint.SetText("")
+}
+
+// Edit fills the form with the account, its name cannot be changed and its password is
+// only replaced if a new one is entered. The accounts whose notes are written on several
+// lines cannot be edited, the entry of the notes holds a single line.
+func (f *accountForm) Edit(a *keep.Account) error {
+	if strings.Contains(a.Notes, "\n") {
+		return fmt.Errorf("The notes of %s are written on several lines, use keep edit to change it", a.Name)
+	}
+	f.account = a
+	f.box.SetTitle("Edit account")
+	f.fill(a.Name, a.Username, a.Notes)
+	f.passwordHint.SetText("Leave the password empty to keep the current one")
+	return nil
+}
+
+func (f *accountForm) fill(name, username, notes string) {
+	f.nameEntry.SetText(name)
+	f.usernameEntry.SetText(username)
+	f.passwordEntry.SetText("")
+	f.passwordEntry.revealed = false
+	f.notesEntry.SetText(notes)
+	f.updateStrength()
+	// The name of an edited account is shown but cannot be focused, hence changed
+	f.view.focusables = f.fields
+	if f.account != nil {
+		f.view.focusables = f.fields[1:]
+	}
+}
+
+// values returns the account described by the form, the fields of an edited account are updated.
+// An edited account shares the password of f.account until a new one is entered.
+func (f *accountForm) values() *keep.Account {
+	username := strings.TrimSpace(f.usernameEntry.Text())
+	password := f.passwordEntry.Text()
+	notes := strings.TrimSpace(f.notesEntry.Text())
+	if f.account == nil {
+		return keep.NewAccount(f.conf, f.nameEntry.Text(), username, password, notes)
+	}
+	a := *f.account
+	a.Username, a.Notes = username, notes
+	if password != "" {
+		a.Password = keep.NewSecret([]byte(password))
+	}
+	return &a
+}
+
+// discard wipes the password entered for an account returned by values that is not saved.
+func (f *accountForm) discard(a *keep.Account) {
+	if f.account == nil || a.Password != f.account.Password {
+		a.Password.Wipe()
+	}
+}
+
+func (f *accountForm) updateStrength() {
+	password := f.passwordEntry.Text()
+	if f.account != nil && password == "" {
+		f.strengthBar.SetCurrent(0)
+		f.strengthLabel.SetText("")
+		return
+	}
+	// Like Account.Strength, without a Secret for every key typed
+	username := strings.TrimSpace(f.usernameEntry.Text())
+	s := keep.EstimateStrength(password, append(strings.Split(f.nameEntry.Text(), "/"), username)...)
+	f.strengthBar.SetCurrent(s.Score)
+	text := s.String()
+	if s.Warning != "" {
+		text += " - " + s.Warning
+	}
+	f.strengthLabel.SetText(text)
+}
+
+// save writes the account through the library, the new accounts must not exist yet.
+func (f *accountForm) save() {
+	a := f.values()
+	if a.Name == "" {
+		f.discard(a)
+		f.onError("Error: The account name cannot be empty")
+		return
+	}
+	if a.Password.Len() == 0 {
+		f.onError("Error: The password cannot be empty")
+		return
+	}
+	if f.account == nil {
+		if _, err := os.Stat(a.Path()); err == nil {
+			f.discard(a)
+			f.onError(fmt.Sprintf("Error: The account %s already exists", a.Name))
+			return
+		}
+	}
+	if f.account == nil || !a.Password.Equal(f.account.Password) {
+		if _, err := a.CheckStrength(); err != nil {
+			f.discard(a)
+			f.onError(fmt.Sprintf("Error: %s", err))
+			return
+		}
+	}
+	f.run("saving the account", func() error {
+		if err := a.Save(); err != nil {
+			f.discard(a)
+			return err
+		}
+		f.onSaved(a)
+		return nil
+	})
+}
@@ -0,0 +1,57 @@
+package main
+
+import (
+	"sort"
+	"strings"
+)
+
+// wordSeparators are the characters after which a match starts a word of an account name.
+const wordSeparators = "/.-_@ "
+
+// fuzzyScore returns how well name matches the pattern, higher is better, and false when the
+// characters of the pattern do not appear in name in the same order. The case is ignored, the
+// consecutive characters and the characters starting a word score more than the scattered ones.
+func fuzzyScore(pattern, name string) (int, bool) {
+	p := []rune(strings.ToLower(pattern))
+	n := []rune(strings.ToLower(name))
+	score, j, prev := 0, 0, -2
+	for i := 0; i < len(n) && j < len(p); i++ {
+		if n[i] != p[j] {
+			continue
+		}
+		switch {
+		case i == prev+1:
+			score += 5
+		case i == 0 || strings.ContainsRune(wordSeparators, n[i-1]):
+			score += 3
+		default:
+			score++
+		}
+		prev = i
+		j++
+	}
+	return score, j == len(p)
+}
+
+// fuzzyFilter returns the names matching the pattern, the best matches first then the shortest names.
+// The names are returned in their order when the pattern is empty.
+func fuzzyFilter(pattern string, names []string) []string {
+	if pattern == "" {
+		return names
+	}
+	var matches []string
+	scores := make(map[string]int)
+	for _, name := range names {
+		if score, ok := fuzzyScore(pattern, name); ok {
+			matches = append(matches, name)
+			scores[name] = score
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		if scores[matches[i]] != scores[matches[j]] {
+			return scores[matches[i]] > scores[matches[j]]
+		}
+		return len(matches[i]) < len(matches[j])
+	})
+	return matches
+}
@@ -0,0 +1,25 @@
+package main
+
+import "testing"
+
+var fuzzyScoreCases = []struct {
+	pattern string
+	name    string
+	score   int
+	ok      bool
+}{
+	{"abc", "abc", 3 + 5 + 5, true},
+	{"ac", "a-c", 3 + 3, true},
+	{"ac", "abc", 3 + 1, true},
+	{"ca", "abc", 0, false},
+	{"", "abc", 0, true},
+}
+
+func Test_fuzzyScore(t *testing.T) {
+	for _, c := range fuzzyScoreCases {
+		score, ok := fuzzyScore(c.pattern, c.name)
+		if ok != c.ok || ok && score != c.score {
+			t.Errorf("%q in %q: got %d %v -- expected %d %v", c.pattern, c.name, score, ok, c.score, c.ok)
+		}
+	}
+}
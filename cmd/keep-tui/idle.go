@@ -0,0 +1,63 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// idleTimer reports when no key has been pressed for its timeout.
+// The keys are recorded by the interface while the timer is watched from another goroutine.
+type idleTimer struct {
+	timeout time.Duration
+
+	mu     sync.Mutex
+	last   time.Time
+	paused bool
+}
+
+func newIdleTimer(timeout time.Duration) *idleTimer {
+	return &idleTimer{timeout: timeout, last: time.Now()}
+}
+
+// Touch records that a key has been pressed.
+func (t *idleTimer) Touch() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.last = time.Now()
+}
+
+// SetPaused stops the timer from expiring, e.g. while the interface is locked, or restarts it.
+func (t *idleTimer) SetPaused(paused bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.paused = paused
+	t.last = time.Now()
+}
+
+// expired returns true if the timer runs and no key has been pressed for the timeout.
+func (t *idleTimer) expired() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return !t.paused && time.Since(t.last) >= t.timeout
+}
+
+// Watch calls onExpired once the timer has expired, unless done is closed first.
+// A timeout of zero disables the timer.
+func (t *idleTimer) Watch(done <-chan struct{}, onExpired func()) {
+	if t.timeout <= 0 {
+		return
+	}
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+			if t.expired() {
+				onExpired()
+				return
+			}
+		}
+	}
+}
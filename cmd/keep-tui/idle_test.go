@@ -0,0 +1,55 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_idleTimer_PauseResume(t *testing.T) {
+	timer := newIdleTimer(20 * time.Millisecond)
+	if timer.expired() {
+		t.Fatal("Expected a new timer not to be expired")
+	}
+	time.Sleep(30 * time.Millisecond)
+	if !timer.expired() {
+		t.Fatal("Expected the timer to expire without a key pressed")
+	}
+	timer.Touch()
+	if timer.expired() {
+		t.Fatal("Expected a key pressed to restart the timer")
+	}
+
+	// A paused timer never expires, it restarts when it is resumed
+	timer.SetPaused(true)
+	time.Sleep(30 * time.Millisecond)
+	if timer.expired() {
+		t.Fatal("Expected a paused timer not to expire")
+	}
+	timer.SetPaused(false)
+	if timer.expired() {
+		t.Fatal("Expected a resumed timer to restart")
+	}
+	time.Sleep(30 * time.Millisecond)
+	if !timer.expired() {
+		t.Fatal("Expected a resumed timer to expire without a key pressed")
+	}
+}
+
+func Test_idleTimer_Watch(t *testing.T) {
+	// A timeout of zero disables the timer
+	returned := make(chan struct{})
+	go func() {
+		newIdleTimer(0).Watch(nil, func() { t.Error("Expected a disabled timer not to expire") })
+		close(returned)
+	}()
+	select {
+	case <-returned:
+	case <-time.After(time.Second):
+		t.Fatal("Expected Watch to return for a disabled timer")
+	}
+
+	// Watch returns without expiring once done is closed
+	done := make(chan struct{})
+	close(done)
+	newIdleTimer(time.Hour).Watch(done, func() { t.Error("Expected the timer not to expire once done is closed") })
+}
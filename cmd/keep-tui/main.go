@@ -4,6 +4,8 @@ package main
 import (
 	"fmt"
 	"os"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/atotto/clipboard"
@@ -14,15 +16,39 @@ import (
 
 var (
 	filter      = ""
-	currentAcct = &keep.Account{}
+	currentAcct *keep.Account
+	// accounts are the accounts of the profile, or of every profile, they are filtered as the filter is typed.
+	accounts []accountEntry
+	// shown are the accounts of the list, in its order.
+	shown []accountEntry
 )
 
+// allProfilesItem is the item of the profile list showing the accounts of every profile.
+const allProfilesItem = "All profiles"
+
+// accountEntry is an account of the list along with the index of its profile in the store.
+type accountEntry struct {
+	profile int
+	name    string
+}
+
 const (
 	exitCodeOk     = 0
 	exitCodeNotOk  = 1
 	hiddenPassword = "*************"
 )
 
+// The shortcuts of the list, tui-go passes the control keys to the widgets as runes.
+const (
+	keyCtrlF = '\x06'
+	keyCtrlO = '\x0f'
+	keyCtrlP = '\x10'
+	keyCtrlR = '\x12'
+	keyCtrlU = '\x15'
+)
+
+const helpText = "^F filter  ^U copy username  ^P copy password  ^R show/hide  ^O next profile  Tab focus  Esc quit"
+
 func main() {
 
 	usage := `keep-ui is a terminal user interface for keep
@@ -31,6 +57,7 @@ Usage:
 
 Options:
 	-p --profile=NAME      Profile name
+	--lock-after=DURATION  Lock the interface after this time without a key pressed, 0 disables it [default: 5m]
 `
 
 	args, err := docopt.Parse(usage, nil, true, "keep cli version: 0.2", false)
@@ -39,19 +66,31 @@ Options:
 		os.Exit(exitCodeNotOk)
 	}
 
+	// The decrypted accounts and the passphrases must not end up in a core dump
+	if err := keep.DisableCoreDumps(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+
 	store, err := keep.LoadProfileStore()
 	if err != nil {
-		panic(err)
+		fmt.Println("An error occured while loading the profile store", err)
+		os.Exit(exitCodeNotOk)
+	}
+
+	lockAfter, err := keep.ParseDuration(args["--lock-after"].(string))
+	if err != nil {
+		fmt.Println("An error occured while parsing --lock-after", err)
+		os.Exit(exitCodeNotOk)
 	}
 
 	// defaulting to the first profile
-	profile := store[0]
+	profileIndex := 0
 	profileName, ok := args["--profile"].(string)
 	if ok {
 		profileFound := false
-		for _, p := range store {
+		for i, p := range store {
 			if profileName == p.Name {
-				profile = p
+				profileIndex = i
 				profileFound = true
 				break
 			}
@@ -67,39 +106,125 @@ Options:
 	statusBox.SetTitle("Status")
 	statusBox.SetBorder(true)
 
-	statusBar.SetText(fmt.Sprintf("Using profile : %s", profile.Name))
+	statusBar.SetText(fmt.Sprintf("Using profile : %s", store[profileIndex].Name))
 
-	conf := keep.NewConfig(&profile)
+	agent := useGpgAgent()
+	// Each profile has its own passphrase, hence its own prompt
+	prompts := make([]*passphrasePrompt, len(store))
+	// The configurations are created before the interface starts, the library prints on the terminal
+	configs := make([]*keep.Config, len(store))
+	for i := range store {
+		configs[i] = keep.NewConfig(&store[i])
+		// The warnings of the library are shown in the status bar instead of being printed below the interface
+		configs[i].Warnf = func(format string, a ...interface{}) {
+			statusBar.SetText("Warning: " + fmt.Sprintf(format, a...))
+		}
+		prompts[i] = newPassphrasePrompt()
+		if !agent {
+			configs[i].PromptFunction = prompts[i].PromptFunction
+		}
+	}
+	// conf is the configuration of the profile, in the "all profiles" mode the one of the selected account.
+	conf := configs[profileIndex]
+	confIndex := profileIndex
+	allProfiles := false
+
+	idle := newIdleTimer(lockAfter)
+	root := &views{onKey: idle.Touch}
+	messages := newMessageDialog(root)
+	passphrase := newPassphraseDialog(root)
+	passphraseMessage := func() string {
+		if agent {
+			return "Press Enter to unlock it with gpg-agent"
+		}
+		if conf.IsSymmetric() {
+			return fmt.Sprintf("Passphrase to unlock the vault of the profile %s", store[confIndex].Name)
+		}
+		return fmt.Sprintf("Passphrase to unlock your key of the profile %s", store[confIndex].Name)
+	}
+	// run runs the action and reports its error. When the passphrase is needed, it is
+	// requested with the passphrase dialog and the action is run again once entered.
+	var run func(desc string, action func() error)
+	run = func(desc string, action func() error) {
+		err := action()
+		if err == nil {
+			return
+		}
+		if err == errLocked || (err == keep.ErrWrongPassphrase && !agent) {
+			// The passphrase is the one of the profile used by the action
+			prompt := prompts[confIndex]
+			msg := passphraseMessage()
+			if err == keep.ErrWrongPassphrase {
+				prompt.Clear()
+				msg = "Wrong passphrase. " + msg
+			}
+			passphrase.Ask(msg, func(s string) {
+				prompt.Set(s)
+				run(desc, action)
+			}, nil)
+			return
+		}
+		messages.Show(fmt.Sprintf("An error occured while %s : %s", desc, err))
+	}
 
 	// Setting up the interface
 	usernameLabel := tui.NewLabel("")
 	notesLabel := tui.NewLabel("")
 	notesLabel.SetWordWrap(true)
 	passwordLabel := tui.NewLabel("")
+	historyLabel := tui.NewLabel("")
 
 	showPasswordState := false
-	showPasswordBtn := tui.NewButton("[ show ]")
-	showPasswordBtn.OnActivated(func(b *tui.Button) {
+	showHistoryState := false
+	togglePassword := func() {
+		if currentAcct == nil {
+			statusBar.SetText("No account selected")
+			return
+		}
 		if showPasswordState {
 			passwordLabel.SetText(hiddenPassword)
 			showPasswordState = false
 		} else {
-			passwordLabel.SetText(currentAcct.Password)
+			passwordLabel.SetText(string(currentAcct.Password.Bytes()))
 			showPasswordState = true
 		}
+		if showHistoryState {
+			historyLabel.SetText(historyText(currentAcct, showPasswordState))
+		}
+	}
+	showPasswordBtn := tui.NewButton("[ show ]")
+	showPasswordBtn.OnActivated(func(b *tui.Button) { togglePassword() })
+
+	historyBtn := tui.NewButton("[ History ]")
+	historyBtn.OnActivated(func(b *tui.Button) {
+		if currentAcct == nil {
+			statusBar.SetText("No account selected")
+			return
+		}
+		showHistoryState = !showHistoryState
+		if showHistoryState {
+			historyLabel.SetText(historyText(currentAcct, showPasswordState))
+		} else {
+			historyLabel.SetText("")
+		}
 	})
 
-	copyPasswordBtn := tui.NewButton("[ Copy ]")
-	copyPasswordBtn.OnActivated(func(b *tui.Button) {
+	copyPassword := func() {
+		if currentAcct == nil {
+			statusBar.SetText("No account selected")
+			return
+		}
 		// Grab the original clipboard value before changing it
 		originalClipboard, err := clipboard.ReadAll()
 		if err != nil {
 			statusBar.SetText(fmt.Sprintf("Error: Could not copy from clipboard : %s", err))
 		}
-		err = clipboard.WriteAll(currentAcct.Password)
+		err = clipboard.WriteAll(string(currentAcct.Password.Bytes()))
 		if err != nil {
 			statusBar.SetText(fmt.Sprintf("Error: Could not paste to clipboard : %s", err))
+			return
 		}
+		statusBar.SetText("Password copied to the clipboard for 15s : " + currentAcct.Name)
 		go func(s string) {
 			time.Sleep(15 * time.Second)
 			err = clipboard.WriteAll(s)
@@ -107,87 +232,430 @@ Options:
 				statusBar.SetText(fmt.Sprintf("Error: Could not restore the clipboard: %s", err))
 			}
 		}(originalClipboard)
-	})
+	}
+	copyPasswordBtn := tui.NewButton("[ Copy ]")
+	copyPasswordBtn.OnActivated(func(b *tui.Button) { copyPassword() })
+
+	copyUsername := func() {
+		if currentAcct == nil {
+			statusBar.SetText("No account selected")
+			return
+		}
+		if err := clipboard.WriteAll(currentAcct.Username); err != nil {
+			statusBar.SetText(fmt.Sprintf("Error: Could not paste to clipboard : %s", err))
+			return
+		}
+		statusBar.SetText("Username copied to the clipboard : " + currentAcct.Name)
+	}
 
 	usernameBox := tui.NewVBox(usernameLabel)
 	notesBox := tui.NewVBox(notesLabel)
-	passwordBox := tui.NewHBox(passwordLabel, showPasswordBtn, copyPasswordBtn)
+	passwordBox := tui.NewHBox(passwordLabel, showPasswordBtn, copyPasswordBtn, historyBtn)
+	historyBox := tui.NewVBox(historyLabel)
 
-	accountDetailBox := tui.NewVBox(usernameBox, notesBox, passwordBox)
+	accountDetailBox := tui.NewVBox(usernameBox, notesBox, passwordBox, historyBox)
 	accountDetailBox.SetTitle("Account details")
 	accountDetailBox.SetBorder(true)
 	accountDetailBox.SetSizePolicy(tui.Preferred, tui.Preferred)
 
+	form := newAccountForm(conf)
+	useConfig := func(i int) {
+		conf, confIndex = configs[i], i
+		form.conf = conf
+	}
+
+	// clearAccount forgets the decrypted account and hides its details.
+	clearAccount := func() {
+		if currentAcct != nil {
+			currentAcct.Wipe()
+		}
+		currentAcct = nil
+		usernameLabel.SetText("")
+		notesLabel.SetText("")
+		passwordLabel.SetText("")
+		showPasswordState = false
+		historyLabel.SetText("")
+		showHistoryState = false
+	}
+
 	accountList := tui.NewList()
-	accountList.OnSelectionChanged(func(l *tui.List) {
-		if accountList.Length() > 0 {
-			fname := accountList.SelectedItem()
-			currentAcct = getAccount(conf, fname)
-			usernameLabel.SetText(currentAcct.Name)
+	// selectedEntry returns the account selected in the list, ok is false if there is none.
+	selectedEntry := func() (accountEntry, bool) {
+		if accountList.Length() == 0 || accountList.Selected() < 0 {
+			return accountEntry{}, false
+		}
+		e := shown[accountList.Selected()]
+		useConfig(e.profile)
+		statusBar.SetPermanentText(profileText(store[e.profile].Name, conf, e.name))
+		return e, true
+	}
+	// currentEntry is the account of currentAcct, it is not decrypted again while it stays selected.
+	var currentEntry accountEntry
+	// previewAccount shows the name of the selected account without decrypting it, the filter
+	// uses it so that the accounts are not decrypted, nor the passphrase requested, as it is typed.
+	previewAccount := func() {
+		e, ok := selectedEntry()
+		if !ok || (currentAcct != nil && e == currentEntry) {
+			return
+		}
+		clearAccount()
+		usernameLabel.SetText(e.name)
+		notesLabel.SetText("Press Enter to show the account")
+	}
+	showAccount := func() {
+		e, ok := selectedEntry()
+		if !ok || (currentAcct != nil && e == currentEntry) {
+			return
+		}
+		fname := e.name
+		clearAccount()
+		usernameLabel.SetText(fname)
+		run("getting the account "+fname, func() error {
+			account, err := keep.NewAccountFromFile(conf, fname)
+			if err != nil {
+				return err
+			}
+			currentAcct, currentEntry = account, e
 			notesLabel.SetText(currentAcct.Notes)
 			passwordLabel.SetText(hiddenPassword)
+			return nil
+		})
+	}
+	accountList.OnSelectionChanged(func(l *tui.List) { showAccount() })
+	accountList.OnItemActivated(func(l *tui.List) { showAccount() })
+
+	// applyFilter lists the accounts matching the filter and selects the given one, or the first one.
+	applyFilter := func(selected accountEntry) {
+		accountList.RemoveItems()
+		shown = filterAccounts(filter, accounts)
+		if len(shown) == 0 {
+			clearAccount()
+			if allProfiles {
+				statusBar.SetPermanentText(allProfilesItem)
+			} else {
+				statusBar.SetPermanentText(profileText(store[profileIndex].Name, conf, ""))
+			}
+			statusBar.SetText("No account matching: " + filter)
+			return
 		}
-	})
+		for i, e := range shown {
+			if allProfiles {
+				accountList.AddItems(fmt.Sprintf("%s: %s", store[e.profile].Name, e.name))
+			} else {
+				accountList.AddItems(e.name)
+			}
+			if e == selected {
+				accountList.SetSelected(i)
+			}
+		}
+		if accountList.Selected() < 0 {
+			accountList.SetSelected(0)
+		}
+		previewAccount()
+	}
+
+	// refreshList reads the accounts of the profile, or of every profile, again then applies the filter.
+	refreshList := func(selected accountEntry) {
+		run("listing the accounts", func() error {
+			var firstErr error
+			accounts = nil
+			for i := range configs {
+				if !allProfiles && i != profileIndex {
+					continue
+				}
+				names, err := fetchAccounts(configs[i])
+				if err != nil && firstErr == nil {
+					firstErr = fmt.Errorf("%s : %s", store[i].Name, err)
+				}
+				for _, name := range names {
+					accounts = append(accounts, accountEntry{profile: i, name: name})
+				}
+			}
+			applyFilter(selected)
+			return firstErr
+		})
+	}
+
+	// moveSelection selects the account delta rows away from the selected one while the filter is typed.
+	moveSelection := func(delta int) {
+		i := accountList.Selected() + delta
+		if accountList.Length() == 0 || i < 0 || i >= accountList.Length() {
+			return
+		}
+		accountList.SetSelected(i)
+		previewAccount()
+	}
 
 	accountListBox := tui.NewVBox(accountList)
 	accountListBox.SetTitle("Accounts")
 	accountListBox.SetBorder(true)
 
-	accountBox := tui.NewHBox(accountListBox, accountDetailBox)
+	// The profile list switches the profile, its last item shows the accounts of every profile grouped by profile
+	profileList := tui.NewList()
+	for _, p := range store {
+		profileList.AddItems(p.Name)
+	}
+	profileList.AddItems(allProfilesItem)
+	profileList.SetSelected(profileIndex)
+	selectProfile := func() {
+		allProfiles = profileList.Selected() == len(store)
+		if allProfiles {
+			statusBar.SetText("Using all the profiles")
+		} else {
+			profileIndex = profileList.Selected()
+			useConfig(profileIndex)
+			statusBar.SetText(fmt.Sprintf("Using profile : %s", store[profileIndex].Name))
+		}
+		refreshList(accountEntry{})
+	}
+	profileList.OnSelectionChanged(func(l *tui.List) { selectProfile() })
+
+	profileListBox := tui.NewVBox(profileList)
+	profileListBox.SetTitle("Profiles")
+	profileListBox.SetBorder(true)
+	profileListBox.SetSizePolicy(tui.Maximum, tui.Preferred)
+
+	accountBox := tui.NewHBox(profileListBox, accountListBox, accountDetailBox)
 	accountBox.SetSizePolicy(tui.Expanding, tui.Expanding)
 
-	filterEntry := tui.NewEntry()
+	filterEntry := newSearchEntry()
 	filterEntry.SetText(filter)
-	filterEntry.OnSubmit(func(e *tui.Entry) {
+	filterEntry.OnChanged(func(e *tui.Entry) {
 		filter = e.Text()
-		accountList.RemoveItems()
-		accounts := fetchAccounts(conf, filter)
-		if len(accounts) == 0 {
-			statusBar.SetText("No account matching: " + filter)
-		} else {
-			accountList.AddItems(accounts...)
-			accountList.SetSelected(0)
-		}
+		applyFilter(accountEntry{})
 	})
+	// Up and Down move the selection while the filter is typed
+	filterEntry.onMove = moveSelection
 
 	filterBox := tui.NewVBox(filterEntry)
 	filterBox.SetTitle("Search an account")
 	filterBox.SetBorder(true)
 
-	tui.DefaultFocusChain.Set(filterEntry, accountList, showPasswordBtn, copyPasswordBtn)
-	listSreen := tui.NewVBox(filterBox, accountBox, statusBox)
+	confirm := newConfirmDialog(root)
+
+	newBtn := tui.NewButton("[ New ]")
+	editBtn := tui.NewButton("[ Edit ]")
+	deleteBtn := tui.NewButton("[ Delete ]")
+	actionsBox := tui.NewHBox(newBtn, editBtn, deleteBtn, tui.NewSpacer())
+	helpLabel := tui.NewLabel(helpText)
+
+	listSreen := tui.NewVBox(filterBox, accountBox, actionsBox, helpLabel)
+	listSreen.SetSizePolicy(tui.Expanding, tui.Expanding)
+	listView := newView(listSreen, filterEntry, profileList, accountList, showPasswordBtn, copyPasswordBtn, historyBtn, newBtn, editBtn, deleteBtn)
+	showList := func() { root.Show(listView) }
+	root.Show(listView)
+	// Enter in the filter moves to the list and shows the selected account
+	filterEntry.OnSubmit(func(e *tui.Entry) {
+		root.Focus(accountList)
+		showAccount()
+	})
+
+	nextProfile := func() {
+		profileList.SetSelected((profileList.Selected() + 1) % profileList.Length())
+		selectProfile()
+	}
+	listView.shortcuts = map[rune]func(){
+		keyCtrlF: func() { root.Focus(filterEntry) },
+		keyCtrlU: copyUsername,
+		keyCtrlP: copyPassword,
+		keyCtrlR: togglePassword,
+		keyCtrlO: nextProfile,
+	}
+
+	// In the "all profiles" mode the profile of a new account is asked first
+	profileChoice := newChoiceDialog(root, "Profile")
+	newBtn.OnActivated(func(b *tui.Button) {
+		if !allProfiles {
+			form.New()
+			root.Show(form.view)
+			return
+		}
+		names := make([]string, len(store))
+		for i, p := range store {
+			names[i] = p.Name
+		}
+		profileChoice.Ask("Profile of the new account", names, confIndex, func(i int) {
+			useConfig(i)
+			form.New()
+			statusBar.SetPermanentText(profileText(store[i].Name, conf, ""))
+			statusBar.SetText(fmt.Sprintf("New account in the profile %s", store[i].Name))
+			root.Show(form.view)
+		})
+	})
+	editBtn.OnActivated(func(b *tui.Button) {
+		if currentAcct == nil {
+			statusBar.SetText("No account selected")
+			return
+		}
+		if err := form.Edit(currentAcct); err != nil {
+			statusBar.SetText(fmt.Sprintf("Error: %s", err))
+			return
+		}
+		root.Show(form.view)
+	})
+	deleteBtn.OnActivated(func(b *tui.Button) {
+		if currentAcct == nil {
+			statusBar.SetText("No account selected")
+			return
+		}
+		account := currentAcct
+		confirm.Ask(fmt.Sprintf("Delete the account %s and its attachments ?", account.Name), func() {
+			run("deleting the account", func() error {
+				if err := account.Remove(); err != nil {
+					return err
+				}
+				statusBar.SetText("Account deleted : " + account.Name)
+				refreshList(accountEntry{})
+				return nil
+			})
+		})
+	})
+	form.onSaved = func(a *keep.Account) {
+		statusBar.SetText("Account saved : " + a.Name)
+		showList()
+		clearAccount()
+		refreshList(accountEntry{profile: confIndex, name: a.Name})
+		showAccount()
+	}
+	form.onCanceled = showList
+	form.onError = statusBar.SetText
+	form.run = run
 
 	theme := tui.NewTheme()
 	theme.SetStyle("box.focused", tui.Style{Fg: tui.ColorYellow, Bg: tui.ColorDefault})
 	theme.SetStyle("list.item.selected", tui.Style{Fg: tui.ColorYellow, Bg: tui.ColorDefault})
 	theme.SetStyle("button.focused", tui.Style{Fg: tui.ColorYellow, Bg: tui.ColorDefault})
 
-	ui := tui.New(listSreen)
-	ui.SetTheme(theme)
+	// quit stops the running interface, Esc cancels the form and the dialogs, then quits
+	var quit func()
+	quitting := false
+	listView.cancel = func() {
+		quitting = true
+		quit()
+	}
+
+	// lock forgets the passphrases and the decrypted account, the interface cannot be used
+	// again until the passphrase of the profile is entered. The reason is shown first.
+	var lock func(reason string)
+	unlock := func(s string) {
+		prompt := prompts[confIndex]
+		prompt.Set(s)
+		if err := conf.VerifyPassphrase(); err != nil {
+			prompt.Clear()
+			lock(err.Error() + ". ")
+			return
+		}
+		idle.SetPaused(false)
+		statusBar.SetText("Unlocked")
+		showAccount()
+	}
+	lock = func(reason string) {
+		idle.SetPaused(true)
+		clearAccount()
+		// New empties the form, a password may have been typed
+		form.New()
+		for i, c := range configs {
+			prompts[i].Clear()
+			c.ForgetPassphrase()
+			// gpg-agent would unlock the interface from its cache without asking anything
+			if agent {
+				if err := c.ClearGpgAgentCache(); err != nil {
+					reason += fmt.Sprintf("The passphrase of %s could not be removed from gpg-agent : %s. ", store[i].Name, err)
+				}
+			}
+		}
+		root.Show(listView)
+		statusBar.SetText(fmt.Sprintf("Locked after %s without a key pressed", lockAfter))
+		passphrase.Ask(reason+"keep-tui is locked. "+passphraseMessage(), unlock, listView.cancel)
+	}
+
+	refreshList(accountEntry{})
+
+	// tui-go only repaints after a key is pressed, the idle timer cannot lock the interface
+	// from its goroutine. It stops the interface instead, which is locked then run again.
+	for {
+		ui := tui.New(tui.NewVBox(root, statusBox))
+		ui.SetTheme(theme)
+		ui.SetFocusChain(root)
+		ui.SetKeybinding(tui.KeyEsc, func() { root.cancel() })
+		// The screen cannot be finalized twice, by the timer and by the user
+		var once sync.Once
+		quit = func() { once.Do(ui.Quit) }
+
+		done := make(chan struct{})
+		go idle.Watch(done, quit)
+		err := ui.Run()
+		close(done)
+		if err != nil {
+			fmt.Println("An error occured while running the interface", err)
+			os.Exit(exitCodeNotOk)
+		}
+		if quitting {
+			return
+		}
+		lock("")
+	}
+}
 
-	ui.SetKeybinding(tui.KeyEsc, func() { ui.Quit() })
+// filterAccounts returns the accounts matching the filter, grouped by profile in the order of the profiles.
+func filterAccounts(filter string, entries []accountEntry) []accountEntry {
+	var profiles []int
+	names := make(map[int][]string)
+	for _, e := range entries {
+		if _, ok := names[e.profile]; !ok {
+			profiles = append(profiles, e.profile)
+		}
+		names[e.profile] = append(names[e.profile], e.name)
+	}
+	var matches []accountEntry
+	for _, p := range profiles {
+		for _, name := range fuzzyFilter(filter, names[p]) {
+			matches = append(matches, accountEntry{profile: p, name: name})
+		}
+	}
+	return matches
+}
 
-	if err := ui.Run(); err != nil {
-		panic(err)
+// profileText returns the profile of an account and what it is encrypted to, for the status bar.
+func profileText(profile string, conf *keep.Config, account string) string {
+	if conf.IsSymmetric() {
+		return fmt.Sprintf("%s - encrypted with a passphrase", profile)
+	}
+	recipients, err := conf.RecipientsFor(account)
+	if err != nil {
+		return fmt.Sprintf("%s - %s", profile, err)
 	}
+	return fmt.Sprintf("%s - encrypted to %s", profile, recipients)
 }
 
-func fetchAccounts(conf *keep.Config, filter string) []string {
-	files, err := conf.ListAccountFiles(filter)
+func fetchAccounts(conf *keep.Config) ([]string, error) {
+	files, err := conf.ListAccountFiles("")
+	if os.IsNotExist(err) {
+		// The account directory is created with the first account
+		return nil, nil
+	}
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	lst := make([]string, len(files))
 	for i, f := range files {
 		lst[i] = f.Name()
 	}
-	return lst
+	return lst, nil
 }
 
-func getAccount(conf *keep.Config, fname string) *keep.Account {
-	account, err := keep.NewAccountFromFile(conf, fname)
-	if err != nil {
-		fmt.Println("An error occured while getting the account: ", err)
+// historyText returns the previous passwords of the account, one per line, hidden unless reveal is true.
+func historyText(a *keep.Account, reveal bool) string {
+	if len(a.History) == 0 {
+		return "No previous password"
+	}
+	lines := make([]string, len(a.History))
+	for i, p := range a.History {
+		password := hiddenPassword
+		if reveal {
+			password = string(p.Password.Bytes())
+		}
+		lines[i] = fmt.Sprintf("Replaced on %s : %s", p.Replaced.Local().Format("2006-01-02 15:04"), password)
 	}
-	return account
+	return strings.Join(lines, "\n")
 }
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+var filterAccountsEntries = []accountEntry{
+	{0, "example.com"},
+	{0, "mail.example.com"},
+	{0, "github.com"},
+	{1, "work/example.org"},
+	{1, "exam"},
+	{1, "gitlab.com"},
+}
+
+var filterAccountsCases = []struct {
+	filter   string
+	expected []accountEntry
+}{
+	// The accounts keep their order, grouped by profile
+	{"", filterAccountsEntries},
+	// The consecutive and word start matches rank first, then the shortest names
+	{"exam", []accountEntry{
+		{0, "example.com"}, {0, "mail.example.com"},
+		{1, "exam"}, {1, "work/example.org"},
+	}},
+	{"gh", []accountEntry{{0, "github.com"}}},
+	{"git", []accountEntry{{0, "github.com"}, {1, "gitlab.com"}}},
+	{"ec", []accountEntry{{0, "example.com"}, {0, "mail.example.com"}}},
+	{"EXAMPLE.ORG", []accountEntry{{1, "work/example.org"}}},
+	{"zzz", nil},
+}
+
+func Test_filterAccounts(t *testing.T) {
+	for _, c := range filterAccountsCases {
+		got := filterAccounts(c.filter, filterAccountsEntries)
+		if !reflect.DeepEqual(got, c.expected) {
+			t.Errorf("%q: got %v -- expected %v", c.filter, got, c.expected)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/jcmdev0/gpgagent"
+	"github.com/yml/keep"
+	"golang.org/x/crypto/openpgp"
+)
+
+// errLocked is returned by the PromptFunction of the TUI as long as the passphrase has not been entered.
+var errLocked = errors.New("The passphrase is required")
+
+// passphrasePrompt provides the passphrase entered in the passphrase dialog to the library.
+// The TUI cannot wait for the user while it handles an event, its PromptFunction returns
+// errLocked instead and the action is run again once the passphrase has been entered.
+type passphrasePrompt struct {
+	passphrase *keep.Secret
+}
+
+// newPassphrasePrompt returns the prompt of the TUI, initialized with GPGPASSPHRASE when it is set.
+func newPassphrasePrompt() *passphrasePrompt {
+	p := &passphrasePrompt{}
+	if s, ok := os.LookupEnv("GPGPASSPHRASE"); ok {
+		p.Set(s)
+	}
+	return p
+}
+
+// Set stores the passphrase used to decrypt the keys.
+func (p *passphrasePrompt) Set(passphrase string) {
+	p.Clear()
+	p.passphrase = keep.NewSecret([]byte(passphrase))
+}
+
+// Clear wipes the passphrase, it will be requested again.
+func (p *passphrasePrompt) Clear() {
+	p.passphrase.Wipe()
+	p.passphrase = nil
+}
+
+// PromptFunction implements openpgp.PromptFunction with the stored passphrase.
+// It returns a copy of the passphrase since the library wipes it after use.
+func (p *passphrasePrompt) PromptFunction(keys []openpgp.Key, symmetric bool) ([]byte, error) {
+	if p.passphrase == nil {
+		return nil, errLocked
+	}
+	if symmetric && len(keys) == 0 {
+		return p.copyPassphrase(), nil
+	}
+	for _, k := range keys {
+		err := k.PrivateKey.Decrypt(p.passphrase.Bytes())
+		if err != nil {
+			return nil, keep.ErrWrongPassphrase
+		}
+		return p.copyPassphrase(), nil
+	}
+	return nil, fmt.Errorf("Unable to find key")
+}
+
+// copyPassphrase returns a plain copy of the passphrase, the library wipes it and it is not locked
+// in memory so that nothing is left to unlock.
+func (p *passphrasePrompt) copyPassphrase() []byte {
+	b := make([]byte, p.passphrase.Len())
+	copy(b, p.passphrase.Bytes())
+	return b
+}
+
+// useGpgAgent returns true when the passphrase is requested by gpg-agent with its own pinentry,
+// as guessed by the library, rather than with the passphrase dialog.
+func useGpgAgent() bool {
+	if _, ok := os.LookupEnv("GPGPASSPHRASE"); ok {
+		return false
+	}
+	conn, err := gpgagent.NewGpgAgentConn()
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
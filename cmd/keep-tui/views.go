@@ -0,0 +1,97 @@
+package main
+
+import (
+	tui "github.com/marcusolsson/tui-go"
+)
+
+// view is a screen of the interface along with the widgets that can get the keyboard focus, in order.
+type view struct {
+	tui.Widget
+	focusables []tui.Widget
+	// cancel is run when Esc is pressed.
+	cancel func()
+	// shortcuts are run when their control key is pressed, whatever the focused widget.
+	shortcuts map[rune]func()
+}
+
+func newView(w tui.Widget, focusables ...tui.Widget) *view {
+	return &view{Widget: w, focusables: focusables}
+}
+
+// views is the root widget of the interface, it draws the current view and
+// is the focus chain moving the keyboard focus along its widgets.
+type views struct {
+	*view
+	// onKey is called for every key pressed.
+	onKey func()
+}
+
+// Show replaces the current view by v and gives the focus to its first widget.
+func (vs *views) Show(v *view) {
+	if vs.view != nil {
+		for _, w := range vs.focusables {
+			w.SetFocused(false)
+		}
+	}
+	vs.view = v
+	v.focusables[0].SetFocused(true)
+}
+
+// Focus moves the focus to w, a widget of the current view.
+func (vs *views) Focus(w tui.Widget) {
+	for _, f := range vs.focusables {
+		f.SetFocused(f == w)
+	}
+}
+
+// OnEvent runs the shortcuts of the current view and passes the other events to the view.
+// The control keys are not passed to the view, an entry would insert them in its text.
+func (vs *views) OnEvent(ev tui.Event) {
+	if ev.Type == tui.EventKey && vs.onKey != nil {
+		vs.onKey()
+	}
+	if ev.Type == tui.EventKey && ev.Key == tui.KeyUnknown {
+		if fn, ok := vs.shortcuts[ev.Ch]; ok {
+			fn()
+			return
+		}
+	}
+	vs.view.OnEvent(ev)
+}
+
+// FocusNext returns the widget following w in the current view.
+func (vs *views) FocusNext(w tui.Widget) tui.Widget {
+	return vs.focus(w, 1)
+}
+
+// FocusPrev returns the widget preceding w in the current view.
+func (vs *views) FocusPrev(w tui.Widget) tui.Widget {
+	return vs.focus(w, -1)
+}
+
+// FocusDefault returns the first widget of the current view.
+func (vs *views) FocusDefault() tui.Widget {
+	return vs.focusables[0]
+}
+
+// focus moves the focus by delta from the focused widget. The UI only knows the last widget it focused,
+// which it unfocuses before asking for the next one, the widget focused by Show or Focus is used first.
+func (vs *views) focus(w tui.Widget, delta int) tui.Widget {
+	i := -1
+	for j, f := range vs.focusables {
+		if f.IsFocused() {
+			i = j
+		}
+	}
+	for j, f := range vs.focusables {
+		if i < 0 && f == w {
+			i = j
+		}
+		f.SetFocused(false)
+	}
+	if i < 0 {
+		i = 0
+	}
+	n := len(vs.focusables)
+	return vs.focusables[((i+delta)%n+n)%n]
+}
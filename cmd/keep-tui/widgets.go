@@ -0,0 +1,57 @@
+package main
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	tui "github.com/marcusolsson/tui-go"
+)
+
+// passwordEntry is an Entry whose text is drawn masked unless it is revealed.
+type passwordEntry struct {
+	*tui.Entry
+	revealed bool
+}
+
+func newPasswordEntry() *passwordEntry {
+	e := &passwordEntry{Entry: tui.NewEntry()}
+	e.SetSizePolicy(tui.Expanding, tui.Maximum)
+	return e
+}
+
+// Draw draws a star in place of each character.
+func (e *passwordEntry) Draw(p *tui.Painter) {
+	if e.revealed {
+		e.Entry.Draw(p)
+		return
+	}
+	text := e.Text()
+	e.SetText(strings.Repeat("*", utf8.RuneCountInString(text)))
+	defer e.SetText(text)
+	e.Entry.Draw(p)
+}
+
+// searchEntry is an Entry passing the Up and Down keys to onMove, to move in a list while typing.
+type searchEntry struct {
+	*tui.Entry
+	onMove func(delta int)
+}
+
+func newSearchEntry() *searchEntry {
+	return &searchEntry{Entry: tui.NewEntry()}
+}
+
+// OnEvent moves on Up and Down and edits the text otherwise.
+func (e *searchEntry) OnEvent(ev tui.Event) {
+	if e.IsFocused() && ev.Type == tui.EventKey && e.onMove != nil {
+		switch ev.Key {
+		case tui.KeyArrowUp:
+			e.onMove(-1)
+			return
+		case tui.KeyArrowDown:
+			e.onMove(1)
+			return
+		}
+	}
+	e.Entry.OnEvent(ev)
+}
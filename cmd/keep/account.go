@@ -0,0 +1,151 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/yml/keep"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+// selectAccountFile returns the name of the account matching fname.
+// When several accounts match, the optional <number> argument picks one of them.
+// The program exits if no account or more than one account match.
+func selectAccountFile(conf *keep.Config, fname string, args map[string]interface{}) string {
+	var accountPosition *int
+	snumber, ok := args["<number>"].(string)
+	if ok {
+		number, err := strconv.Atoi(snumber)
+		printAndExitOnError(err, "An error occured while converting the <number> to an int")
+		accountPosition = &number
+	}
+
+	files, err := conf.ListAccountFiles(fname)
+	printAndExitOnError(err, "An error occured while gathering the accounts")
+	switch l := len(files); {
+	case l == 1:
+		// Automatically fallback to the first match if there is only one option
+		return files[0].Name()
+	case l > 1 && accountPosition != nil && *accountPosition < l:
+		// If there is more than one option and an accountPosition is given we are going to use it
+		return files[*accountPosition].Name()
+	case l > 1:
+		// An exact match wins over the partial ones
+		for _, f := range files {
+			if f.Name() == fname {
+				return fname
+			}
+		}
+	case l == 0:
+		// 0 matching account
+		fmt.Println("No account name match :", fname)
+		os.Exit(exitCodeNotOk)
+	}
+	// We couldn't guess what to do so we list all the options
+	fmt.Println("There is more than one match")
+	printFileNames(files)
+	os.Exit(exitCodeNotOk)
+	return ""
+}
+
+// readAccount decrypts the account or exits the program.
+func readAccount(conf *keep.Config, fname string) *keep.Account {
+	account, err := keep.NewAccountFromFile(conf, fname)
+	if os.IsNotExist(err) {
+		fmt.Printf("Account name (%s) does not exist.\n", fname)
+		os.Exit(exitCodeNotOk)
+	}
+	printAndExitOnError(err, "An error occured while creating and account from the clear text reader")
+	return account
+}
+
+// readPassword returns the password generated with --generate or read from stdin,
+// the user is prompted if stdin is a terminal. The password is kept as entered, only the
+// end of the line read from stdin is removed.
+func readPassword(args map[string]interface{}) string {
+	if slength, ok := args["--generate"].(string); ok {
+		length, err := strconv.Atoi(slength)
+		printAndExitOnError(err, "An error occured while converting --generate to an int")
+		generated, err := keep.NewPassword(length)
+		printAndExitOnError(err, "An error occured while generating the password")
+		return string(generated)
+	}
+	if terminal.IsTerminal(int(syscall.Stdin)) {
+		fmt.Print("Enter Password: ")
+		password, err := terminal.ReadPassword(int(syscall.Stdin))
+		fmt.Printf("\n")
+		printAndExitOnError(err, "An error occured while reading the password")
+		return string(password)
+	}
+	line, err := stdin.ReadString('\n')
+	if err != nil && line == "" {
+		printAndExitOnError(err, "An error occured while reading the password from stdin")
+	}
+	return strings.TrimRight(line, "\r\n")
+}
+
+// checkStrength prints the strength of the password of the account and exits
+// if it is below the minimum score of the profile.
+func checkStrength(account *keep.Account) {
+	strength, err := account.CheckStrength()
+	fmt.Println("Password strength :", strength)
+	if strength.Warning != "" {
+		fmt.Println(strength.Warning)
+	}
+	printAndExitOnError(err, "An error occured while checking the password :")
+}
+
+// newAccountFromArgs returns the account described by the command line parameters.
+func newAccountFromArgs(conf *keep.Config, name string, args map[string]interface{}) *keep.Account {
+	username, _ := args["--username"].(string)
+	notes, _ := args["--notes"].(string)
+	account := keep.NewAccount(conf, name, username, readPassword(args), notes)
+	checkStrength(account)
+	return account
+}
+
+// editAccount changes the fields of the account given on the command line.
+func editAccount(account *keep.Account, args map[string]interface{}) {
+	if username, ok := args["--username"].(string); ok {
+		account.Username = username
+	}
+	if notes, ok := args["--notes"].(string); ok {
+		account.Notes = notes
+	}
+	if args["--password"] == true || args["--generate"] != nil {
+		account.Password = keep.NewSecret([]byte(readPassword(args)))
+		checkStrength(account)
+	}
+}
+
+// printHistory prints the previous passwords of the account.
+func printHistory(account *keep.Account) {
+	if len(account.History) == 0 {
+		fmt.Println("No previous password")
+	}
+	for _, p := range account.History {
+		fmt.Printf("Replaced on %s : %s\n", p.Replaced.Local().Format("2006-01-02 15:04"), p.Password.Bytes())
+	}
+}
+
+// printTimestamps prints the dates recorded in the account, the unknown ones are skipped.
+func printTimestamps(account *keep.Account) {
+	dates := []struct {
+		label string
+		t     time.Time
+	}{
+		{"Created", account.Created},
+		{"Updated", account.Updated},
+		{"Password changed", account.PasswordChanged},
+		{"Expires", account.Expires},
+	}
+	for _, d := range dates {
+		if !d.t.IsZero() {
+			fmt.Printf("%s : %s\n", d.label, d.t.Local().Format("2006-01-02 15:04"))
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/yml/keep"
+)
+
+func printAttachments(attachments []keep.Attachment) {
+	for _, at := range attachments {
+		fmt.Printf("Attachment : %s (%d bytes, added on %s)\n", at.Name, at.Size, at.Added.Local().Format("2006-01-02"))
+	}
+}
+
+// detachAttachment decrypts the attachment of the account into out, which must not exist.
+func detachAttachment(account *keep.Account, name, out string) {
+	if _, err := os.Stat(out); !os.IsNotExist(err) {
+		fmt.Printf("Refusing to overwrite %s\n", out)
+		os.Exit(exitCodeNotOk)
+	}
+	content, err := account.ReadAttachment(name)
+	printAndExitOnError(err, "An error occured while decrypting the attachment")
+	err = ioutil.WriteFile(out, content, 0600)
+	printAndExitOnError(err, "An error occured while writing the attachment")
+	fmt.Println("Attachment written to :", out)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/yml/keep"
+)
+
+func printStrengths(strengths []keep.AccountStrength) {
+	for _, s := range strengths {
+		fmt.Printf("%-18s %s", s.Strength, s.Name)
+		if s.Strength.Warning != "" {
+			fmt.Printf(" : %s", s.Strength.Warning)
+		}
+		fmt.Printf("\n")
+	}
+}
+
+func printBreached(breached []keep.BreachedAccount) {
+	if len(breached) == 0 {
+		fmt.Println("No password has been found in the breaches")
+	}
+	for _, b := range breached {
+		fmt.Printf("%s : seen %d times\n", b.Name, b.Count)
+	}
+}
+
+func printReuseGroups(groups []keep.ReuseGroup) {
+	if len(groups) == 0 {
+		fmt.Println("No reused password has been found")
+	}
+	for _, g := range groups {
+		if g.Identical {
+			fmt.Println("Identical password :")
+		} else {
+			fmt.Println("Similar passwords :")
+		}
+		for _, a := range g.Accounts {
+			fmt.Printf("\t%s\n", a)
+		}
+	}
+}
+
+// printAccountDates prints the accounts with their date, unknown is printed for the zero dates.
+func printAccountDates(accounts []keep.AccountDate, label, unknown string) {
+	for _, a := range accounts {
+		if a.Date.IsZero() {
+			fmt.Printf("%s : %s\n", a.Name, unknown)
+			continue
+		}
+		fmt.Printf("%s : %s %s\n", a.Name, label, a.Date.Local().Format("2006-01-02"))
+	}
+}
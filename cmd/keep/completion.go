@@ -0,0 +1,370 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/yml/keep"
+)
+
+// completeCommand is the hidden entry point used by the completion scripts to list
+// the names that change over time: `keep __complete accounts [<profile>]` or `keep __complete profiles`.
+const completeCommand = "__complete"
+
+// completionOption is an option of the usage.
+type completionOption struct {
+	Short       string
+	Long        string
+	Value       string
+	Description string
+}
+
+// IsFile returns true if the value of the option is a path.
+func (o completionOption) IsFile() bool {
+	return o.Value == "PATH" || o.Value == "FILE"
+}
+
+// completionSpec describes the command line of keep, it is extracted from the usage.
+type completionSpec struct {
+	Commands []string
+	// Subcommands lists the second word of the commands that have one, e.g. audit strength.
+	Subcommands map[string][]string
+	// AccountCommands are the commands whose arguments are account names.
+	AccountCommands []string
+	Options         []completionOption
+}
+
+var (
+	usageOptionRegexp  = regexp.MustCompile(`^(?:-([a-z]) )?(--[a-z-]+)(?:=([A-Z]+))?\s*(.*)$`)
+	patternFlagRegexp  = regexp.MustCompile(`(--[a-z-]+)(?:=([A-Z]+))?`)
+	subcommandRegexp   = regexp.MustCompile(`^[a-z-]+$`)
+	optionDefaultRegex = regexp.MustCompile(`\s*\[default: [^]]*\]`)
+)
+
+// parseUsage extracts the commands and the options from the docopt usage so that
+// the completion follows its changes.
+func parseUsage(usage string) completionSpec {
+	spec := completionSpec{Subcommands: make(map[string][]string)}
+	seenCommand := make(map[string]bool)
+	seenOption := make(map[string]bool)
+	var patternFlags []completionOption
+	section := ""
+	for _, line := range strings.Split(usage, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasSuffix(trimmed, ":") && !strings.HasPrefix(line, "\t"):
+			section = trimmed
+		case section == "Usage:" && strings.HasPrefix(trimmed, "keep "):
+			words := strings.Fields(trimmed)
+			cmd := words[1]
+			if !seenCommand[cmd] {
+				seenCommand[cmd] = true
+				spec.Commands = append(spec.Commands, cmd)
+				if strings.Contains(trimmed, "<file>") || strings.Contains(trimmed, "<account>") {
+					spec.AccountCommands = append(spec.AccountCommands, cmd)
+				}
+			}
+			if len(words) > 2 && subcommandRegexp.MatchString(words[2]) {
+				spec.Subcommands[cmd] = append(spec.Subcommands[cmd], words[2])
+			}
+			for _, m := range patternFlagRegexp.FindAllStringSubmatch(trimmed, -1) {
+				patternFlags = append(patternFlags, completionOption{Long: m[1], Value: m[2]})
+			}
+		case section == "Options:" && strings.HasPrefix(trimmed, "-"):
+			m := usageOptionRegexp.FindStringSubmatch(trimmed)
+			if m == nil || seenOption[m[2]] {
+				continue
+			}
+			seenOption[m[2]] = true
+			description := optionDefaultRegex.ReplaceAllString(m[4], "")
+			spec.Options = append(spec.Options, completionOption{"-" + m[1], m[2], m[3], description})
+		}
+	}
+	// The options only used in the patterns, e.g. --print
+	for _, o := range patternFlags {
+		if !seenOption[o.Long] {
+			seenOption[o.Long] = true
+			spec.Options = append(spec.Options, o)
+		}
+	}
+	for i := range spec.Options {
+		if spec.Options[i].Short == "-" {
+			spec.Options[i].Short = ""
+		}
+	}
+	return spec
+}
+
+// Words returns the list of the options separated by spaces.
+func (s completionSpec) Words() string {
+	var words []string
+	for _, o := range s.Options {
+		if o.Short != "" {
+			words = append(words, o.Short)
+		}
+		words = append(words, o.Long)
+	}
+	return strings.Join(words, " ")
+}
+
+// FileOptions returns the pattern, e.g. "-d|--dir", of the options taking a path.
+func (s completionSpec) FileOptions() string {
+	return s.valueOptions(completionOption.IsFile)
+}
+
+// OtherOptions returns the pattern of the other options taking a value, except --profile.
+func (s completionSpec) OtherOptions() string {
+	return s.valueOptions(func(o completionOption) bool {
+		return !o.IsFile() && o.Long != "--profile"
+	})
+}
+
+func (s completionSpec) valueOptions(filter func(completionOption) bool) string {
+	var words []string
+	for _, o := range s.Options {
+		if o.Value == "" || !filter(o) {
+			continue
+		}
+		if o.Short != "" {
+			words = append(words, o.Short)
+		}
+		words = append(words, o.Long)
+	}
+	return strings.Join(words, "|")
+}
+
+// SortedSubcommands returns the commands that have subcommands, sorted.
+func (s completionSpec) SortedSubcommands() []string {
+	var cmds []string
+	for cmd := range s.Subcommands {
+		cmds = append(cmds, cmd)
+	}
+	sort.Strings(cmds)
+	return cmds
+}
+
+var completionFuncs = template.FuncMap{
+	"join":  strings.Join,
+	"quote": func(s string) string { return "'" + strings.Replace(s, "'", `'\''`, -1) + "'" },
+}
+
+var completionTemplates = map[string]string{
+	"bash": `# bash completion for keep, generated by keep completion bash
+# Add this line to ~/.bashrc: source <(keep completion bash)
+
+_keep() {
+	local cur="${COMP_WORDS[COMP_CWORD]}" prev="${COMP_WORDS[COMP_CWORD-1]}"
+	if [[ "$cur" == "=" ]]; then
+		cur=""
+	fi
+	if [[ "$prev" == "=" ]]; then
+		prev="${COMP_WORDS[COMP_CWORD-2]}"
+	fi
+	local cmd="" sub="" profile="" i w
+	for ((i=1; i < COMP_CWORD; i++)); do
+		w="${COMP_WORDS[i]}"
+		case "$w" in
+			-p|--profile)
+				((i++)); [[ "${COMP_WORDS[i]}" == "=" ]] && ((i++))
+				profile="${COMP_WORDS[i]}" ;;
+			{{ .OtherOptions }}|{{ .FileOptions }})
+				((i++)); [[ "${COMP_WORDS[i]}" == "=" ]] && ((i++)) ;;
+			-*) ;;
+			*)
+				if [[ -z "$cmd" ]]; then
+					cmd="$w"
+				elif [[ -z "$sub" ]]; then
+					sub="$w"
+				fi ;;
+		esac
+	done
+
+	case "$prev" in
+		-p|--profile)
+			COMPREPLY=($(compgen -W "$(keep {{ .Complete }} profiles 2>/dev/null)" -- "$cur"))
+			return ;;
+		{{ .FileOptions }})
+			COMPREPLY=($(compgen -f -- "$cur"))
+			return ;;
+		{{ .OtherOptions }})
+			return ;;
+	esac
+	if [[ "$cur" == -* ]]; then
+		COMPREPLY=($(compgen -W "{{ .Words }}" -- "$cur"))
+		return
+	fi
+	if [[ -z "$cmd" ]]; then
+		COMPREPLY=($(compgen -W "{{ join .Commands " " }}" -- "$cur"))
+		return
+	fi
+	case "$cmd" in
+{{- range $cmd := .SortedSubcommands }}
+		{{ $cmd }})
+			if [[ -z "$sub" ]]; then
+				COMPREPLY=($(compgen -W "{{ join (index $.Subcommands $cmd) " " }}" -- "$cur"))
+				return
+			fi ;;
+{{- end }}
+	esac
+	case "$cmd" in
+		{{ join .AccountCommands "|" }})
+			COMPREPLY=($(compgen -W "$(keep {{ .Complete }} accounts "$profile" 2>/dev/null)" -- "$cur")) ;;
+		*)
+			COMPREPLY=($(compgen -f -- "$cur")) ;;
+	esac
+}
+complete -F _keep keep
+`,
+	"zsh": `#compdef keep
+# zsh completion for keep, generated by keep completion zsh
+# Add this line to ~/.zshrc, after compinit: source <(keep completion zsh)
+
+_keep() {
+	local cmd="" sub="" profile="" i w
+	for ((i=2; i < CURRENT; i++)); do
+		w="${words[i]}"
+		case "$w" in
+			-p|--profile)
+				((i++))
+				profile="${words[i]}" ;;
+			--profile=*)
+				profile="${w#--profile=}" ;;
+			{{ .OtherOptions }}|{{ .FileOptions }})
+				((i++)) ;;
+			-*) ;;
+			*)
+				if [[ -z "$cmd" ]]; then
+					cmd="$w"
+				elif [[ -z "$sub" ]]; then
+					sub="$w"
+				fi ;;
+		esac
+	done
+
+	local cur="${words[CURRENT]}" prev="${words[CURRENT-1]}"
+	case "$prev" in
+		-p|--profile)
+			compadd -- ${(f)"$(keep {{ .Complete }} profiles 2>/dev/null)"}
+			return ;;
+		{{ .FileOptions }})
+			_files
+			return ;;
+		{{ .OtherOptions }})
+			return ;;
+	esac
+	if [[ "$cur" == -* ]]; then
+		compadd -- {{ .Words }}
+		return
+	fi
+	if [[ -z "$cmd" ]]; then
+		compadd -- {{ join .Commands " " }}
+		return
+	fi
+	case "$cmd" in
+{{- range $cmd := .SortedSubcommands }}
+		{{ $cmd }})
+			if [[ -z "$sub" ]]; then
+				compadd -- {{ join (index $.Subcommands $cmd) " " }}
+				return
+			fi ;;
+{{- end }}
+	esac
+	case "$cmd" in
+		{{ join .AccountCommands "|" }})
+			compadd -- ${(f)"$(keep {{ .Complete }} accounts "$profile" 2>/dev/null)"} ;;
+		*)
+			_files ;;
+	esac
+}
+compdef _keep keep
+`,
+	"fish": `# fish completion for keep, generated by keep completion fish
+# Save it with: keep completion fish > ~/.config/fish/completions/keep.fish
+
+function __keep_profile
+	set -l tokens (commandline -opc)
+	set -l profile ''
+	for i in (seq (count $tokens))
+		switch $tokens[$i]
+			case -p --profile
+				set -q tokens[(math $i + 1)]; and set profile $tokens[(math $i + 1)]
+			case '--profile=*'
+				set profile (string replace -- --profile= '' $tokens[$i])
+		end
+	end
+	echo $profile
+end
+
+function __keep_accounts
+	keep {{ .Complete }} accounts (__keep_profile) 2>/dev/null
+end
+
+complete -c keep -f
+complete -c keep -n __fish_use_subcommand -a {{ quote (join .Commands " ") }}
+{{- range $cmd := .SortedSubcommands }}
+complete -c keep -n {{ quote (printf "__fish_seen_subcommand_from %s; and not __fish_seen_subcommand_from %s" $cmd (join (index $.Subcommands $cmd) " ")) }} -a {{ quote (join (index $.Subcommands $cmd) " ") }}
+{{- end }}
+complete -c keep -n {{ quote (printf "__fish_seen_subcommand_from %s" (join .AccountCommands " ")) }} -a '(__keep_accounts)'
+{{- range .Options }}
+complete -c keep{{ if .Short }} -s {{ slice .Short 1 }}{{ end }} -l {{ slice .Long 2 }}{{ if .IsFile }} -r -F{{ else if eq .Long "--profile" }} -x -a '(keep {{ $.Complete }} profiles 2>/dev/null)'{{ else if .Value }} -x{{ end }}{{ if .Description }} -d {{ quote .Description }}{{ end }}
+{{- end }}
+`,
+}
+
+// runCompletion prints the completion script of the shell.
+func runCompletion(shell, usage string) {
+	text, ok := completionTemplates[shell]
+	if !ok {
+		fmt.Printf("Unknown shell %q, expected bash, zsh or fish\n", shell)
+		os.Exit(exitCodeNotOk)
+	}
+	tmpl := template.Must(template.New(shell).Funcs(completionFuncs).Parse(text))
+	data := struct {
+		completionSpec
+		Complete string
+	}{parseUsage(usage), completeCommand}
+	err := tmpl.Execute(os.Stdout, data)
+	printAndExitOnError(err, "An error occured while writing the completion script")
+}
+
+// runComplete prints the names the completion scripts ask for with `keep __complete`, one per line.
+// Nothing is printed on error so that the completion falls back to nothing.
+func runComplete(args []string) {
+	if len(args) == 0 {
+		os.Exit(exitCodeNotOk)
+	}
+	// Only the names are written to stdout
+	out := os.Stdout
+	os.Stdout = os.Stderr
+	store, err := keep.LoadProfileStore()
+	if err != nil || len(store) == 0 {
+		os.Exit(exitCodeNotOk)
+	}
+	switch args[0] {
+	case "profiles":
+		for _, p := range store {
+			fmt.Fprintln(out, p.Name)
+		}
+	case "accounts":
+		profile := store[0]
+		if len(args) > 1 && args[1] != "" {
+			for _, p := range store {
+				if p.Name == args[1] {
+					profile = p
+				}
+			}
+		}
+		files, err := keep.NewConfig(&profile).ListAccountFiles("")
+		if err != nil {
+			os.Exit(exitCodeNotOk)
+		}
+		for _, f := range files {
+			fmt.Fprintln(out, f.Name())
+		}
+	}
+	os.Exit(exitCodeOk)
+}
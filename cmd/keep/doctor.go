@@ -0,0 +1,18 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/yml/keep"
+)
+
+// printCheckResults prints a pass/fail report and returns false if one of the checks failed.
+func printCheckResults(results []keep.CheckResult) bool {
+	counts := make(map[keep.CheckStatus]int)
+	for _, r := range results {
+		counts[r.Status]++
+		fmt.Printf("[%s] %-20s %s\n", r.Status, r.Name, r.Detail)
+	}
+	fmt.Printf("\n%d passed, %d warning(s), %d failed\n", counts[keep.CheckPass], counts[keep.CheckWarn], counts[keep.CheckFail])
+	return !keep.HasFailure(results)
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"syscall"
+
+	"github.com/yml/keep"
+)
+
+// forwardedSignals are the signals received by keep exec that are passed to the command.
+var forwardedSignals = []os.Signal{syscall.SIGTERM, syscall.SIGHUP, syscall.SIGUSR1, syscall.SIGUSR2}
+
+// terminalSignals are sent by the terminal to the command too, keep exec only waits for
+// the command to handle them instead of exiting.
+var terminalSignals = []os.Signal{syscall.SIGINT, syscall.SIGQUIT}
+
+// runExec runs the command with the environment variables set to the fields of the accounts
+// and exits with its exit code. With mask, the secrets are masked in its stdout and stderr.
+func runExec(conf *keep.Config, specs []string, command []string, mask bool, stdout *os.File) {
+	envs, err := conf.ResolveEnvSecrets(specs)
+	printAndExitOnError(err, "An error occured while reading the environment variables")
+
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = os.Stderr
+	cmd.Env = os.Environ()
+	secrets := make([]string, len(envs))
+	for i, e := range envs {
+		cmd.Env = append(cmd.Env, e.Name+"="+e.Value)
+		secrets[i] = e.Value
+	}
+	var masks []io.Closer
+	if mask {
+		maskedOut, maskedErr := keep.NewMaskWriter(stdout, secrets...), keep.NewMaskWriter(os.Stderr, secrets...)
+		cmd.Stdout, cmd.Stderr = maskedOut, maskedErr
+		masks = append(masks, maskedOut, maskedErr)
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, forwardedSignals...)
+	ignored := make(chan os.Signal, 1)
+	signal.Notify(ignored, terminalSignals...)
+	err = cmd.Start()
+	printAndExitOnError(err, "An error occured while starting the command")
+	go func() {
+		for sig := range sigs {
+			cmd.Process.Signal(sig)
+		}
+	}()
+	go func() {
+		for range ignored {
+		}
+	}()
+
+	err = cmd.Wait()
+	signal.Stop(sigs)
+	signal.Stop(ignored)
+	for _, m := range masks {
+		m.Close()
+	}
+	os.Exit(exitCode(err))
+}
+
+// exitCode returns the exit code of a command from the error returned by Wait,
+// a command killed by a signal exits like in a shell with 128 + the signal number.
+func exitCode(err error) int {
+	if err == nil {
+		return exitCodeOk
+	}
+	if exitErr, ok := err.(*exec.ExitError); ok {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok {
+			if status.Signaled() {
+				return 128 + int(status.Signal())
+			}
+			return status.ExitStatus()
+		}
+	}
+	fmt.Println("An error occured while running the command", err)
+	return exitCodeNotOk
+}
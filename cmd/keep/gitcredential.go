@@ -0,0 +1,31 @@
+package main
+
+import (
+	"io"
+	"os"
+
+	"github.com/yml/keep"
+)
+
+// runGitCredential implements the git credential helper protocol, the credential is read
+// from stdin and the answer of get is written to out. Unknown operations are ignored as
+// required by git.
+func runGitCredential(conf *keep.Config, operation string, out io.Writer) {
+	g, err := keep.ReadGitCredential(os.Stdin)
+	printAndExitOnError(err, "An error occured while reading the credential from git")
+	switch operation {
+	case "get":
+		found, err := conf.GetGitCredential(g)
+		printAndExitOnError(err, "An error occured while looking up the credential")
+		if found {
+			err = g.Write(out)
+			printAndExitOnError(err, "An error occured while writing the credential to git")
+		}
+	case "store":
+		err = conf.StoreGitCredential(g)
+		printAndExitOnError(err, "An error occured while storing the credential")
+	case "erase":
+		err = conf.EraseGitCredential(g)
+		printAndExitOnError(err, "An error occured while erasing the credential")
+	}
+}
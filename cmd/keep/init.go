@@ -0,0 +1,155 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/yml/keep"
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/ssh/terminal"
+)
+
+var stdin = bufio.NewReader(os.Stdin)
+
+// ask prints the question and returns the answer or def if the answer is empty.
+func ask(question, def string) string {
+	if def != "" {
+		fmt.Printf("%s [%s]: ", question, def)
+	} else {
+		fmt.Printf("%s: ", question)
+	}
+	answer, _ := stdin.ReadString('\n')
+	answer = strings.TrimSpace(answer)
+	if answer == "" {
+		return def
+	}
+	return answer
+}
+
+// askPassphrase reads a passphrase twice from the terminal and makes sure both match.
+func askPassphrase(label string) ([]byte, error) {
+	fmt.Printf("%s: ", label)
+	passphrase, err := terminal.ReadPassword(int(syscall.Stdin))
+	fmt.Printf("\n")
+	if err != nil {
+		return nil, err
+	}
+	fmt.Printf("Repeat the %s: ", strings.ToLower(label))
+	confirmation, err := terminal.ReadPassword(int(syscall.Stdin))
+	fmt.Printf("\n")
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(passphrase, confirmation) {
+		return nil, fmt.Errorf("the passphrases do not match")
+	}
+	if len(passphrase) == 0 {
+		return nil, fmt.Errorf("the passphrase cannot be empty")
+	}
+	return passphrase, nil
+}
+
+// secretKeys returns the entities with a secret key found in the keyring, nil if it can't be read.
+func secretKeys(path string) openpgp.EntityList {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+	el, err := openpgp.ReadKeyRing(f)
+	if err != nil {
+		return nil
+	}
+	var secrets openpgp.EntityList
+	for _, e := range el {
+		if e.PrivateKey != nil {
+			secrets = append(secrets, e)
+		}
+	}
+	return secrets
+}
+
+// runInit guides the user through the creation of the configuration file.
+func runInit(profileName string, symmetric bool) {
+	configFile, accountDir := keep.GetConfigPaths()
+	if _, err := os.Stat(configFile); !os.IsNotExist(err) {
+		fmt.Println("keep is already initialized, the configuration file is :", configFile)
+		os.Exit(exitCodeNotOk)
+	}
+	keepDir := filepath.Dir(configFile)
+
+	profile := keep.DefaultProfile()
+	if profileName != "" {
+		profile.Name = profileName
+	}
+	profile.AccountDir = ask("Account directory", accountDir)
+
+	var passphrase []byte
+	defer func() { keep.WipeBytes(passphrase) }()
+	if symmetric {
+		profile.Type = keep.ProfileTypeSymmetric
+		profile.SecringDir = ""
+		profile.PubringDir = ""
+		profile.RecipientKeyIds = ""
+		profile.SignerKeyID = ""
+		var err error
+		passphrase, err = askPassphrase("Passphrase of the vault")
+		printAndExitOnError(err, "An error occured while reading the passphrase")
+	} else {
+		var entity *openpgp.Entity
+		if secrets := secretKeys(profile.SecringDir); len(secrets) > 0 {
+			fmt.Println("Secret keys found in", profile.SecringDir)
+			for i, e := range secrets {
+				fmt.Printf("%d - %s %s\n", i, keep.Fingerprint(e.PrimaryKey), keep.PrimaryIdentity(e))
+			}
+			answer := ask("Number of the key to use or `new` to generate a new key", "0")
+			if answer != "new" {
+				i, err := strconv.Atoi(answer)
+				if err != nil || i < 0 || i >= len(secrets) {
+					fmt.Println("Invalid key number :", answer)
+					os.Exit(exitCodeNotOk)
+				}
+				entity = secrets[i]
+			}
+		}
+
+		if entity == nil {
+			name := ask("Your name", os.Getenv("USER"))
+			email := ask("Your email", "")
+			var err error
+			passphrase, err = askPassphrase("Passphrase protecting the new key")
+			printAndExitOnError(err, "An error occured while reading the passphrase")
+
+			fmt.Println("Generating a RSA-4096 key pair, this can take a while ...")
+			entity, err = keep.NewKeyPair(name, email)
+			printAndExitOnError(err, "An error occured while generating the key pair")
+
+			profile.PubringDir = filepath.Join(keepDir, "pubring.gpg")
+			profile.SecringDir = filepath.Join(keepDir, "secring.gpg")
+			backup := filepath.Join(keepDir, keep.Fingerprint(entity.PrimaryKey)+"-secret-key.asc")
+			err = keep.WriteKeyPair(entity, passphrase, profile.PubringDir, profile.SecringDir, backup)
+			printAndExitOnError(err, "An error occured while writing the key pair")
+			fmt.Println("Public keyring :", profile.PubringDir)
+			fmt.Println("Secret keyring :", profile.SecringDir)
+			fmt.Println("Backup of the secret key, store it somewhere safe :", backup)
+		}
+		fpr := keep.Fingerprint(entity.PrimaryKey)
+		profile.RecipientKeyIds = fpr
+		profile.SignerKeyID = fpr
+	}
+
+	_, err := keep.InitProfileStore(*profile)
+	printAndExitOnError(err, "An error occured while creating the configuration file")
+	if symmetric {
+		err = keep.NewConfig(profile).InitPassphrase(passphrase)
+		printAndExitOnError(err, "An error occured while writing the passphrase check file")
+		fmt.Println("The accounts of this profile are encrypted with this passphrase.")
+	}
+	fmt.Println("Configuration written to :", configFile)
+}
@@ -5,7 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 	"path/filepath"
-	"strconv"
+	"strings"
 	"time"
 
 	"github.com/atotto/clipboard"
@@ -39,36 +39,178 @@ func main() {
 	usage := `keep password manager
 
 Usage:
-	keep read [options] <file> [<number>] [--print]
+	keep init [options] [--symmetric]
+	keep read [options] <file> [<number>] [--print] [--history]
 	keep list [options] [<file>]
-	keep add [options]
+	keep edit [options] <file> [<number>] [--username=USER] [--notes=NOTES] [--password | --generate=LENGTH] [--expires=DATE]
+	keep add [options] [<file>] [--username=USER] [--notes=NOTES] [--generate=LENGTH] [--expires=DATE]
+	keep doctor [options]
+	keep upgrade [options]
+	keep keys expiring [options]
+	keep expiring [options]
+	keep recipients set [options] <folder> <keys>...
+	keep share [options] <file> [<number>] --with=KEY [--out=PATH]
+	keep unshare [options] <file> [<number>] [--with=KEY]
+	keep shares [options]
+	keep attach [options] <file> <path> [--name=NAME]
+	keep detach [options] <file> <name> [--out=PATH] [--remove]
+	keep ssh-key set [options] <file> <keyfile> [--confirm] [--lifetime=DURATION]
+	keep ssh-key remove [options] <file>
+	keep ssh-agent [options] [--socket=PATH] [<account>...]
+	keep exec [options] [--env=SPEC...] [--mask] [--] <command>...
+	keep inject [options] --in=PATH --out=PATH
+	keep git-credential [options] <operation>
+	keep audit strength [options]
+	keep audit breached [options] --hibp=FILE
+	keep audit reuse [options] [--all]
+	keep audit age [options] [--older-than=DURATION]
+	keep completion <shell>
 
 Options:
 	-r --recipients=KEYS   List of key ids the message should be encypted
 	-d --dir=PATH          Account Directory
 	-p --profile=NAME      Profile name
 	-c --clipboard         Copy password to the clipboard
+	--history              Print the previous passwords of the account
+	--password             Change the password, the new one is read from stdin
+	--name=NAME            Name of the attachment, the base name of the file by default
+	--remove               Remove the attachment from the account
+	--symmetric            Encrypt the accounts with a passphrase instead of a GPG key
+	--with=KEY             Key id, fingerprint, email or armored public key file to share with
+	-i --in=PATH           Template to render with keep inject
+	-o --out=PATH          Write the copy encrypted only for the --with key, the detached file or the rendered template to PATH
+	--within=DURATION      Time window, e.g. 30d, 2w or 12h [default: 30d]
+	--username=USER        Username of the account to add
+	--notes=NOTES          Notes of the account to add
+	--generate=LENGTH      Generate a random password instead of reading it from stdin
+	--hibp=FILE            Pwned Passwords file, SHA-1 ordered by hash, downloaded from haveibeenpwned.com
+	--all                  Use the accounts of all the profiles
+	--expires=DATE         Date, e.g. 2018-06-30, or duration, e.g. 90d, after which the password should be changed
+	--older-than=DURATION  Minimum age of the passwords to report [default: 180d]
+	--confirm              Ask before each use of the SSH key served by keep ssh-agent
+	--lifetime=DURATION    Time after which keep ssh-agent forgets the SSH key, e.g. 1h
+	--socket=PATH          Unix socket of the SSH agent, in a new private directory by default
+	--env=SPEC             Environment variable set to a field of an account: VAR=ACCOUNT:FIELD
+	--mask                 Replace the secrets by ****** in the output of the command
 
 Examples:
 
+	Create the configuration file and, if needed, a GPG key pair:
+
+		keep init
+
 	Read the account information for example.com:
 
 		keep read -c example.com
+
+	Check that the company profile is correctly set up:
+
+		keep doctor -p company
+
+	Replace the key ids of the company profile by full fingerprints:
+
+		keep upgrade -p company
+
+	List the recipient keys that expire within the next 2 weeks:
+
+		keep keys expiring --within 2w
+
+	Encrypt the accounts of the finance folder to 3 keys:
+
+		keep recipients set finance alice@example.com bob@example.com 0x659FCF596A8D785C
+
+	Give a copy of an account to a contractor:
+
+		keep share example.com --with contractor.asc --out example.com.asc
+
+	Attach an SSH private key to an account and get it back:
+
+		keep attach servers/web ~/.ssh/id_rsa
+		keep detach servers/web id_rsa --out /tmp/id_rsa
+
+	Store an SSH private key in an account and serve it with an agent asking before each use:
+
+		keep ssh-key set servers/web ~/.ssh/id_ed25519 --confirm
+		keep ssh-agent servers/web
+
+	Run a command with secrets in its environment instead of a .env file:
+
+		keep exec --env DB_PASS=prod/db:password --env API_KEY=stripe:password -- ./deploy.sh
+
+	Render a configuration file from a template holding {{ keep "prod/db" "password" }} placeholders:
+
+		keep inject -i app.conf.tmpl -o app.conf
+
+	Use keep as the credential helper of git, the credentials are stored in git/<host>:
+
+		git config --global credential.helper '!keep git-credential'
+
+	Enable the completion of the commands, the profiles and the account names in bash:
+
+		source <(keep completion bash)
+
+	Add an account without prompting, the password is read from stdin:
+
+		echo "$PASSWORD" | keep add example.com --username jdoe
+
+	Rotate the password of an account, the previous one is kept in its history:
+
+		keep edit example.com --generate 20
+		keep read example.com --history
+
+	Rank the accounts from the weakest password to the strongest:
+
+		keep audit strength
+
+	List the accounts whose password has been seen in a breach:
+
+		keep audit breached --hibp pwned-passwords-sha1-ordered-by-hash-v4.txt
+
+	Find the passwords reused between the profiles:
+
+		keep audit reuse --all
+
+	List the passwords that have not been changed for a year:
+
+		keep audit age --older-than 365d
 `
 
+	// The hidden entry point of the completion scripts is not part of the usage
+	if len(os.Args) > 1 && os.Args[1] == completeCommand {
+		runComplete(os.Args[2:])
+	}
+
 	args, err := docopt.Parse(usage, nil, true, "keep cli version: 0.2", false)
 	printAndExitOnError(err, "Docopt specification cannot be parsed")
 
+	// The decrypted accounts and the passphrases must not end up in a core dump
+	if err := keep.DisableCoreDumps(); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+	}
+
+	if val, ok := args["completion"]; ok == true && val == true {
+		runCompletion(args["<shell>"].(string), usage)
+		os.Exit(exitCodeOk)
+	}
+
+	if val, ok := args["init"]; ok == true && val == true {
+		profileName, _ := args["--profile"].(string)
+		runInit(profileName, args["--symmetric"] == true)
+		os.Exit(exitCodeOk)
+	}
+
 	store, err := keep.LoadProfileStore()
 	printAndExitOnError(err, "An error occured while loading the profile store")
 
 	// defaulting to the first profile
+	profileIdx := 0
 	profile := store[0]
 	profileName, ok := args["--profile"].(string)
 	if ok {
 		profileFound := false
-		for _, p := range store {
+		for i, p := range store {
 			if profileName == p.Name {
+				profileIdx = i
 				profile = p
 				profileFound = true
 				break
@@ -79,6 +221,12 @@ Examples:
 			os.Exit(exitCodeNotOk)
 		}
 	}
+	// The git credential helper protocol is spoken on stdout and the command of keep exec
+	// or the template of keep inject may write to it, the messages of keep go to stderr
+	stdout := os.Stdout
+	if args["git-credential"] == true || args["exec"] == true || args["inject"] == true {
+		os.Stdout = os.Stderr
+	}
 	fmt.Println("Using profile : ", profile.Name)
 
 	conf := keep.NewConfig(&profile)
@@ -101,14 +249,6 @@ Examples:
 			os.Exit(exitCodeOk)
 		}
 
-		var accountPosition *int
-		snumber, ok := args["<number>"].(string)
-		if ok {
-			number, err := strconv.Atoi(snumber)
-			printAndExitOnError(err, "An error occured while converting the <number> to an int")
-			accountPosition = &number
-		}
-
 		var copyToclipboard bool
 		if val, ok := args["-c"]; ok == true && val == true {
 			copyToclipboard = true
@@ -116,53 +256,34 @@ Examples:
 			copyToclipboard = true
 		}
 
-		files, err := conf.ListAccountFiles(fname)
-		printAndExitOnError(err, "An error occured while gathering the accounts")
-		switch l := len(files); {
-		case l == 1:
-			// Automatically fallback to the first match if there is only one option
-			f := files[0]
-			fname = f.Name()
-		case l > 1 && accountPosition != nil && *accountPosition < l:
-			// If there is more than one option and an accountPosition is given we are going to use it
-			f := files[*accountPosition]
-			fname = f.Name()
-		case l == 0:
-			// 0 matching account
-			fmt.Println("No account name match :", fname)
-			os.Exit(exitCodeNotOk)
-		default:
-			// We couldn't guess what to do so we list all the options
-			fmt.Println("There is more than one match")
-			printFileNames(files)
-			os.Exit(exitCodeNotOk)
-		}
-
-		account, err := keep.NewAccountFromFile(conf, fname)
-		if os.IsNotExist(err) {
-			fmt.Printf("Account name (%s) does not exist.\n Listing ...\n\n", fname)
-			os.Exit(exitCodeNotOk)
-		}
-		printAndExitOnError(err, "An error occured while creating and account from the clear text reader")
+		fname = selectAccountFile(conf, fname, args)
+		account := readAccount(conf, fname)
 
 		fmt.Println("file path :", account.Path())
 		if account.IsSigned && account.SignedBy != nil {
 			fmt.Printf("Credentials have been signed by : %s\n\n", account.SignedBy.PrivateKey.KeyIdShortString())
-		} else {
+		} else if !conf.IsSymmetric() {
 			fmt.Printf("\nWARNING: This credential is not signed !!!\n\n")
 		}
 
 		fmt.Println("Name : ", account.Name)
 		fmt.Println("Username : ", account.Username)
 		fmt.Println("Notes : ", account.Notes)
+		printTimestamps(account)
+		printShares(account.Shares)
+		printAttachments(account.Attachments)
+		printSSHKey(account)
 		if printOpt, ok := args["--print"]; ok && printOpt.(bool) == true {
-			fmt.Println("Password : ", account.Password)
+			fmt.Println("Password : ", string(account.Password.Bytes()))
+		}
+		if args["--history"] == true {
+			printHistory(account)
 		}
 
 		if copyToclipboard {
 			// Grab the original clipboard value before changing it
 			original, err := clipboard.ReadAll()
-			err = clipboard.WriteAll(account.Password)
+			err = clipboard.WriteAll(string(account.Password.Bytes()))
 			printAndExitOnError(err, "An error occured while writing the password to the clipboard")
 			defer func(s string) {
 				// restore the clipboard with the original value after 15s
@@ -183,19 +304,226 @@ Examples:
 
 	} else if val, ok := args["add"]; ok == true && val == true {
 		fmt.Printf("Adding ...\n\n")
-		account, err := keep.NewAccountFromConsole(conf)
-		printAndExitOnError(err, "An error occured while retrieving account info from the console :")
-
-		content, err := account.Encrypt()
-		printAndExitOnError(err, "An error occured while encrypting the account to bytes")
+		var account *keep.Account
+		if name, ok := args["<file>"].(string); ok {
+			account = newAccountFromArgs(conf, name, args)
+		} else {
+			account, err = keep.NewAccountFromConsole(conf)
+			printAndExitOnError(err, "An error occured while retrieving account info from the console :")
+		}
+		if expires, ok := args["--expires"].(string); ok {
+			account.Expires, err = keep.ParseDate(expires)
+			printAndExitOnError(err, "An error occured while parsing --expires")
+		}
 
-		fpath := filepath.Join(conf.AccountDir, account.Name)
+		fpath := account.Path()
 		if _, err := os.Stat(fpath); !os.IsNotExist(err) {
 			fmt.Printf("Account %s already exists\n", fpath)
 			os.Exit(exitCodeNotOk)
 		}
 		fmt.Println("Writing file :", fpath)
-		err = ioutil.WriteFile(fpath, content, 0600)
+		err = account.Save()
 		printAndExitOnError(err, "An error occured while writing the new account to disk")
+	} else if val, ok := args["edit"]; ok == true && val == true {
+		fname := selectAccountFile(conf, args["<file>"].(string), args)
+		account := readAccount(conf, fname)
+		fmt.Printf("Editing %s ...\n\n", account.Name)
+		editAccount(account, args)
+		if expires, ok := args["--expires"].(string); ok {
+			account.Expires, err = keep.ParseDate(expires)
+			printAndExitOnError(err, "An error occured while parsing --expires")
+		}
+		err = account.Save()
+		printAndExitOnError(err, "An error occured while writing the account to disk")
+		fmt.Println("Account written :", account.Path())
+	} else if val, ok := args["doctor"]; ok == true && val == true {
+		fmt.Printf("Diagnosing ...\n\n")
+		if !printCheckResults(conf.Doctor()) {
+			os.Exit(exitCodeNotOk)
+		}
+	} else if val, ok := args["upgrade"]; ok == true && val == true {
+		fmt.Printf("Upgrading ...\n\n")
+		upgraded, err := profile.Upgrade()
+		printAndExitOnError(err, "An error occured while upgrading the profile")
+		fmt.Println("RecipientKeyIds : ", upgraded.RecipientKeyIds)
+		fmt.Println("SignerKeyID : ", upgraded.SignerKeyID)
+		store[profileIdx] = upgraded
+		err = keep.SaveProfileStore(store)
+		printAndExitOnError(err, "An error occured while saving the profile store")
+	} else if val, ok := args["recipients"]; ok == true && val == true {
+		folder := args["<folder>"].(string)
+		keys := strings.Join(args["<keys>"].([]string), " ")
+		fmt.Printf("Setting the recipients of %s ...\n\n", folder)
+		reencrypted, err := conf.SetFolderRecipients(folder, keys)
+		for _, name := range reencrypted {
+			fmt.Println("Re-encrypted :", name)
+		}
+		printAndExitOnError(err, "An error occured while setting the recipients")
+		fmt.Printf("%d account(s) re-encrypted\n", len(reencrypted))
+	} else if val, ok := args["share"]; ok == true && val == true {
+		fname := selectAccountFile(conf, args["<file>"].(string), args)
+		account := readAccount(conf, fname)
+		with := args["--with"].(string)
+		if _, err := os.Stat(with); err == nil {
+			with = importPublicKeys(conf, with)
+		}
+		out, _ := args["--out"].(string)
+		fmt.Printf("Sharing %s with %s ...\n\n", account.Name, with)
+		err = account.Share(with, out)
+		printAndExitOnError(err, "An error occured while sharing the account")
+		if out != "" {
+			fmt.Println("Shared copy written to :", out)
+		} else {
+			fmt.Println("Account re-encrypted :", account.Path())
+		}
+	} else if val, ok := args["unshare"]; ok == true && val == true {
+		fname := selectAccountFile(conf, args["<file>"].(string), args)
+		account := readAccount(conf, fname)
+		with, _ := args["--with"].(string)
+		removed, err := account.Unshare(with)
+		printAndExitOnError(err, "An error occured while removing the shares")
+		fmt.Printf("%d share(s) removed from %s\n", removed, account.Name)
+	} else if val, ok := args["shares"]; ok == true && val == true {
+		fmt.Printf("Listing the shared accounts ...\n\n")
+		shared, err := conf.ListShares()
+		printAndExitOnError(err, "An error occured while gathering the shared accounts")
+		for _, a := range shared {
+			fmt.Println(a.Name)
+			printShares(a.Shares)
+		}
+	} else if val, ok := args["strength"]; ok == true && val == true && args["audit"] == true {
+		fmt.Printf("Auditing the password strength ...\n\n")
+		strengths, err := conf.AuditStrength()
+		printAndExitOnError(err, "An error occured while auditing the accounts")
+		printStrengths(strengths)
+	} else if val, ok := args["breached"]; ok == true && val == true && args["audit"] == true {
+		fmt.Printf("Auditing the breached passwords ...\n\n")
+		h, err := keep.OpenHIBPFile(args["--hibp"].(string))
+		printAndExitOnError(err, "An error occured while opening the Pwned Passwords file")
+		defer h.Close()
+		breached, err := conf.AuditBreached(h)
+		printAndExitOnError(err, "An error occured while auditing the accounts")
+		printBreached(breached)
+	} else if val, ok := args["reuse"]; ok == true && val == true && args["audit"] == true {
+		fmt.Printf("Auditing the reused passwords ...\n\n")
+		configs := map[string]*keep.Config{profile.Name: conf}
+		if args["--all"] == true {
+			for i := range store {
+				if store[i].Name != profile.Name {
+					configs[store[i].Name] = keep.NewConfig(&store[i])
+				}
+			}
+		}
+		groups, err := keep.AuditReuse(configs)
+		printAndExitOnError(err, "An error occured while auditing the accounts")
+		printReuseGroups(groups)
+	} else if val, ok := args["age"]; ok == true && val == true && args["audit"] == true {
+		olderThan, err := keep.ParseDuration(args["--older-than"].(string))
+		printAndExitOnError(err, "An error occured while parsing --older-than")
+		fmt.Printf("Auditing the password age ...\n\n")
+		old, err := conf.AuditAge(olderThan)
+		printAndExitOnError(err, "An error occured while auditing the accounts")
+		if len(old) == 0 {
+			fmt.Println("No password is older than", args["--older-than"])
+		}
+		printAccountDates(old, "changed on", "unknown age")
+	} else if val, ok := args["expiring"]; ok == true && val == true && args["keys"] != true {
+		within, err := keep.ParseDuration(args["--within"].(string))
+		printAndExitOnError(err, "An error occured while parsing --within")
+		expiring, err := conf.ExpiringAccounts(within)
+		printAndExitOnError(err, "An error occured while gathering the accounts")
+		if len(expiring) == 0 {
+			fmt.Println("No account expires within", args["--within"])
+		}
+		printAccountDates(expiring, "expires on", "")
+	} else if val, ok := args["attach"]; ok == true && val == true {
+		fname := selectAccountFile(conf, args["<file>"].(string), args)
+		account := readAccount(conf, fname)
+		fpath := args["<path>"].(string)
+		name, ok := args["--name"].(string)
+		if !ok {
+			name = filepath.Base(fpath)
+		}
+		f, err := os.Open(fpath)
+		printAndExitOnError(err, "An error occured while opening the file to attach")
+		defer f.Close()
+		fmt.Printf("Attaching %s to %s ...\n\n", name, account.Name)
+		err = account.Attach(name, f)
+		printAndExitOnError(err, "An error occured while attaching the file")
+		fmt.Println("Attachment written :", account.AttachmentPath(name))
+	} else if val, ok := args["detach"]; ok == true && val == true {
+		fname := selectAccountFile(conf, args["<file>"].(string), args)
+		account := readAccount(conf, fname)
+		name := args["<name>"].(string)
+		out, ok := args["--out"].(string)
+		if ok || args["--remove"] != true {
+			if !ok {
+				out = name
+			}
+			detachAttachment(account, name, out)
+		}
+		if args["--remove"] == true {
+			err = account.RemoveAttachment(name)
+			printAndExitOnError(err, "An error occured while removing the attachment")
+			fmt.Printf("%s removed from %s\n", name, account.Name)
+		}
+	} else if val, ok := args["ssh-key"]; ok == true && val == true {
+		fname := selectAccountFile(conf, args["<file>"].(string), args)
+		account := readAccount(conf, fname)
+		if args["remove"] == true {
+			account.SSHKey = nil
+		} else {
+			var lifetime time.Duration
+			if slifetime, ok := args["--lifetime"].(string); ok {
+				lifetime, err = keep.ParseDuration(slifetime)
+				printAndExitOnError(err, "An error occured while parsing --lifetime")
+			}
+			pem, err := ioutil.ReadFile(args["<keyfile>"].(string))
+			printAndExitOnError(err, "An error occured while reading the SSH key")
+			err = account.SetSSHKey(pem, args["--confirm"] == true, lifetime)
+			printAndExitOnError(err, "An error occured while setting the SSH key")
+		}
+		err = account.Save()
+		printAndExitOnError(err, "An error occured while writing the account")
+		fmt.Println("Account written :", account.Path())
+		printSSHKey(account)
+	} else if val, ok := args["ssh-agent"]; ok == true && val == true {
+		var names []string
+		for _, name := range args["<account>"].([]string) {
+			names = append(names, selectAccountFile(conf, name, args))
+		}
+		socket, _ := args["--socket"].(string)
+		runSSHAgent(conf, names, socket)
+	} else if val, ok := args["exec"]; ok == true && val == true {
+		specs, _ := args["--env"].([]string)
+		runExec(conf, specs, args["<command>"].([]string), args["--mask"] == true, stdout)
+	} else if val, ok := args["inject"]; ok == true && val == true {
+		out := args["--out"].(string)
+		err = conf.InjectTemplate(args["--in"].(string), out)
+		printAndExitOnError(err, "An error occured while rendering the template")
+		fmt.Println("File written :", out)
+	} else if val, ok := args["git-credential"]; ok == true && val == true {
+		runGitCredential(conf, args["<operation>"].(string), stdout)
+	} else if val, ok := args["expiring"]; ok == true && val == true && args["keys"] == true {
+		within, err := keep.ParseDuration(args["--within"].(string))
+		printAndExitOnError(err, "An error occured while parsing --within")
+		keys, err := conf.ExpiringRecipients(within)
+		printAndExitOnError(err, "An error occured while gathering the recipient keys")
+		if len(keys) == 0 {
+			fmt.Println("No recipient key expires within", args["--within"])
+		}
+		for _, k := range keys {
+			status := "never expires"
+			if !k.Expires.IsZero() {
+				status = "expires on " + k.Expires.Format("2006-01-02")
+				if k.Expires.Before(time.Now()) {
+					status = "expired on " + k.Expires.Format("2006-01-02")
+				}
+			}
+			if k.Revoked {
+				status = "revoked, " + status
+			}
+			fmt.Printf("%s %s : %s\n", k.Fingerprint, k.Identity, status)
+		}
 	}
 }
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/yml/keep"
+)
+
+// importPublicKeys imports the armored public keys of the file into the pubring
+// of the profile and returns their fingerprints.
+func importPublicKeys(conf *keep.Config, fpath string) string {
+	f, err := os.Open(fpath)
+	printAndExitOnError(err, "An error occured while opening the public key")
+	defer f.Close()
+	el, err := conf.ImportPublicKeys(f)
+	printAndExitOnError(err, "An error occured while importing the public key")
+	fprs := make([]string, len(el))
+	for i, e := range el {
+		fprs[i] = keep.Fingerprint(e.PrimaryKey)
+		fmt.Println("Imported :", fprs[i])
+	}
+	return strings.Join(fprs, " ")
+}
+
+func printShares(shares []keep.Share) {
+	for _, s := range shares {
+		fmt.Printf("\tShared with %s on %s", s.Recipients, s.At.Format("2006-01-02"))
+		if s.Out != "" {
+			fmt.Printf(" (copy written to %s)", s.Out)
+		}
+		fmt.Printf("\n")
+	}
+}
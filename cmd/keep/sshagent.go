@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/yml/keep"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// printSSHKey prints the fingerprint and the constraints of the SSH key of the account, if any.
+func printSSHKey(account *keep.Account) {
+	if account.SSHKey == nil {
+		return
+	}
+	pub, err := account.SSHKey.PublicKey()
+	if err != nil {
+		fmt.Println("SSH key : invalid,", err)
+		return
+	}
+	constraints := []string{}
+	if account.SSHKey.Confirm {
+		constraints = append(constraints, "confirm")
+	}
+	if account.SSHKey.Lifetime > 0 {
+		constraints = append(constraints, "lifetime "+account.SSHKey.Lifetime.String())
+	}
+	fmt.Printf("SSH key : %s %s", pub.Type(), ssh.FingerprintSHA256(pub))
+	if len(constraints) > 0 {
+		fmt.Printf(" (%s)", strings.Join(constraints, ", "))
+	}
+	fmt.Println()
+}
+
+// confirmKeyUse asks the user whether the key can be used, with $SSH_ASKPASS
+// like ssh-agent if it is set or on the terminal of the agent otherwise.
+func confirmKeyUse(k *agent.Key) bool {
+	question := fmt.Sprintf("Allow the use of the key %s %s?", k.Comment, ssh.FingerprintSHA256(k))
+	if askpass := os.Getenv("SSH_ASKPASS"); askpass != "" {
+		cmd := exec.Command(askpass, question)
+		cmd.Env = append(os.Environ(), "SSH_ASKPASS_PROMPT=confirm")
+		return cmd.Run() == nil
+	}
+	tty, err := os.OpenFile("/dev/tty", os.O_RDWR, 0)
+	if err != nil {
+		fmt.Println("Refusing the use of", k.Comment, "as there is no way to confirm it :", err)
+		return false
+	}
+	defer tty.Close()
+	fmt.Fprintf(tty, "%s [y/N] ", question)
+	answer, _ := bufio.NewReader(tty).ReadString('\n')
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
+
+// runSSHAgent serves the SSH keys of the accounts on a Unix socket until the program is interrupted.
+// The keys are only held in memory.
+func runSSHAgent(conf *keep.Config, names []string, socket string) {
+	a := keep.NewSSHAgent()
+	a.Confirm = confirmKeyUse
+	loaded, err := conf.LoadSSHKeys(a, names...)
+	printAndExitOnError(err, "An error occured while loading the SSH keys")
+	if len(loaded) == 0 {
+		fmt.Println("No account holds an SSH key")
+		os.Exit(exitCodeNotOk)
+	}
+	for _, name := range loaded {
+		fmt.Println("SSH key loaded :", name)
+	}
+
+	if socket == "" {
+		dir, err := ioutil.TempDir("", "keep-ssh-")
+		printAndExitOnError(err, "An error occured while creating the directory of the socket")
+		defer os.RemoveAll(dir)
+		socket = filepath.Join(dir, "agent.sock")
+	}
+	l, err := net.Listen("unix", socket)
+	printAndExitOnError(err, "An error occured while listening on the socket")
+	// Only the user can connect to the socket, the temporary directory is already private
+	err = os.Chmod(socket, 0600)
+	if err != nil {
+		l.Close()
+		printAndExitOnError(err, "An error occured while restricting the access to the socket")
+	}
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM, syscall.SIGHUP)
+	go func() {
+		<-sigs
+		l.Close()
+	}()
+
+	fmt.Printf("\nSSH_AUTH_SOCK=%s; export SSH_AUTH_SOCK;\n", socket)
+	fmt.Println("The agent runs until it is interrupted with Ctrl-C")
+	keep.ServeSSHAgent(l, a)
+	// Closing the listener has removed the socket
+	fmt.Println("SSH agent stopped")
+}
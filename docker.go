@@ -0,0 +1,102 @@
+package keep
+
+import (
+	"errors"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// DockerCredentialPrefix is the folder of the accounts of the docker credential helper.
+const DockerCredentialPrefix = "docker/"
+
+// ErrDockerCredentialNotFound is returned when there is no account for a registry,
+// docker recognizes this message.
+var ErrDockerCredentialNotFound = errors.New("credentials not found in native keychain")
+
+// DockerCredential is a credential exchanged with docker by a credential helper,
+// see https://github.com/docker/docker-credential-helpers
+type DockerCredential struct {
+	ServerURL string
+	Username  string
+	Secret    string
+}
+
+// DockerAccountName returns the name of the account of a registry: docker/<host>[/<path>].
+func DockerAccountName(serverURL string) string {
+	s := serverURL
+	if u, err := url.Parse(serverURL); err == nil && u.Host != "" {
+		s = u.Host + u.Path
+	}
+	return DockerCredentialPrefix + strings.Trim(s, "/")
+}
+
+// GetDockerCredential returns the credential of the registry.
+func (c *Config) GetDockerCredential(serverURL string) (*DockerCredential, error) {
+	a, err := NewAccountFromFile(c, DockerAccountName(serverURL))
+	if os.IsNotExist(err) {
+		return nil, ErrDockerCredentialNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &DockerCredential{ServerURL: serverURL, Username: a.Username, Secret: string(a.Password.Bytes())}, nil
+}
+
+// StoreDockerCredential encrypts the credential to the recipients of its account, creating it if needed.
+func (c *Config) StoreDockerCredential(cred *DockerCredential) error {
+	name := DockerAccountName(cred.ServerURL)
+	a, err := NewAccountFromFile(c, name)
+	if os.IsNotExist(err) {
+		a, err = NewAccount(c, name, "", "", ""), nil
+	}
+	if err != nil {
+		return err
+	}
+	err = a.SetField(fieldURL, cred.ServerURL)
+	if err != nil {
+		return err
+	}
+	a.Username, a.Password = cred.Username, NewSecret([]byte(cred.Secret))
+	return a.Save()
+}
+
+// EraseDockerCredential removes the account of the registry.
+func (c *Config) EraseDockerCredential(serverURL string) error {
+	a, err := NewAccountFromFile(c, DockerAccountName(serverURL))
+	if os.IsNotExist(err) {
+		return ErrDockerCredentialNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return a.Remove()
+}
+
+// ListDockerCredentials returns the usernames of the registries stored in the docker folder by server URL.
+func (c *Config) ListDockerCredentials() (map[string]string, error) {
+	registries := make(map[string]string)
+	files, err := c.ListAccountFiles(DockerCredentialPrefix)
+	if os.IsNotExist(err) {
+		return registries, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if !strings.HasPrefix(f.Name(), DockerCredentialPrefix) {
+			continue
+		}
+		a, err := NewAccountFromFile(c, f.Name())
+		if err != nil {
+			return nil, err
+		}
+		serverURL, err := a.Field(fieldURL)
+		if err != nil {
+			// The account has been added by hand
+			serverURL = strings.TrimPrefix(a.Name, DockerCredentialPrefix)
+		}
+		registries[serverURL] = a.Username
+	}
+	return registries, nil
+}
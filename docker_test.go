@@ -0,0 +1,86 @@
+package keep
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func Test_DockerAccountName(t *testing.T) {
+	tests := map[string]string{
+		"https://index.docker.io/v1/":  "docker/index.docker.io/v1",
+		"registry.example.com":         "docker/registry.example.com",
+		"registry.example.com:5000/":   "docker/registry.example.com:5000",
+		"https://registry.example.com": "docker/registry.example.com",
+	}
+	for in, expected := range tests {
+		if got := DockerAccountName(in); got != expected {
+			t.Errorf("%s: got : %s - expected : %s", in, got, expected)
+		}
+	}
+}
+
+func Test_Config_DockerCredential(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-docker")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = filepath.Join(dir, "passwords")
+
+	if registries, err := c.ListDockerCredentials(); err != nil || len(registries) != 0 {
+		t.Error("Expected no registry; got :", registries, err)
+	}
+	if _, err := c.GetDockerCredential("https://registry.example.com"); err != ErrDockerCredentialNotFound {
+		t.Error("Expected ErrDockerCredentialNotFound; got :", err)
+	}
+
+	cred := &DockerCredential{ServerURL: "https://registry.example.com", Username: "ci", Secret: "t0ken"}
+	if err := c.StoreDockerCredential(cred); err != nil {
+		t.Fatal("An error occured while storing the credential", err)
+	}
+	if err := NewAccount(c, "mail", "me", "p", "").Save(); err != nil {
+		t.Fatal(err)
+	}
+	got, err := c.GetDockerCredential("https://registry.example.com")
+	if err != nil || *got != *cred {
+		t.Errorf("got : %v %v - expected : %v", got, err, cred)
+	}
+	registries, err := c.ListDockerCredentials()
+	expected := map[string]string{"https://registry.example.com": "ci"}
+	if err != nil || !reflect.DeepEqual(registries, expected) {
+		t.Errorf("got : %v %v - expected : %v", registries, err, expected)
+	}
+
+	if err := c.EraseDockerCredential("https://registry.example.com"); err != nil {
+		t.Fatal("An error occured while erasing the credential", err)
+	}
+	if _, err := c.GetDockerCredential("https://registry.example.com"); err != ErrDockerCredentialNotFound {
+		t.Error("Expected the credential to be erased; got :", err)
+	}
+}
+
+func Test_Config_StoreDockerCredential_LineBreak(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-docker")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = filepath.Join(dir, "passwords")
+
+	for _, cred := range []*DockerCredential{
+		{ServerURL: "registry.example.com", Username: "ci\nX-Injected: value", Secret: "t0ken"},
+		{ServerURL: "registry.example.com", Username: "ci", Secret: "t0ken\r\nnotes"},
+	} {
+		if err := c.StoreDockerCredential(cred); err == nil {
+			t.Errorf("Expected the credential %q %q to be refused", cred.Username, cred.Secret)
+		}
+	}
+	if _, err := c.GetDockerCredential("registry.example.com"); err != ErrDockerCredentialNotFound {
+		t.Error("Expected no credential to be stored; got :", err)
+	}
+}
@@ -0,0 +1,297 @@
+package keep
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/jcmdev0/gpgagent"
+
+	"golang.org/x/crypto/openpgp"
+)
+
+// CheckStatus is the outcome of a single diagnostic.
+type CheckStatus int
+
+// The possible outcomes of a diagnostic.
+const (
+	CheckPass CheckStatus = iota
+	CheckWarn
+	CheckFail
+)
+
+func (s CheckStatus) String() string {
+	switch s {
+	case CheckPass:
+		return "PASS"
+	case CheckWarn:
+		return "WARN"
+	default:
+		return "FAIL"
+	}
+}
+
+// CheckResult represents the result of a diagnostic run by Doctor.
+type CheckResult struct {
+	Name   string
+	Status CheckStatus
+	Detail string
+}
+
+func passCheck(name, format string, a ...interface{}) CheckResult {
+	return CheckResult{Name: name, Status: CheckPass, Detail: fmt.Sprintf(format, a...)}
+}
+
+func warnCheck(name, format string, a ...interface{}) CheckResult {
+	return CheckResult{Name: name, Status: CheckWarn, Detail: fmt.Sprintf(format, a...)}
+}
+
+func failCheck(name, format string, a ...interface{}) CheckResult {
+	return CheckResult{Name: name, Status: CheckFail, Detail: fmt.Sprintf(format, a...)}
+}
+
+// hasEncryptionKey reports whether the entity has a key that can be used to encrypt a message to it.
+// It mirrors the selection done by openpgp.Encrypt.
+func hasEncryptionKey(e *openpgp.Entity, now time.Time) bool {
+	for _, subkey := range e.Subkeys {
+		if subkey.Sig.FlagsValid &&
+			subkey.Sig.FlagEncryptCommunications &&
+			subkey.PublicKey.PubKeyAlgo.CanEncrypt() &&
+			!subkey.Sig.KeyExpired(now) {
+			return true
+		}
+	}
+	for _, i := range e.Identities {
+		if !i.SelfSignature.FlagsValid || i.SelfSignature.FlagEncryptCommunications &&
+			e.PrimaryKey.PubKeyAlgo.CanEncrypt() &&
+			!i.SelfSignature.KeyExpired(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasSigningKey reports whether the entity has a key that can be used to sign a message.
+func hasSigningKey(e *openpgp.Entity, now time.Time) bool {
+	for _, subkey := range e.Subkeys {
+		if subkey.Sig.FlagsValid &&
+			subkey.Sig.FlagSign &&
+			subkey.PublicKey.PubKeyAlgo.CanSign() &&
+			!subkey.Sig.KeyExpired(now) {
+			return true
+		}
+	}
+	for _, i := range e.Identities {
+		if !i.SelfSignature.FlagsValid || i.SelfSignature.FlagSign &&
+			!i.SelfSignature.KeyExpired(now) {
+			return true
+		}
+	}
+	return false
+}
+
+// isShortKeyID returns true if id is a 32-bit key id, these ids are trivially collidable.
+func isShortKeyID(id string) bool {
+	return len(strings.TrimPrefix(strings.ToLower(id), "0x")) == 8 && !strings.Contains(id, "@")
+}
+
+func checkKeyring(name, path string) (openpgp.EntityList, CheckResult) {
+	el, err := getKeyRing(path)
+	if err != nil {
+		return nil, failCheck(name, "%s cannot be read: %v", path, err)
+	}
+	if len(el) == 0 {
+		return nil, failCheck(name, "%s does not contain any key", path)
+	}
+	return el, passCheck(name, "%s contains %d key(s)", path, len(el))
+}
+
+func checkPermissions(name, path string, dir bool) CheckResult {
+	fi, err := os.Stat(path)
+	if err != nil {
+		return failCheck(name, "%v", err)
+	}
+	if dir && !fi.IsDir() {
+		return failCheck(name, "%s is not a directory", path)
+	}
+	perm := fi.Mode().Perm()
+	switch {
+	case perm&0002 != 0:
+		return failCheck(name, "%s is world writable (%v)", path, perm)
+	case perm&0004 != 0:
+		return warnCheck(name, "%s is world readable (%v)", path, perm)
+	}
+	return passCheck(name, "%s (%v)", path, perm)
+}
+
+// Doctor runs a set of diagnostics against the Config and returns a report.
+// It checks the keyrings, the recipients and signer key ids, the permissions
+// of the files involved, the gpg-agent and finally round-trips a test account.
+func (c *Config) Doctor() []CheckResult {
+	var results []CheckResult
+	now := time.Now()
+
+	if c.IsSymmetric() {
+		results = append(results, passCheck("profile type", "symmetric, accounts are encrypted with a passphrase"))
+		results = append(results, checkPermissions("account dir", c.AccountDir, true))
+		results = append(results, c.checkRoundTrip())
+		return results
+	}
+
+	pubring, res := checkKeyring("pubring", c.PubringDir)
+	results = append(results, res)
+	secring, res := checkKeyring("secring", c.SecringDir)
+	results = append(results, res)
+
+	if secring != nil {
+		if len(secring.DecryptionKeys()) == 0 {
+			results = append(results, failCheck("secret keys", "%s does not contain any secret key", c.SecringDir))
+		} else {
+			results = append(results, passCheck("secret keys", "%d secret key(s) available", len(secring.DecryptionKeys())))
+		}
+	}
+
+	recipients := strings.Fields(c.RecipientKeyIds)
+	if len(recipients) == 0 {
+		results = append(results, failCheck("recipients", "no RecipientKeyIds configured"))
+	}
+	canDecrypt := false
+	for _, r := range recipients {
+		name := "recipient " + r
+		if pubring == nil {
+			results = append(results, failCheck(name, "pubring is not available"))
+			continue
+		}
+		el, err := filterEntityList(pubring, r)
+		switch {
+		case err != nil:
+			results = append(results, failCheck(name, "%v", err))
+		case !hasEncryptionKey(el[0], now):
+			results = append(results, failCheck(name, "%s has no usable encryption key", Fingerprint(el[0].PrimaryKey)))
+		case isShortKeyID(r):
+			results = append(results, warnCheck(name, "%s is a short key id, run keep upgrade to store fingerprints", r))
+		default:
+			results = append(results, passCheck(name, "%s", Fingerprint(el[0].PrimaryKey)))
+		}
+		if err == nil && secring != nil && len(secring.KeysById(el[0].PrimaryKey.KeyId)) > 0 {
+			canDecrypt = true
+		}
+	}
+	if pubring != nil {
+		results = append(results, c.checkRecipientsFiles(pubring, now)...)
+	}
+	if len(recipients) > 0 && secring != nil && !canDecrypt {
+		results = append(results, warnCheck("decryption", "none of the recipients has a secret key in %s", c.SecringDir))
+	}
+
+	if c.SignerKeyID == "" {
+		results = append(results, warnCheck("signer", "no SignerKeyID configured, accounts will not be signed"))
+	} else if secring != nil {
+		el, err := filterEntityList(secring, c.SignerKeyID)
+		switch {
+		case err != nil:
+			results = append(results, failCheck("signer", "%v", err))
+		case len(el) != 1:
+			results = append(results, failCheck("signer", "exactly one SignerKeyID must be given, received : %d", len(el)))
+		case el[0].PrivateKey == nil:
+			results = append(results, failCheck("signer", "no secret key for %s", c.SignerKeyID))
+		case !hasSigningKey(el[0], now):
+			results = append(results, failCheck("signer", "%s has no usable signing key", c.SignerKeyID))
+		case isShortKeyID(c.SignerKeyID):
+			results = append(results, warnCheck("signer", "%s is a short key id, run keep upgrade to store fingerprints", c.SignerKeyID))
+		default:
+			results = append(results, passCheck("signer", "%s", Fingerprint(el[0].PrimaryKey)))
+		}
+	}
+
+	results = append(results, checkPermissions("account dir", c.AccountDir, true))
+	if secring != nil {
+		results = append(results, checkPermissions("secring permissions", c.SecringDir, false))
+	}
+
+	conn, err := gpgagent.NewGpgAgentConn()
+	if err != nil {
+		results = append(results, warnCheck("gpg-agent", "not reachable: %v", err))
+	} else {
+		conn.Close()
+		results = append(results, passCheck("gpg-agent", "reachable"))
+	}
+
+	results = append(results, c.checkRoundTrip())
+	return results
+}
+
+// checkRecipientsFiles makes sure that the keys listed in the .keep-recipients files can be used.
+func (c *Config) checkRecipientsFiles(pubring openpgp.EntityList, now time.Time) []CheckResult {
+	var results []CheckResult
+	filepath.Walk(c.AccountDir, func(fpath string, f os.FileInfo, err error) error {
+		if err != nil || f.Name() != RecipientsFile {
+			return nil
+		}
+		name, _ := filepath.Rel(c.AccountDir, fpath)
+		ids, err := readRecipientsFile(fpath)
+		if err != nil {
+			results = append(results, failCheck(name, "%v", err))
+			return nil
+		}
+		el, err := filterEntityList(pubring, ids)
+		if err != nil {
+			results = append(results, failCheck(name, "%v", err))
+			return nil
+		}
+		for _, e := range el {
+			if !hasEncryptionKey(e, now) {
+				results = append(results, failCheck(name, "%s has no usable encryption key", Fingerprint(e.PrimaryKey)))
+				return nil
+			}
+		}
+		results = append(results, passCheck(name, "%d recipient(s)", len(el)))
+		return nil
+	})
+	return results
+}
+
+// checkRoundTrip encrypts a test account and decrypts it back.
+func (c *Config) checkRoundTrip() CheckResult {
+	name := "round trip"
+	a := &Account{
+		config:   c,
+		Name:     "keep-doctor",
+		Username: "doctor",
+		Password: NewSecret([]byte("round-trip")),
+		Notes:    "generated by keep doctor",
+	}
+	crypt, err := a.Encrypt()
+	if err != nil {
+		return failCheck(name, "encryption failed: %v", err)
+	}
+	md, err := c.decode(bytes.NewReader(crypt))
+	if err != nil {
+		return failCheck(name, "decryption failed: %v", err)
+	}
+	clear, err := ioutil.ReadAll(md.UnverifiedBody)
+	if err != nil {
+		return failCheck(name, "decryption failed: %v", err)
+	}
+	if !bytes.Equal(clear, a.Bytes()) {
+		return failCheck(name, "decrypted content does not match")
+	}
+	if md.IsSigned && md.SignatureError != nil {
+		return failCheck(name, "invalid signature: %v", md.SignatureError)
+	}
+	return passCheck(name, "account encrypted and decrypted")
+}
+
+// HasFailure returns true if at least one of the results is a failure.
+func HasFailure(results []CheckResult) bool {
+	for _, r := range results {
+		if r.Status == CheckFail {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,26 @@
+package keep
+
+import (
+	"testing"
+)
+
+func Test_Config_Doctor(t *testing.T) {
+	c := NewConfig(nil)
+	c.AccountDir = "test_data/passwords"
+	results := c.Doctor()
+	for _, r := range results {
+		if r.Status == CheckFail {
+			t.Errorf("Unexpected failure for %s : %s", r.Name, r.Detail)
+		}
+	}
+}
+
+func Test_Config_Doctor_UnknownRecipient(t *testing.T) {
+	c := NewConfig(nil)
+	c.AccountDir = "test_data/passwords"
+	c.RecipientKeyIds = "DEADBEEF"
+	results := c.Doctor()
+	if !HasFailure(results) {
+		t.Error("Expected a failure for an unknown recipient; got :", results)
+	}
+}
@@ -0,0 +1,17 @@
+package keep
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+// disableDumpable also prevents the other processes of the user from attaching to the process
+// or reading its memory through /proc.
+func disableDumpable() error {
+	err := unix.Prctl(unix.PR_SET_DUMPABLE, 0, 0, 0, 0)
+	if err != nil {
+		return fmt.Errorf("An error occured while marking the process as not dumpable : %s", err)
+	}
+	return nil
+}
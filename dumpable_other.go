@@ -0,0 +1,8 @@
+//go:build darwin || dragonfly || freebsd || netbsd || openbsd || solaris
+// +build darwin dragonfly freebsd netbsd openbsd solaris
+
+package keep
+
+func disableDumpable() error {
+	return nil
+}
@@ -0,0 +1,164 @@
+package keep
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// SecretRef designates a field of an account, see Account.Field.
+type SecretRef struct {
+	Account string
+	Field   string
+}
+
+// String returns the representation of a SecretRef: "<account>:<field>".
+func (r SecretRef) String() string {
+	return r.Account + ":" + r.Field
+}
+
+// ParseSecretRef parses "<account>:<field>", the field is the password when it is omitted.
+func ParseSecretRef(s string) (SecretRef, error) {
+	ref := SecretRef{Account: s, Field: "password"}
+	if i := strings.LastIndex(s, ":"); i >= 0 {
+		ref.Account, ref.Field = s[:i], s[i+1:]
+	}
+	if ref.Account == "" || ref.Field == "" {
+		return SecretRef{}, fmt.Errorf("Invalid secret reference %q, expected <account>:<field>", s)
+	}
+	return ref, nil
+}
+
+// secretResolver returns the fields of the accounts, decrypting each account only once.
+type secretResolver struct {
+	config   *Config
+	accounts map[string]*Account
+}
+
+func newSecretResolver(c *Config) *secretResolver {
+	return &secretResolver{config: c, accounts: make(map[string]*Account)}
+}
+
+func (r *secretResolver) resolve(ref SecretRef) (string, error) {
+	a, ok := r.accounts[ref.Account]
+	if !ok {
+		var err error
+		a, err = NewAccountFromFile(r.config, ref.Account)
+		if err != nil {
+			return "", fmt.Errorf("An error occured while reading the account %s : %v", ref.Account, err)
+		}
+		r.accounts[ref.Account] = a
+	}
+	return a.Field(ref.Field)
+}
+
+var envNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// EnvSecret is an environment variable set to a field of an account.
+type EnvSecret struct {
+	Name  string
+	Ref   SecretRef
+	Value string
+}
+
+// ParseEnvSecret parses "<VAR>=<account>:<field>", the value is not resolved.
+func ParseEnvSecret(spec string) (EnvSecret, error) {
+	parts := strings.SplitN(spec, "=", 2)
+	if len(parts) != 2 || !envNameRegexp.MatchString(parts[0]) {
+		return EnvSecret{}, fmt.Errorf("Invalid environment variable %q, expected <VAR>=<account>:<field>", spec)
+	}
+	ref, err := ParseSecretRef(parts[1])
+	if err != nil {
+		return EnvSecret{}, err
+	}
+	return EnvSecret{Name: parts[0], Ref: ref}, nil
+}
+
+// ResolveEnvSecrets parses the specs, see ParseEnvSecret, and returns the variables with their values.
+// Each account is decrypted once, a missing account or field is an error.
+func (c *Config) ResolveEnvSecrets(specs []string) ([]EnvSecret, error) {
+	r := newSecretResolver(c)
+	envs := make([]EnvSecret, 0, len(specs))
+	for _, spec := range specs {
+		env, err := ParseEnvSecret(spec)
+		if err != nil {
+			return nil, err
+		}
+		env.Value, err = r.resolve(env.Ref)
+		if err != nil {
+			return nil, err
+		}
+		envs = append(envs, env)
+	}
+	return envs, nil
+}
+
+// SecretMask replaces the secrets in the output masked by a MaskWriter.
+const SecretMask = "******"
+
+// MaskWriter replaces the secrets written to it by SecretMask before writing to the underlying writer.
+// The bytes that could be the beginning of a secret are held until the next Write or Close.
+type MaskWriter struct {
+	w       io.Writer
+	secrets [][]byte
+	buf     []byte
+}
+
+// NewMaskWriter returns a MaskWriter masking the secrets, the empty ones are ignored.
+func NewMaskWriter(w io.Writer, secrets ...string) *MaskWriter {
+	m := &MaskWriter{w: w}
+	for _, s := range secrets {
+		if s != "" {
+			m.secrets = append(m.secrets, []byte(s))
+		}
+	}
+	// The longest secret wins when they overlap
+	sort.SliceStable(m.secrets, func(i, j int) bool { return len(m.secrets[i]) > len(m.secrets[j]) })
+	return m
+}
+
+// Write masks the secrets in p and writes the result to the underlying writer.
+func (m *MaskWriter) Write(p []byte) (int, error) {
+	m.buf = append(m.buf, p...)
+	err := m.flush(false)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Close writes the bytes held by the MaskWriter, it does not close the underlying writer.
+func (m *MaskWriter) Close() error {
+	return m.flush(true)
+}
+
+func (m *MaskWriter) flush(final bool) error {
+	var out bytes.Buffer
+	i := 0
+scan:
+	for i < len(m.buf) {
+		rest := m.buf[i:]
+		if !final {
+			for _, s := range m.secrets {
+				if len(rest) < len(s) && bytes.HasPrefix(s, rest) {
+					break scan
+				}
+			}
+		}
+		for _, s := range m.secrets {
+			if bytes.HasPrefix(rest, s) {
+				out.WriteString(SecretMask)
+				i += len(s)
+				continue scan
+			}
+		}
+		out.WriteByte(m.buf[i])
+		i++
+	}
+	m.buf = append(m.buf[:0], m.buf[i:]...)
+	_, err := m.w.Write(out.Bytes())
+	return err
+}
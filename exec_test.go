@@ -0,0 +1,74 @@
+package keep
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func Test_ParseEnvSecret(t *testing.T) {
+	tests := []struct {
+		spec     string
+		expected EnvSecret
+		err      bool
+	}{
+		{"DB_PASS=prod/db:password", EnvSecret{Name: "DB_PASS", Ref: SecretRef{"prod/db", "password"}}, false},
+		{"DB_USER=prod/db:username", EnvSecret{Name: "DB_USER", Ref: SecretRef{"prod/db", "username"}}, false},
+		{"API_KEY=stripe", EnvSecret{Name: "API_KEY", Ref: SecretRef{"stripe", "password"}}, false},
+		{"API_KEY", EnvSecret{}, true},
+		{"1KEY=stripe:password", EnvSecret{}, true},
+		{"KEY=:password", EnvSecret{}, true},
+		{"KEY=stripe:", EnvSecret{}, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseEnvSecret(tt.spec)
+		if (err != nil) != tt.err || got != tt.expected {
+			t.Errorf("%s: got : %v %v - expected : %v", tt.spec, got, err, tt.expected)
+		}
+	}
+}
+
+func Test_Config_ResolveEnvSecrets(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-exec")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = dir
+
+	if err := NewAccount(c, "prod/db", "admin", "s3cret", "").Save(); err != nil {
+		t.Fatal(err)
+	}
+	envs, err := c.ResolveEnvSecrets([]string{"DB_PASS=prod/db:password", "DB_USER=prod/db:Username"})
+	if err != nil {
+		t.Fatal("An error occured while resolving the variables", err)
+	}
+	if len(envs) != 2 || envs[0].Value != "s3cret" || envs[1].Value != "admin" {
+		t.Error("Unexpected variables :", envs)
+	}
+	for _, spec := range []string{"DB_PASS=prod/missing:password", "DB_PASS=prod/db:url"} {
+		if _, err := c.ResolveEnvSecrets([]string{spec}); err == nil {
+			t.Error("Expected an error for", spec)
+		}
+	}
+}
+
+func Test_MaskWriter(t *testing.T) {
+	var out bytes.Buffer
+	m := NewMaskWriter(&out, "s3cret", "", "s3cret-long")
+	// The secrets are split between the writes
+	for _, chunk := range []string{"user s3", "cret logged in with s3cret-", "long\npartial s3cr", "et", " end s3"} {
+		if _, err := m.Write([]byte(chunk)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := m.Close(); err != nil {
+		t.Fatal(err)
+	}
+	expected := "user ****** logged in with ******\npartial ****** end s3"
+	if out.String() != expected {
+		t.Errorf("got : %q - expected : %q", out.String(), expected)
+	}
+}
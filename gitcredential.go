@@ -0,0 +1,186 @@
+package keep
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// GitCredentialPrefix is the folder of the accounts created by the git credential helper.
+const GitCredentialPrefix = "git/"
+
+// fieldURL is the metadata key of the URL an account is used for.
+const fieldURL = "URL"
+
+// GitCredential is a credential exchanged with git by a credential helper,
+// see https://git-scm.com/docs/git-credential#IOFMT
+type GitCredential struct {
+	Protocol string
+	Host     string
+	Path     string
+	Username string
+	Password string
+}
+
+// ReadGitCredential reads the key=value lines sent by git until an empty line or the end of r.
+func ReadGitCredential(r io.Reader) (*GitCredential, error) {
+	g := &GitCredential{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSuffix(scanner.Text(), "\r")
+		if line == "" {
+			break
+		}
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("Invalid git credential line %q", line)
+		}
+		switch parts[0] {
+		case "protocol":
+			g.Protocol = parts[1]
+		case "host":
+			g.Host = parts[1]
+		case "path":
+			g.Path = parts[1]
+		case "username":
+			g.Username = parts[1]
+		case "password":
+			g.Password = parts[1]
+		case "url":
+			u, err := url.Parse(parts[1])
+			if err != nil {
+				return nil, err
+			}
+			g.Protocol, g.Host, g.Path = u.Scheme, u.Host, strings.TrimPrefix(u.Path, "/")
+			if u.User != nil {
+				g.Username = u.User.Username()
+				g.Password, _ = u.User.Password()
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if g.Host == "" {
+		return nil, fmt.Errorf("The git credential has no host")
+	}
+	return g, nil
+}
+
+// Write writes the username and the password of the credential as expected by git.
+func (g *GitCredential) Write(w io.Writer) error {
+	_, err := fmt.Fprintf(w, "username=%s\npassword=%s\n", g.Username, g.Password)
+	return err
+}
+
+// URL returns the URL of the credential: "<protocol>://<host>[/<path>]".
+func (g *GitCredential) URL() string {
+	u := url.URL{Scheme: g.Protocol, Host: g.Host, Path: g.Path}
+	if g.Path != "" {
+		u.Path = "/" + g.Path
+	}
+	return u.String()
+}
+
+// AccountName returns the name of the account following the naming convention: git/<host>[/<path>].
+func (g *GitCredential) AccountName() string {
+	name := GitCredentialPrefix + g.Host
+	if g.Path != "" {
+		name += "/" + strings.Trim(g.Path, "/")
+	}
+	return name
+}
+
+// matchesURL returns true if the URL field of an account designates the credential.
+// The protocols must be equal and the paths too when both of them are known.
+func (g *GitCredential) matchesURL(s string) bool {
+	u, err := url.Parse(s)
+	if err != nil || u.Host != g.Host || (g.Protocol != "" && u.Scheme != g.Protocol) {
+		return false
+	}
+	p := strings.Trim(u.Path, "/")
+	return p == "" || g.Path == "" || p == strings.Trim(g.Path, "/")
+}
+
+// FindGitCredential returns the account of the credential or nil if there is none.
+// The accounts following the naming convention, see AccountName, are looked up first,
+// with and without the path, then the accounts whose URL field matches the credential.
+func (c *Config) FindGitCredential(g *GitCredential) (*Account, error) {
+	names := []string{g.AccountName()}
+	if g.Path != "" {
+		names = append(names, GitCredentialPrefix+g.Host)
+	}
+	for _, name := range names {
+		if fi, err := os.Stat(filepath.Join(c.AccountDir, name)); err != nil || fi.IsDir() {
+			continue
+		}
+		return NewAccountFromFile(c, name)
+	}
+	accounts, err := c.accounts()
+	if os.IsNotExist(err) {
+		// The account directory has not been created yet
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range accounts {
+		if u, err := a.Field(fieldURL); err == nil && g.matchesURL(u) {
+			return a, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetGitCredential fills the username and the password of the credential from its account.
+// It returns false if there is no account for the credential.
+func (c *Config) GetGitCredential(g *GitCredential) (bool, error) {
+	a, err := c.FindGitCredential(g)
+	if err != nil || a == nil {
+		return false, err
+	}
+	if a.Username != "" {
+		g.Username = a.Username
+	}
+	g.Password = string(a.Password.Bytes())
+	return true, nil
+}
+
+// StoreGitCredential updates the account of the credential or creates it following the naming convention.
+func (c *Config) StoreGitCredential(g *GitCredential) error {
+	a, err := c.FindGitCredential(g)
+	if err != nil {
+		return err
+	}
+	if a == nil {
+		a = NewAccount(c, g.AccountName(), "", "", "")
+		err = a.SetField(fieldURL, g.URL())
+		if err != nil {
+			return err
+		}
+	}
+	if a.Username == g.Username && bytes.Equal(a.Password.Bytes(), []byte(g.Password)) {
+		return nil
+	}
+	a.Username, a.Password = g.Username, NewSecret([]byte(g.Password))
+	return a.Save()
+}
+
+// EraseGitCredential removes the account of the credential.
+// Like the other helpers, the account is only removed if it holds the credential
+// git has rejected, so that a stale credential does not erase a newer one.
+func (c *Config) EraseGitCredential(g *GitCredential) error {
+	a, err := c.FindGitCredential(g)
+	if err != nil || a == nil {
+		return err
+	}
+	if (g.Username != "" && g.Username != a.Username) || (g.Password != "" && !bytes.Equal(a.Password.Bytes(), []byte(g.Password))) {
+		return nil
+	}
+	return a.Remove()
+}
@@ -0,0 +1,108 @@
+package keep
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func Test_ReadGitCredential(t *testing.T) {
+	g, err := ReadGitCredential(strings.NewReader("protocol=https\nhost=git.example.com\npath=team/repo.git\nusername=jdoe\n\nignored=1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := GitCredential{Protocol: "https", Host: "git.example.com", Path: "team/repo.git", Username: "jdoe"}
+	if *g != expected {
+		t.Errorf("got : %v - expected : %v", *g, expected)
+	}
+	if g.AccountName() != "git/git.example.com/team/repo.git" || g.URL() != "https://git.example.com/team/repo.git" {
+		t.Error("Unexpected account name or URL :", g.AccountName(), g.URL())
+	}
+
+	g, err = ReadGitCredential(strings.NewReader("url=https://jdoe@git.example.com:8443\n"))
+	if err != nil || g.Host != "git.example.com:8443" || g.Username != "jdoe" || g.Path != "" {
+		t.Error("Unexpected credential :", g, err)
+	}
+	if _, err := ReadGitCredential(strings.NewReader("protocol=https\n")); err == nil {
+		t.Error("Expected an error for a credential without host")
+	}
+}
+
+func Test_Config_GitCredential(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-git")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = filepath.Join(dir, "passwords")
+
+	g := &GitCredential{Protocol: "https", Host: "git.example.com", Username: "jdoe", Password: "token1"}
+	if err := c.StoreGitCredential(g); err != nil {
+		t.Fatal("An error occured while storing the credential", err)
+	}
+	a, err := NewAccountFromFile(c, "git/git.example.com")
+	if err != nil || string(a.Password.Bytes()) != "token1" {
+		t.Fatal("Expected the account to follow the naming convention", err)
+	}
+	if u, err := a.Field("url"); err != nil || u != "https://git.example.com" {
+		t.Error("Expected the URL to be recorded; got :", u, err)
+	}
+
+	// An account named otherwise is found by its URL field
+	other := NewAccount(c, "work/gitlab", "bot", "token2", "")
+	if err := other.SetField("URL", "https://gitlab.example.com/group"); err != nil {
+		t.Fatal(err)
+	}
+	if err := other.Save(); err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range []struct {
+		in       string
+		found    bool
+		expected string
+	}{
+		{"protocol=https\nhost=git.example.com\npath=team/repo.git\n", true, "username=jdoe\npassword=token1\n"},
+		{"protocol=https\nhost=gitlab.example.com\n", true, "username=bot\npassword=token2\n"},
+		{"protocol=https\nhost=gitlab.example.com\npath=group\n", true, "username=bot\npassword=token2\n"},
+		{"protocol=https\nhost=gitlab.example.com\npath=other\n", false, ""},
+		{"protocol=http\nhost=gitlab.example.com\n", false, ""},
+		{"protocol=https\nhost=unknown.example.com\n", false, ""},
+	} {
+		g, err := ReadGitCredential(strings.NewReader(tt.in))
+		if err != nil {
+			t.Fatal(err)
+		}
+		found, err := c.GetGitCredential(g)
+		if err != nil || found != tt.found {
+			t.Errorf("%q: got : %v %v - expected : %v", tt.in, found, err, tt.found)
+			continue
+		}
+		var out bytes.Buffer
+		if found {
+			g.Write(&out)
+		}
+		if out.String() != tt.expected {
+			t.Errorf("%q: got : %q - expected : %q", tt.in, out.String(), tt.expected)
+		}
+	}
+
+	// A rejected credential that is no longer stored does not erase the account
+	g.Password = "old-token"
+	if err := c.EraseGitCredential(g); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(a.Path()); err != nil {
+		t.Error("Expected the account to be kept", err)
+	}
+	g.Password = "token1"
+	if err := c.EraseGitCredential(g); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(a.Path()); !os.IsNotExist(err) {
+		t.Error("Expected the account to be removed", err)
+	}
+}
@@ -0,0 +1,73 @@
+package keep
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+// RenderTemplate executes the text/template read from r and writes the result to w.
+// The placeholders {{ keep "<account>" "<field>" }} are replaced by the field of the account,
+// see Account.Field, the field is the password when it is omitted. Each account is decrypted
+// once and a missing account or field stops the rendering with an error.
+func (c *Config) RenderTemplate(w io.Writer, name string, r io.Reader) error {
+	text, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	resolver := newSecretResolver(c)
+	funcs := template.FuncMap{
+		"keep": func(account string, field ...string) (string, error) {
+			ref := SecretRef{Account: account, Field: "password"}
+			switch len(field) {
+			case 0:
+			case 1:
+				ref.Field = field[0]
+			default:
+				return "", fmt.Errorf("keep expects an account and a field, got %d fields", len(field))
+			}
+			return resolver.resolve(ref)
+		},
+	}
+	tmpl, err := template.New(name).Funcs(funcs).Option("missingkey=error").Parse(string(text))
+	if err != nil {
+		return err
+	}
+	return tmpl.Execute(w, nil)
+}
+
+// InjectTemplate renders the template file in to the file out, see RenderTemplate.
+// The output is only written, with 0600 permissions, if the whole template has been rendered.
+// It is written to a temporary file next to out, then renamed, so that the secrets are never
+// in a file with the permissions of an existing out.
+func (c *Config) InjectTemplate(in, out string) error {
+	f, err := os.Open(in)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	var buf bytes.Buffer
+	defer func() { WipeBytes(buf.Bytes()) }()
+	err = c.RenderTemplate(&buf, in, f)
+	if err != nil {
+		return err
+	}
+	// TempFile creates the file with 0600 permissions
+	tmp, err := ioutil.TempFile(filepath.Dir(out), "."+filepath.Base(out)+".keep-tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	_, err = tmp.Write(buf.Bytes())
+	if cerr := tmp.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), out)
+}
@@ -0,0 +1,63 @@
+package keep
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_Config_InjectTemplate(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-inject")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = filepath.Join(dir, "passwords")
+
+	if err := NewAccount(c, "prod/db", "admin", "s3cret", "").Save(); err != nil {
+		t.Fatal(err)
+	}
+	in := filepath.Join(dir, "app.conf.tmpl")
+	out := filepath.Join(dir, "app.conf")
+	tmpl := `user={{ keep "prod/db" "username" }}
+password={{ keep "prod/db" "password" }}
+again={{ keep "prod/db" }}
+`
+	if err := ioutil.WriteFile(in, []byte(tmpl), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// An existing output file gets restricted permissions
+	if err := ioutil.WriteFile(out, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	err = c.InjectTemplate(in, out)
+	if err != nil {
+		t.Fatal("An error occured while rendering the template", err)
+	}
+	got, err := ioutil.ReadFile(out)
+	expected := "user=admin\npassword=s3cret\nagain=s3cret\n"
+	if err != nil || string(got) != expected {
+		t.Errorf("got : %q %v - expected : %q", got, err, expected)
+	}
+	if fi, err := os.Stat(out); err != nil || fi.Mode().Perm() != 0600 {
+		t.Error("Expected the output to be written with 0600 permissions; got :", fi.Mode(), err)
+	}
+	if tmps, _ := filepath.Glob(filepath.Join(dir, ".app.conf*")); len(tmps) != 0 {
+		t.Error("Expected no temporary file to be left; got :", tmps)
+	}
+
+	for _, tmpl := range []string{`{{ keep "prod/missing" "password" }}`, `{{ keep "prod/db" "url" }}`, `{{ keep "prod/db"`} {
+		if err := ioutil.WriteFile(in, []byte("before\n"+tmpl), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := c.InjectTemplate(in, out); err == nil {
+			t.Error("Expected an error for", tmpl)
+		}
+	}
+	// The output is not touched when the rendering fails
+	if got, _ := ioutil.ReadFile(out); string(got) != expected {
+		t.Errorf("got : %q - expected : %q", got, expected)
+	}
+}
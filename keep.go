@@ -5,19 +5,24 @@ import (
 	"bytes"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"math/big"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"syscall"
+	"time"
 
 	"github.com/jcmdev0/gpgagent"
 
 	"golang.org/x/crypto/openpgp"
 	"golang.org/x/crypto/openpgp/armor"
+	pgperrors "golang.org/x/crypto/openpgp/errors"
+	"golang.org/x/crypto/openpgp/packet"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
@@ -39,6 +44,42 @@ func NewPassword(length int) ([]byte, error) {
 	return password, nil
 }
 
+// ParseDuration parses a duration string. In addition to the units accepted
+// by time.ParseDuration it accepts "d" for days and "w" for weeks, e.g. "30d".
+// A negative duration is refused.
+func ParseDuration(s string) (time.Duration, error) {
+	units := map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour}
+	for suffix, unit := range units {
+		if strings.HasSuffix(s, suffix) {
+			n, err := strconv.Atoi(strings.TrimSuffix(s, suffix))
+			if err != nil || n < 0 {
+				return 0, fmt.Errorf("invalid duration %q", s)
+			}
+			return time.Duration(n) * unit, nil
+		}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, err
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("invalid duration %q", s)
+	}
+	return d, nil
+}
+
+// ParseDate parses a date written as "2006-01-02" or as a duration from now, e.g. "90d".
+func ParseDate(s string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", s); err == nil {
+		return t, nil
+	}
+	d, err := ParseDuration(s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %q, expected 2006-01-02 or a duration", s)
+	}
+	return time.Now().Add(d).UTC().Truncate(time.Second), nil
+}
+
 func getKeyRing(keyringPath string) (el openpgp.EntityList, err error) {
 	// Read in public key
 	keyringFileBuffer, err := os.Open(keyringPath)
@@ -54,17 +95,75 @@ func getKeyRing(keyringPath string) (el openpgp.EntityList, err error) {
 	return el, nil
 }
 
-func filterEntityList(el openpgp.EntityList, recipients string) openpgp.EntityList {
-	rs := strings.Split(recipients, " ")
+// Fingerprint returns the full fingerprint of a public key in capital hex.
+func Fingerprint(pk *packet.PublicKey) string {
+	return fmt.Sprintf("%X", pk.Fingerprint[:])
+}
+
+// keyMatches returns true if the public key is identified by the normalized id.
+func keyMatches(pk *packet.PublicKey, id string) bool {
+	switch len(id) {
+	case 40:
+		return Fingerprint(pk) == id
+	case 16:
+		return pk.KeyIdString() == id
+	case 8:
+		return pk.KeyIdShortString() == id
+	}
+	return false
+}
+
+// entityMatches returns true if the entity is identified by id.
+// id can be an email address or the fingerprint, the long or the short key id
+// of the primary key or of one of its subkeys.
+func entityMatches(e *openpgp.Entity, id string) bool {
+	if strings.Contains(id, "@") {
+		email := strings.ToLower(strings.Trim(id, "<>"))
+		for _, i := range e.Identities {
+			if i.UserId != nil && strings.ToLower(i.UserId.Email) == email {
+				return true
+			}
+		}
+		return false
+	}
+	id = strings.ToUpper(strings.TrimPrefix(strings.ToLower(id), "0x"))
+	if keyMatches(e.PrimaryKey, id) {
+		return true
+	}
+	for _, subkey := range e.Subkeys {
+		if keyMatches(subkey.PublicKey, id) {
+			return true
+		}
+	}
+	return false
+}
+
+// filterEntityList returns the entities identified by the space separated list of ids.
+// An error is returned if one of the ids matches no key or more than one key.
+func filterEntityList(el openpgp.EntityList, ids string) (openpgp.EntityList, error) {
+	rs := strings.Fields(ids)
 	fel := make([]*openpgp.Entity, 0, len(rs))
+	seen := make(map[uint64]bool)
 	for _, r := range rs {
+		var matches []*openpgp.Entity
 		for _, e := range el {
-			if r == e.PrimaryKey.KeyIdShortString() {
-				fel = append(fel, e)
+			if entityMatches(e, r) {
+				matches = append(matches, e)
 			}
 		}
+		switch len(matches) {
+		case 0:
+			return nil, fmt.Errorf("No key matches %q", r)
+		case 1:
+		default:
+			return nil, fmt.Errorf("%q is ambiguous, it matches %d keys", r, len(matches))
+		}
+		if e := matches[0]; !seen[e.PrimaryKey.KeyId] {
+			seen[e.PrimaryKey.KeyId] = true
+			fel = append(fel, e)
+		}
 	}
-	return fel
+	return fel, nil
 }
 
 func decodeFile(el openpgp.EntityList, pf openpgp.PromptFunction, fpath string) (*openpgp.MessageDetails, error) {
@@ -73,7 +172,11 @@ func decodeFile(el openpgp.EntityList, pf openpgp.PromptFunction, fpath string)
 	if err != nil {
 		return nil, err
 	}
-	result, err := armor.Decode(f)
+	return decodeReader(el, pf, f)
+}
+
+func decodeReader(el openpgp.EntityList, pf openpgp.PromptFunction, r io.Reader) (*openpgp.MessageDetails, error) {
+	result, err := armor.Decode(r)
 	if err != nil {
 		return nil, err
 	}
@@ -83,15 +186,20 @@ func decodeFile(el openpgp.EntityList, pf openpgp.PromptFunction, fpath string)
 
 func promptFromString(passphrase string) openpgp.PromptFunction {
 	return func(keys []openpgp.Key, symmetric bool) ([]byte, error) {
+		if symmetric && len(keys) == 0 {
+			return []byte(passphrase), nil
+		}
 		for _, k := range keys {
 			ID := k.PrivateKey.KeyIdShortString()
 			fmt.Printf("Passphrase to unlock your key (%s) : ", ID)
-			err := k.PrivateKey.Decrypt([]byte(passphrase))
+			pw := []byte(passphrase)
+			err := k.PrivateKey.Decrypt(pw)
 			if err != nil {
+				WipeBytes(pw)
 				fmt.Println("\nAn error occurred while decrypting the key", err)
 				return nil, err
 			}
-			return []byte(passphrase), nil
+			return pw, nil
 		}
 		return nil, fmt.Errorf("Unable to find key")
 	}
@@ -105,6 +213,19 @@ func promptFunctionGpgAgent() openpgp.PromptFunction {
 		}
 		defer conn.Close()
 
+		if symmetric && len(keys) == 0 {
+			request := gpgagent.PassphraseRequest{
+				CacheKey: symmetricCacheID,
+				Prompt:   "Passphrase",
+				Desc:     "Please enter the passphrase of your keep vault",
+			}
+			passphrase, err := conn.GetPassphrase(&request)
+			if err != nil {
+				return nil, err
+			}
+			return []byte(passphrase), nil
+		}
+
 		for _, key := range keys {
 			cacheID := strings.ToUpper(hex.EncodeToString(key.PublicKey.Fingerprint[:]))
 
@@ -113,8 +234,10 @@ func promptFunctionGpgAgent() openpgp.PromptFunction {
 			if err != nil {
 				return nil, err
 			}
-			err = key.PrivateKey.Decrypt([]byte(passphrase))
+			pw := []byte(passphrase)
+			err = key.PrivateKey.Decrypt(pw)
 			if err != nil {
+				WipeBytes(pw)
 				err := conn.RemoveFromCache(cacheID)
 				if err != nil {
 					err = fmt.Errorf("cannot remove the key from cache: %s", err)
@@ -122,23 +245,83 @@ func promptFunctionGpgAgent() openpgp.PromptFunction {
 				err = fmt.Errorf("can t decrypt: %s", err)
 				return nil, err
 			}
-			return []byte(passphrase), nil
+			return pw, nil
 		}
 		return nil, fmt.Errorf("Unable to find key")
 	}
 }
 
+// ClearGpgAgentCache removes the passphrases of the profile from the cache of gpg-agent,
+// they are requested again with its pinentry the next time they are needed.
+func (c *Config) ClearGpgAgentCache() error {
+	conn, err := gpgagent.NewGpgAgentConn()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if c.IsSymmetric() {
+		return conn.RemoveFromCache(symmetricCacheID)
+	}
+	el, err := c.EntityListWithSecretKey()
+	if err != nil {
+		return err
+	}
+	for _, key := range el.DecryptionKeys() {
+		err = conn.RemoveFromCache(strings.ToUpper(hex.EncodeToString(key.PublicKey.Fingerprint[:])))
+		if err != nil {
+			return fmt.Errorf("cannot remove the key from cache: %s", err)
+		}
+	}
+	return nil
+}
+
+// errNoTerminal is returned by promptTerminal when there is no terminal to request the passphrase.
+var errNoTerminal = errors.New("No terminal to request the passphrase, gpg-agent or GPGPASSPHRASE is required")
+
+// The terminal used to request the passphrases, they are variables to be replaced in the tests.
+var (
+	terminalInput = os.Stdin
+	ttyPath       = "/dev/tty"
+)
+
+// readPassword prints the prompt and reads a password without echo from the terminal.
+// When stdin is not a terminal, e.g. it carries the protocol of a credential helper,
+// the controlling terminal of the process is used instead.
+func readPassword(prompt string) ([]byte, error) {
+	if terminal.IsTerminal(int(terminalInput.Fd())) {
+		fmt.Print(prompt)
+		pw, err := terminal.ReadPassword(int(terminalInput.Fd()))
+		fmt.Printf("\n")
+		return pw, err
+	}
+	tty, err := os.OpenFile(ttyPath, os.O_RDWR, 0)
+	if err != nil {
+		return nil, errNoTerminal
+	}
+	defer tty.Close()
+	if !terminal.IsTerminal(int(tty.Fd())) {
+		return nil, errNoTerminal
+	}
+	fmt.Fprint(tty, prompt)
+	pw, err := terminal.ReadPassword(int(tty.Fd()))
+	fmt.Fprintf(tty, "\n")
+	return pw, err
+}
+
 func promptTerminal(keys []openpgp.Key, symmetric bool) ([]byte, error) {
+	if symmetric && len(keys) == 0 {
+		return readPassword("Passphrase to unlock your vault : ")
+	}
 	for _, k := range keys {
 		ID := k.PrivateKey.KeyIdShortString()
-		fmt.Printf("Passphrase to unlock your key (%s) : ", ID)
-		pw, err := terminal.ReadPassword(int(os.Stdin.Fd()))
+		pw, err := readPassword(fmt.Sprintf("Passphrase to unlock your key (%s) : ", ID))
 		if err != nil {
 			return nil, err
 		}
 		err = k.PrivateKey.Decrypt(pw)
 		if err != nil {
-			fmt.Println("\nAn error occurred while decrypting the key", err)
+			WipeBytes(pw)
+			fmt.Println("An error occurred while decrypting the key", err)
 			return nil, err
 		}
 		return pw, nil
@@ -152,8 +335,8 @@ func GuessPromptFunction() openpgp.PromptFunction {
 	conn, err := gpgagent.NewGpgAgentConn()
 	if err == nil {
 		pf = promptFunctionGpgAgent()
+		conn.Close()
 	}
-	defer conn.Close()
 
 	// if GPGPASSPHRASE in Environ use it else request it when needed
 	envs := os.Environ()
@@ -170,12 +353,35 @@ func GuessPromptFunction() openpgp.PromptFunction {
 
 // Config represents the configuration required to work with GPG.
 type Config struct {
+	Type            string
 	SecringDir      string
 	PubringDir      string
 	AccountDir      string
 	RecipientKeyIds string
 	SignerKeyID     string
-	PromptFunction  openpgp.PromptFunction
+	KeyPolicy       string
+	// MinPasswordScore is the minimum strength score required for the password of new accounts.
+	MinPasswordScore int
+	// PasswordHistory is the number of previous passwords kept in the accounts.
+	PasswordHistory int
+	// PromptFunction requests the passphrase of the keys or of a symmetric profile. The passphrase
+	// returned belongs to the caller, it is wiped after use.
+	PromptFunction openpgp.PromptFunction
+	// Warnf is called to report non fatal problems, they are printed on stderr if nil.
+	Warnf func(format string, a ...interface{})
+
+	// PassphraseTimeout is how long the passphrase of a symmetric profile is kept once it has been
+	// requested, it is kept until ForgetPassphrase if it is 0.
+	PassphraseTimeout time.Duration
+
+	// passphrase of a symmetric profile once it has been requested, at passphraseAt.
+	passphrase   *Secret
+	passphraseAt time.Time
+	// passphraseVerified is true once the passphrase has been checked against the vault.
+	passphraseVerified bool
+	// secring, when it is set, holds the secret keys used by decode instead of reading them again,
+	// the keys decrypted for an account are used for the following ones.
+	secring openpgp.EntityList
 }
 
 // NewConfig returns an initialized Config with the information copied from a Profile. If nil Profile is passed we build one from DefaultProfile.
@@ -184,22 +390,61 @@ func NewConfig(p *Profile) *Config {
 		p = DefaultProfile()
 	}
 	return &Config{
-		SecringDir:      p.SecringDir,
-		PubringDir:      p.PubringDir,
-		AccountDir:      p.AccountDir,
-		RecipientKeyIds: p.RecipientKeyIds,
-		SignerKeyID:     p.SignerKeyID,
-		PromptFunction:  GuessPromptFunction(),
+		Type:             p.Type,
+		SecringDir:       p.SecringDir,
+		PubringDir:       p.PubringDir,
+		AccountDir:       p.AccountDir,
+		RecipientKeyIds:  p.RecipientKeyIds,
+		SignerKeyID:      p.SignerKeyID,
+		KeyPolicy:        p.KeyPolicy,
+		MinPasswordScore: p.MinPasswordScore,
+		PasswordHistory:  p.PasswordHistory,
+		PromptFunction:   GuessPromptFunction(),
+
+		PassphraseTimeout: defaultPassphraseTimeout,
+	}
+}
+
+func (c *Config) warnf(format string, a ...interface{}) {
+	if c.Warnf != nil {
+		c.Warnf(format, a...)
+		return
 	}
+	fmt.Fprintf(os.Stderr, "WARNING: "+format+"\n", a...)
 }
 
 // EntityListRecipients returns the openpgp.EntityList corresponding to the RecipientKeyIds from the Config.
 func (c *Config) EntityListRecipients() (openpgp.EntityList, error) {
+	return c.entityList(c.RecipientKeyIds)
+}
+
+// entityList returns the openpgp.EntityList of the keys identified by ids in the pubring.
+func (c *Config) entityList(ids string) (openpgp.EntityList, error) {
 	el, err := getKeyRing(c.PubringDir)
 	if err != nil {
 		return nil, err
 	}
-	el = filterEntityList(el, c.RecipientKeyIds)
+	if strings.TrimSpace(ids) == "" {
+		return nil, fmt.Errorf("No RecipientKeyIds configured")
+	}
+	return filterEntityList(el, ids)
+}
+
+// ValidEntityListRecipients returns the recipients after checking that their keys are neither expired nor revoked.
+// Depending on the KeyPolicy, problems are either returned as an error or reported as warnings.
+func (c *Config) ValidEntityListRecipients() (openpgp.EntityList, error) {
+	return c.validEntityList(c.RecipientKeyIds)
+}
+
+func (c *Config) validEntityList(ids string) (openpgp.EntityList, error) {
+	el, err := c.entityList(ids)
+	if err != nil {
+		return nil, err
+	}
+	err = c.checkKeys(el, true)
+	if err != nil {
+		return nil, err
+	}
 	return el, nil
 }
 
@@ -218,8 +463,10 @@ func (c *Config) EntitySigner() (*openpgp.Entity, error) {
 	if err != nil {
 		return nil, err
 	}
-	el = filterEntityList(el, c.SignerKeyID)
-
+	el, err = filterEntityList(el, c.SignerKeyID)
+	if err != nil {
+		return nil, err
+	}
 	if len(el) != 1 {
 		return nil, fmt.Errorf("Exactly one SignerKeyID must be given, received : %d", len(el))
 	}
@@ -229,7 +476,12 @@ func (c *Config) EntitySigner() (*openpgp.Entity, error) {
 	if err != nil {
 		return nil, err
 	}
+	defer WipeBytes(passphrase)
 	signer := el[0]
+	err = c.checkKeys(el, false)
+	if err != nil {
+		return nil, err
+	}
 	err = signer.PrivateKey.Decrypt(passphrase)
 	if err != nil {
 		return nil, err
@@ -237,28 +489,138 @@ func (c *Config) EntitySigner() (*openpgp.Entity, error) {
 	return signer, nil
 }
 
-// decodeFile returns an io.Reader from which the content of the message can be read in clear text.
-func (c *Config) decodeAccountFile(fpath string) (*openpgp.MessageDetails, error) {
+// verifyKeyPassphrase decrypts, with the PromptFunction, a secret key able to decrypt the accounts
+// encrypted to the RecipientKeyIds. Unlike EntitySigner it does not require a SignerKeyID.
+func (c *Config) verifyKeyPassphrase() error {
 	el, err := c.EntityListWithSecretKey()
+	if err != nil {
+		return err
+	}
+	var keys []openpgp.Key
+	for _, k := range el.DecryptionKeys() {
+		for _, id := range strings.Fields(c.RecipientKeyIds) {
+			if entityMatches(k.Entity, id) {
+				keys = append(keys, k)
+				break
+			}
+		}
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("No secret key in %s matches the RecipientKeyIds (%s)", c.SecringDir, c.RecipientKeyIds)
+	}
+	for _, k := range keys {
+		if !k.PrivateKey.Encrypted {
+			return nil
+		}
+	}
+	passphrase, err := c.PromptFunction(keys, false)
+	if err != nil {
+		return err
+	}
+	defer WipeBytes(passphrase)
+	// The PromptFunction decrypts the first key it can, a PromptFunction that does not is checked here
+	for _, k := range keys {
+		if k.PrivateKey.Decrypt(passphrase) == nil {
+			return nil
+		}
+	}
+	return ErrWrongPassphrase
+}
+
+// decodeAccountFile returns an io.Reader from which the content of the message can be read in clear text.
+func (c *Config) decodeAccountFile(fpath string) (*openpgp.MessageDetails, error) {
+	f, err := os.Open(fpath)
 	if err != nil {
 		return nil, err
 	}
-	return decodeFile(el, c.PromptFunction, fpath)
+	return c.decode(f)
+}
+
+// errSessionKeyLength is returned by openpgp when a wrong passphrase decrypts a session key of an invalid length.
+var errSessionKeyLength = pgperrors.InvalidArgumentError("SymmetricallyEncrypted: incorrect key length")
+
+// decode decrypts an armored message with the secret keys or, for symmetric profiles, with the passphrase.
+func (c *Config) decode(r io.Reader) (*openpgp.MessageDetails, error) {
+	if c.IsSymmetric() {
+		md, err := decodeReader(nil, c.symmetricPrompt(), r)
+		if err == errSessionKeyLength {
+			c.ForgetPassphrase()
+			return nil, ErrWrongPassphrase
+		}
+		return md, err
+	}
+	el := c.secring
+	if el == nil {
+		var err error
+		el, err = c.EntityListWithSecretKey()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return decodeReader(el, c.wipingPrompt(), r)
+}
+
+// wipingPrompt returns a PromptFunction that wipes the passphrase returned by the PromptFunction.
+// The keys are decrypted by the PromptFunction, openpgp only uses the passphrase returned for
+// the messages encrypted with a passphrase, which are not read with the keys.
+func (c *Config) wipingPrompt() openpgp.PromptFunction {
+	return func(keys []openpgp.Key, symmetric bool) ([]byte, error) {
+		passphrase, err := c.PromptFunction(keys, symmetric)
+		WipeBytes(passphrase)
+		return nil, err
+	}
+}
+
+// accountFileInfo is an os.FileInfo whose Name is the path of the file relative to the AccountDir.
+type accountFileInfo struct {
+	os.FileInfo
+	name string
+}
+
+func (fi accountFileInfo) Name() string {
+	return fi.name
+}
+
+// isHidden returns true for the files and directories that are not accounts:
+// .keep-recipients, .git, ...
+func isHidden(name string) bool {
+	return strings.HasPrefix(name, ".")
 }
 
-// ListAccountFiles returns the list of Files stored in the AccountDir.
+// ListAccountFiles returns the list of Files stored in the AccountDir and its sub directories.
+// The name of each file is its path relative to the AccountDir, e.g. finance/bank.
 // The list is filtered in a case in sensitive way.
 func (c *Config) ListAccountFiles(fileSubStr string) ([]os.FileInfo, error) {
 	var filteredFiles []os.FileInfo
-	files, err := ioutil.ReadDir(c.AccountDir)
+	err := filepath.Walk(c.AccountDir, func(path string, f os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == c.AccountDir {
+			return nil
+		}
+		if isHidden(f.Name()) {
+			if f.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if f.IsDir() {
+			return nil
+		}
+		name, err := filepath.Rel(c.AccountDir, path)
+		if err != nil {
+			return err
+		}
+		name = filepath.ToSlash(name)
+		if strings.Contains(strings.ToLower(name), strings.ToLower(fileSubStr)) {
+			filteredFiles = append(filteredFiles, accountFileInfo{f, name})
+		}
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
-	for _, f := range files {
-		if strings.Contains(strings.ToLower(f.Name()), strings.ToLower(fileSubStr)) {
-			filteredFiles = append(filteredFiles, f)
-		}
-	}
 	return filteredFiles, nil
 }
 
@@ -267,9 +629,31 @@ type Account struct {
 	config   *Config
 	Name     string
 	Username string
-	Password string
+	Password *Secret
 	Notes    string
 
+	// Created, Updated and PasswordChanged are maintained by Save, they are zero for
+	// the accounts written by older versions of keep until they are modified.
+	Created         time.Time
+	Updated         time.Time
+	PasswordChanged time.Time
+	// Expires is the optional date at which the password should be changed.
+	Expires time.Time
+
+	// History lists the previous passwords, the most recent first.
+	History []PreviousPassword
+	// Attachments lists the files attached to the account, they are stored in AttachmentsDir.
+	Attachments []Attachment
+	// Shares lists the recipients the account has been shared with outside of the profile.
+	Shares []Share
+	// SSHKey is the optional SSH private key served by keep ssh-agent.
+	SSHKey *SSHKey
+
+	// extra holds the metadata fields this version of keep does not know about.
+	extra []field
+	// saved is nil for the accounts that have never been written.
+	saved *savedState
+
 	// The following fields are valued when the account is read.
 	IsSigned bool
 	SignedBy *openpgp.Key // the key of the signer, if available
@@ -280,7 +664,20 @@ func (a *Account) Path() string {
 	return filepath.Join(a.config.AccountDir, a.Name)
 }
 
+// NewAccount returns an Account built with the given elements, it is not written to disk until Save is called.
+// The password is kept as entered, its spaces are part of it, the other elements are trimmed.
+func NewAccount(conf *Config, name, username, password, notes string) *Account {
+	return &Account{
+		config:   conf,
+		Name:     strings.TrimSpace(name),
+		Username: strings.TrimSpace(username),
+		Password: NewSecret([]byte(password)),
+		Notes:    strings.TrimSpace(notes),
+	}
+}
+
 // NewAccountFromConsole returns an Account built with the elements collected by interacting with the user.
+// The password is requested until its strength reaches the MinPasswordScore of the Config.
 func NewAccountFromConsole(conf *Config) (*Account, error) {
 	reader := bufio.NewReader(os.Stdin)
 
@@ -293,32 +690,37 @@ func NewAccountFromConsole(conf *Config) (*Account, error) {
 	fmt.Print("Enter Notes: ")
 	notes, _ := reader.ReadString('\n')
 
-	fmt.Print("Enter Password (`gen` to generate a random one): ")
-	bytePassword, err := terminal.ReadPassword(int(syscall.Stdin))
-	if err != nil {
-		return nil, err
-	}
-
-	// Making sure that we jump a line in the console after reading the Password
-	fmt.Printf("\n")
-
-	if bytes.Equal(bytePassword, []byte("gen")) {
-		bytePassword, err = NewPassword(10)
+	account := NewAccount(conf, name, username, "", notes)
+	for {
+		fmt.Print("Enter Password (`gen` to generate a random one): ")
+		bytePassword, err := terminal.ReadPassword(int(syscall.Stdin))
 		if err != nil {
 			return nil, err
 		}
-	}
-	password := string(bytePassword)
 
-	account := Account{
-		config:   conf,
-		Name:     strings.TrimSpace(name),
-		Username: strings.TrimSpace(username),
-		Password: strings.TrimSpace(password),
-		Notes:    strings.TrimSpace(notes),
+		// Making sure that we jump a line in the console after reading the Password
+		fmt.Printf("\n")
+
+		if bytes.Equal(bytePassword, []byte("gen")) {
+			bytePassword, err = NewPassword(10)
+			if err != nil {
+				return nil, err
+			}
+		}
+		account.Password = NewSecret(bytePassword)
+
+		strength, err := account.CheckStrength()
+		fmt.Println("Password strength :", strength)
+		if strength.Warning != "" {
+			fmt.Println(strength.Warning)
+		}
+		if err == nil {
+			break
+		}
+		fmt.Println(err)
 	}
 
-	return &account, nil
+	return account, nil
 }
 
 // NewAccountFromFile returns an Account as described by a file in the accountDir.
@@ -327,30 +729,61 @@ func NewAccountFromFile(conf *Config, fname string) (*Account, error) {
 	md, err := conf.decodeAccountFile(fpath)
 	if err != nil {
 		return nil, err
-	} else if md.IsSigned && md.SignatureError != nil {
-		return nil, fmt.Errorf("A signature error has been detected in this accoun : %v", err)
 	}
 
 	clearTextReader := md.UnverifiedBody
-	account, err := NewAccountFromReader(conf, filepath.Base(fpath), clearTextReader)
+	account, err := NewAccountFromReader(conf, filepath.ToSlash(filepath.Clean(fname)), clearTextReader)
+	if err != nil {
+		return nil, err
+	}
+	// The signature is only verified once the body has been read
+	if md.IsSigned && md.SignatureError != nil {
+		return nil, fmt.Errorf("A signature error has been detected in this account : %v", md.SignatureError)
+	}
 
 	if md.IsSigned {
 		account.IsSigned = true
 		account.SignedBy = md.SignedBy
+		if md.SignedBy != nil {
+			for _, p := range keyProblems(md.SignedBy.Entity, time.Now(), false) {
+				conf.warnf("%s was signed by a key that can no longer be trusted: %s", account.Name, p)
+			}
+		}
 	}
 
-	return account, err
+	err = account.readPrivateFields()
+	if err != nil {
+		return nil, err
+	}
+	return account, nil
 }
 
-func newAccountFromFileContent(conf *Config, name, str string) (*Account, error) {
+// newAccountFromFileContent parses the clear text of an account. The passwords are copied to
+// Secrets, the caller wipes the content.
+func newAccountFromFileContent(conf *Config, name string, content []byte) (*Account, error) {
 	a := Account{
 		config: conf,
 		Name:   name,
 	}
-	chunks := strings.Split(str, "\n")
-	a.Password = chunks[0]
-	a.Username = chunks[1]
-	a.Notes = chunks[2]
+	chunks := bytes.SplitN(content, []byte("\n"), 4)
+	for len(chunks) < 3 {
+		chunks = append(chunks, nil)
+	}
+	a.Password = NewSecret(append([]byte(nil), chunks[0]...))
+	a.Username = string(chunks[1])
+	a.Notes = string(chunks[2])
+	if len(chunks) == 4 {
+		notes, fields := splitFields(chunks[3])
+		if notes != "" {
+			a.Notes += "\n" + notes
+		}
+		err := a.parseFields(fields)
+		if err != nil {
+			a.Wipe()
+			return nil, err
+		}
+	}
+	a.saved = a.digest()
 
 	return &a, nil
 }
@@ -358,12 +791,14 @@ func newAccountFromFileContent(conf *Config, name, str string) (*Account, error)
 // NewAccountFromReader returns an account with the provided element.
 // The reader is expected to returns bytes int the appropriate format :
 //   * []byte(password\nusername\nnotes)
+//   * optionally followed by metadata lines: \nKey: value
 func NewAccountFromReader(conf *Config, name string, r io.Reader) (*Account, error) {
 	content, err := ioutil.ReadAll(r)
+	defer WipeBytes(content)
 	if err != nil {
 		return nil, err
 	}
-	account, err := newAccountFromFileContent(conf, name, string(content))
+	account, err := newAccountFromFileContent(conf, name, content)
 	if err != nil {
 		return nil, err
 	}
@@ -372,36 +807,200 @@ func NewAccountFromReader(conf *Config, name string, r io.Reader) (*Account, err
 
 // Bytes returns a slice of byte representing the account.
 func (a Account) Bytes() []byte {
-	return []byte(fmt.Sprintf("%s\n%s\n%s", a.Password, a.Username, a.Notes))
+	return a.bytes(true)
+}
+
+// bytes returns the clear text of the account, without its private metadata unless private is true.
+// The buffer is allocated once so that no copy of the passwords is left behind, the caller wipes it.
+func (a Account) bytes(private bool) []byte {
+	b := make([]byte, 0, a.Password.Len()+len(a.Username)+len(a.Notes)+2+a.fieldsSize())
+	b = append(append(b, a.Password.Bytes()...), '\n')
+	b = append(append(b, a.Username...), '\n')
+	b = append(b, a.Notes...)
+	return a.appendFields(b, func(key string) bool {
+		return private || !isPrivateField(key)
+	})
 }
 
 // Encrypt returns the encrypted byte slice for an account.
+// The account is encrypted to the recipients of the nearest .keep-recipients file
+// or, if there is none, to the recipients of the Config, and to the recipients
+// it has been shared with in place. The private metadata of an account shared in place
+// is left out, Save writes it to a separate file.
 func (a *Account) Encrypt() ([]byte, error) {
-	el, err := a.config.EntityListRecipients()
+	recipients, err := a.config.RecipientsFor(a.Name)
+	if err != nil {
+		return nil, err
+	}
+	content, private, err := a.encryptFiles(recipients)
+	WipeBytes(private)
+	return content, err
+}
+
+// encryptFiles returns the encrypted account for the space separated list of recipients of its
+// profile and, for an account shared in place, its private metadata, nil otherwise. The account
+// is also encrypted to the recipients of its shares, the private metadata is not.
+func (a *Account) encryptFiles(recipients string) ([]byte, []byte, error) {
+	if !a.sharedInPlace() {
+		clear := a.Bytes()
+		defer WipeBytes(clear)
+		content, err := a.config.encrypt(clear, nil, recipients)
+		return content, nil, err
+	}
+	clear := a.bytes(false)
+	content, err := a.config.encrypt(clear, nil, a.withShares(recipients))
+	WipeBytes(clear)
+	if err != nil {
+		return nil, nil, err
+	}
+	clear = a.privateBytes()
+	private, err := a.config.encrypt(clear, nil, recipients)
+	WipeBytes(clear)
+	if err != nil {
+		return nil, nil, err
+	}
+	return content, private, nil
+}
+
+// recipients returns the space separated list of the recipients of the account.
+func (a *Account) recipients() (string, error) {
+	recipients, err := a.config.RecipientsFor(a.Name)
+	if err != nil {
+		return "", err
+	}
+	return a.withShares(recipients), nil
+}
+
+// withShares adds the recipients of the shares of the account that are not written to a copy.
+func (a *Account) withShares(recipients string) string {
+	for _, s := range a.Shares {
+		if s.Out == "" {
+			recipients += " " + s.Recipients
+		}
+	}
+	return recipients
+}
+
+// Save encrypts the account and writes it to its Path, overwriting the previous version if any.
+// The Created, Updated and PasswordChanged timestamps are updated according to the changes.
+func (a *Account) Save() error {
+	err := a.config.checkAccountName(a.Name)
+	if err != nil {
+		return err
+	}
+	err = a.checkNotes()
+	if err != nil {
+		return err
+	}
+	err = a.checkLineBreaks()
+	if err != nil {
+		return err
+	}
+	a.touch(time.Now())
+	recipients, err := a.config.RecipientsFor(a.Name)
+	if err != nil {
+		return err
+	}
+	content, private, err := a.encryptFiles(recipients)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(a.Path()), 0700)
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(a.Path(), content, 0600)
+	if err != nil {
+		return err
+	}
+	err = a.writePrivateFields(private)
+	if err != nil {
+		return err
+	}
+	a.saved = a.digest()
+	return nil
+}
+
+// Remove deletes the account file and the files of its attachments.
+func (a *Account) Remove() error {
+	err := a.config.checkAccountName(a.Name)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(a.Path())
+	if err != nil {
+		return err
+	}
+	err = os.Remove(a.privatePath())
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, at := range a.Attachments {
+		err = os.Remove(a.AttachmentPath(at.Name))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	a.Wipe()
+	return nil
+}
+
+// Wipe zeroes the password and the previous passwords of the account, it must not be used afterwards.
+func (a *Account) Wipe() {
+	a.Password.Wipe()
+	for _, p := range a.History {
+		p.Password.Wipe()
+	}
+	if a.saved != nil {
+		a.saved.password.Wipe()
+	}
+}
+
+// encryptWriter returns a WriteCloser encrypting what is written to it into w.
+// The message is encrypted to the space separated list of recipients and signed
+// by the signer of the Config or, for symmetric profiles, encrypted with the passphrase.
+func (c *Config) encryptWriter(w io.Writer, hints *openpgp.FileHints, recipients string) (io.WriteCloser, error) {
+	if c.IsSymmetric() {
+		passphrase, err := c.verifiedPassphrase()
+		if err != nil {
+			return nil, err
+		}
+		return openpgp.SymmetricallyEncrypt(w, passphrase, hints, symmetricConfig)
+	}
+
+	el, err := c.validEntityList(recipients)
 	if err != nil {
 		return nil, err
 	}
+	var signer *openpgp.Entity
+	if c.SignerKeyID != "" {
+		signer, err = c.EntitySigner()
+		if err != nil {
+			return nil, err
+		}
+	}
+	return openpgp.Encrypt(w, el, signer, hints, nil)
+}
 
+// encrypt returns the armored encrypted form of the clear text for the space separated list of recipients.
+// The hints describe the clear text, they can be nil.
+func (c *Config) encrypt(clear []byte, hints *openpgp.FileHints, recipients string) ([]byte, error) {
 	buf := bytes.NewBuffer(nil)
 	aw, err := armor.Encode(
 		buf,
 		"PGP MESSAGE",
 		map[string]string{"Version": "OpenPGP"},
 	)
-	var signer *openpgp.Entity
-	if a.config.SignerKeyID != "" {
-		signer, err = a.config.EntitySigner()
-		if err != nil {
-			return nil, err
-		}
+	if err != nil {
+		return nil, err
 	}
 
-	w, err := openpgp.Encrypt(aw, el, signer, nil, nil)
+	w, err := c.encryptWriter(aw, hints, recipients)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = w.Write(a.Bytes())
+	_, err = w.Write(clear)
 	if err != nil {
 		return nil, err
 	}
@@ -6,7 +6,11 @@ import (
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
 	"testing"
+	"time"
+
+	"golang.org/x/crypto/openpgp"
 )
 
 func Test_NewConfig(t *testing.T) {
@@ -68,7 +72,7 @@ func Test_filterEntityList(t *testing.T) {
 	keyid := os.Getenv("GPGKEY")
 
 	el, err := getKeyRing(path)
-	el = filterEntityList(el, keyid)
+	el, err = filterEntityList(el, keyid)
 	if err != nil {
 		t.Errorf("An error occured while filtering the entity list: %v", err)
 	}
@@ -84,6 +88,43 @@ func Test_filterEntityList(t *testing.T) {
 	}
 }
 
+var filterEntityListCases = []struct {
+	ids     string
+	matches int
+	isErr   bool
+}{
+	{"6A8D785C", 1, false},
+	{"0x659FCF596A8D785C", 1, false},
+	{"F6B1D530656C7863EDBB0760659FCF596A8D785C", 1, false},
+	{"testingKeyForKeep@example.com", 1, false},
+	{"<TestingKeyForKeep@example.com>", 1, false},
+	{"4141932281A3A338", 1, false},
+	{"6A8D785C 659FCF596A8D785C", 1, false},
+	{"DEADBEEF", 0, true},
+	{"6A8D785C nobody@example.com", 0, true},
+}
+
+func Test_filterEntityList_Matching(t *testing.T) {
+	f, err := os.Open("test_data/6A8D785C.gpg.asc")
+	if err != nil {
+		t.Fatal("An error occured while opening the test key", err)
+	}
+	defer f.Close()
+	el, err := openpgp.ReadArmoredKeyRing(f)
+	if err != nil {
+		t.Fatal("An error occured while reading the test key", err)
+	}
+	for _, c := range filterEntityListCases {
+		fel, err := filterEntityList(el, c.ids)
+		if c.isErr != (err != nil) {
+			t.Errorf("%q: unexpected error value : %v", c.ids, err)
+		}
+		if len(fel) != c.matches {
+			t.Errorf("%q: got %d entities -- expected %d", c.ids, len(fel), c.matches)
+		}
+	}
+}
+
 func Test_DecryptFile(t *testing.T) {
 	encryptedfile := "test_data/passwords/testsuite-signed-account"
 	c := NewConfig(nil)
@@ -139,7 +180,7 @@ func Test_Account_EncryptFile(t *testing.T) {
 		config:   c,
 		Name:     "name",
 		Username: "username",
-		Password: "password",
+		Password: NewSecret([]byte("password")),
 		Notes:    "note",
 	}
 	crypt, err := a.Encrypt()
@@ -152,7 +193,7 @@ func Test_Account_EncryptFile(t *testing.T) {
 func Test_AccountString(t *testing.T) {
 	a := Account{
 		Username: "u",
-		Password: "p",
+		Password: NewSecret([]byte("p")),
 		Notes:    "n",
 	}
 	got := a.Bytes()
@@ -165,12 +206,12 @@ func Test_AccountString(t *testing.T) {
 
 func Test_NewAccount(t *testing.T) {
 	s := "p\nu\nn"
-	a, err := newAccountFromFileContent(nil, "nameAccount", s)
+	a, err := newAccountFromFileContent(nil, "nameAccount", []byte(s))
 	if err != nil {
 		t.Errorf("An error occured while scanning an account from a string : %s", err)
 	}
-	if a.Password != "p" {
-		t.Errorf("Not the expected password : %s", a.Password)
+	if string(a.Password.Bytes()) != "p" {
+		t.Errorf("Not the expected password : %s", a.Password.Bytes())
 	}
 }
 
@@ -188,3 +229,280 @@ func Test_NewPassword(t *testing.T) {
 		}
 	}
 }
+
+var parseDurationCases = []struct {
+	s        string
+	expected time.Duration
+	isErr    bool
+}{
+	{"30d", 30 * 24 * time.Hour, false},
+	{"2w", 14 * 24 * time.Hour, false},
+	{"12h", 12 * time.Hour, false},
+	{"xd", 0, true},
+	{"-30d", 0, true},
+	{"-1w", 0, true},
+	{"-12h", 0, true},
+	{"0d", 0, false},
+}
+
+func Test_ParseDuration(t *testing.T) {
+	for _, c := range parseDurationCases {
+		got, err := ParseDuration(c.s)
+		if c.isErr != (err != nil) {
+			t.Errorf("%s: unexpected error value : %v", c.s, err)
+		}
+		if got != c.expected {
+			t.Errorf("%s: got %v -- expected %v", c.s, got, c.expected)
+		}
+	}
+}
+
+func Test_Account_Save_Timestamps(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-timestamps")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = dir
+
+	a := NewAccount(c, "example.com", "u", "p", "n")
+	if err := a.Save(); err != nil {
+		t.Fatal("An error occured while saving the account", err)
+	}
+	if a.Created.IsZero() || a.Updated != a.Created || a.PasswordChanged != a.Created {
+		t.Fatal("Expected the timestamps of a new account to be set; got :", a.Created, a.Updated, a.PasswordChanged)
+	}
+
+	// Pretend the account has been written a while ago
+	past := a.Created.Add(-24 * time.Hour)
+	a.Created, a.Updated, a.PasswordChanged = past, past, past
+	if err := a.Save(); err != nil {
+		t.Fatal(err)
+	}
+	read, err := NewAccountFromFile(c, "example.com")
+	if err != nil {
+		t.Fatal("An error occured while reading the account", err)
+	}
+	if read.Created != past || read.PasswordChanged != past || read.Updated == past {
+		t.Error("Expected only Updated to change; got :", read.Created, read.Updated, read.PasswordChanged)
+	}
+
+	// Re-encrypting an unchanged account keeps its timestamps
+	read.Updated = past
+	read.saved = read.digest()
+	if err := read.Save(); err != nil {
+		t.Fatal(err)
+	}
+	if read.Updated != past {
+		t.Error("Expected Updated to be kept for an unchanged account; got :", read.Updated)
+	}
+
+	read.Password = NewSecret([]byte("new password"))
+	if err := read.Save(); err != nil {
+		t.Fatal(err)
+	}
+	if read.Created != past || read.PasswordChanged == past || read.Updated == past {
+		t.Error("Expected PasswordChanged and Updated to change; got :", read.Created, read.Updated, read.PasswordChanged)
+	}
+}
+
+func Test_ParseDate(t *testing.T) {
+	got, err := ParseDate("2018-03-01")
+	if err != nil || !got.Equal(time.Date(2018, 3, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("Unexpected date :", got, err)
+	}
+	got, err = ParseDate("90d")
+	if err != nil || got.Before(time.Now().Add(89*24*time.Hour)) {
+		t.Error("Unexpected date :", got, err)
+	}
+	if _, err = ParseDate("tomorrow"); err == nil {
+		t.Error("Expected an error for an invalid date")
+	}
+}
+
+func Test_Account_History(t *testing.T) {
+	c := NewConfig(nil)
+	c.PasswordHistory = 2
+	dir, err := ioutil.TempDir("", "keep-history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = dir
+
+	a := NewAccount(c, "example.com", "u", "first", "n")
+	for _, p := range []string{"first", "second", "third", "fourth"} {
+		a.Password = NewSecret([]byte(p))
+		if err := a.Save(); err != nil {
+			t.Fatal("An error occured while saving the account", err)
+		}
+	}
+	read, err := NewAccountFromFile(c, "example.com")
+	if err != nil {
+		t.Fatal("An error occured while reading the account", err)
+	}
+	if len(read.History) != 2 || string(read.History[0].Password.Bytes()) != "third" || string(read.History[1].Password.Bytes()) != "second" {
+		t.Fatal("Expected the 2 previous passwords, the most recent first; got :", read.History)
+	}
+	if read.History[0].Replaced.IsZero() {
+		t.Error("Expected the time the password was replaced")
+	}
+
+	c.PasswordHistory = -1
+	read.Notes = "no more history"
+	if err := read.Save(); err != nil {
+		t.Fatal(err)
+	}
+	if len(read.History) != 0 {
+		t.Error("Expected the history to be dropped; got :", read.History)
+	}
+}
+
+func Test_Config_VerifyPassphrase_NoSigner(t *testing.T) {
+	c := NewConfig(nil)
+	c.SignerKeyID = ""
+	c.PromptFunction = promptFromString(os.Getenv("GPGPASSPHRASE"))
+	if err := c.VerifyPassphrase(); err != nil {
+		t.Error("Expected the passphrase of a profile without signer to be verified; got :", err)
+	}
+	c.PromptFunction = func(keys []openpgp.Key, symmetric bool) ([]byte, error) {
+		return []byte("wrong passphrase"), nil
+	}
+	if err := c.VerifyPassphrase(); err != ErrWrongPassphrase {
+		t.Errorf("got : %v - expected : %v", err, ErrWrongPassphrase)
+	}
+	c.RecipientKeyIds = "0000000000000000"
+	if err := c.VerifyPassphrase(); err == nil {
+		t.Error("Expected an error when no secret key matches the recipients")
+	}
+}
+
+func Test_Account_Save_LineBreaks(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-linebreaks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = dir
+
+	a := NewAccount(c, "example.com", "u", "p", "n")
+	a.Username = "u\nX-Injected: value"
+	if err := a.Save(); err != errUsernameLineBreak {
+		t.Errorf("got : %v - expected : %v", err, errUsernameLineBreak)
+	}
+	a.Username = "u"
+	a.Password = NewSecret([]byte("p\r"))
+	if err := a.Save(); err != errPasswordLineBreak {
+		t.Errorf("got : %v - expected : %v", err, errPasswordLineBreak)
+	}
+	if _, err := os.Stat(a.Path()); !os.IsNotExist(err) {
+		t.Errorf("Expected no account to be written; got : %v", err)
+	}
+	if err := a.SetField("username", "u\n"); err != errUsernameLineBreak {
+		t.Errorf("got : %v - expected : %v", err, errUsernameLineBreak)
+	}
+	if err := a.SetField("password", "p\nu"); err != errPasswordLineBreak {
+		t.Errorf("got : %v - expected : %v", err, errPasswordLineBreak)
+	}
+
+	// The carriage returns of an account written with CRLF are written back
+	read, err := newAccountFromFileContent(c, "example.com", []byte("p\r\nu\r\nn"))
+	if err != nil {
+		t.Fatal("An error occured while parsing the account", err)
+	}
+	if err := read.checkLineBreaks(); err != nil {
+		t.Error("Expected the values read with CRLF to be accepted; got :", err)
+	}
+}
+
+func Test_Account_Remove_Wipe(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-remove")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = dir
+
+	a := NewAccount(c, "example.com", "u", "first", "n")
+	for _, p := range []string{"first", "second"} {
+		a.Password = NewSecret([]byte(p))
+		if err := a.Save(); err != nil {
+			t.Fatal("An error occured while saving the account", err)
+		}
+	}
+	read, err := NewAccountFromFile(c, "example.com")
+	if err != nil || len(read.History) != 1 {
+		t.Fatal("An error occured while reading the account", err)
+	}
+	password, previous := read.Password, read.History[0].Password
+	if err := read.Remove(); err != nil {
+		t.Fatal("An error occured while removing the account", err)
+	}
+	if _, err := os.Stat(read.Path()); !os.IsNotExist(err) {
+		t.Errorf("Expected the account to be removed; got : %v", err)
+	}
+	if password.Len() != 0 || previous.Len() != 0 {
+		t.Errorf("Expected the passwords to be wiped; got : %q %q", password.Bytes(), previous.Bytes())
+	}
+}
+
+func Test_PreviousPassword_RoundTrip(t *testing.T) {
+	at := time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC)
+	passwords := []string{"", "trailing ", " leading", "two  spaces", `"quoted"`, "tab\there", "new\nline", "\x00\x7f\xff", "été \u2028"}
+	a := NewAccount(nil, "name", "u", "p", "n")
+	for _, p := range passwords {
+		a.History = append(a.History, PreviousPassword{NewSecret([]byte(p)), at})
+	}
+	a.extra = append(a.extra, field{"X-Padded", " value "})
+
+	read, err := newAccountFromFileContent(nil, "name", a.Bytes())
+	if err != nil {
+		t.Fatal("An error occured while parsing the account", err)
+	}
+	if len(read.History) != len(passwords) {
+		t.Fatalf("Expected %d previous passwords; got : %v", len(passwords), read.History)
+	}
+	for i, p := range passwords {
+		if string(read.History[i].Password.Bytes()) != p || !read.History[i].Replaced.Equal(at) {
+			t.Errorf("got : %q - expected : %q", read.History[i].Password.Bytes(), p)
+		}
+	}
+	if v, _ := read.Field("X-Padded"); v != " value " {
+		t.Errorf("Expected the spaces of the value to be kept; got : %q", v)
+	}
+
+	// Older versions of keep wrote the previous passwords unquoted
+	legacy, err := newAccountFromFileContent(nil, "name", []byte("p\nu\nn\nPrevious-Password: 2017-06-01T10:00:00Z old pass"))
+	if err != nil {
+		t.Fatal("An error occured while parsing the account", err)
+	}
+	if len(legacy.History) != 1 || string(legacy.History[0].Password.Bytes()) != "old pass" {
+		t.Error("Expected the unquoted previous password to be read; got :", legacy.History)
+	}
+}
+
+func Test_promptTerminal_NoTerminal(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+	defer func(input *os.File, path string) {
+		terminalInput, ttyPath = input, path
+	}(terminalInput, ttyPath)
+	// stdin carries the protocol of a credential helper and there is no controlling terminal
+	terminalInput, ttyPath = r, filepath.Join(os.TempDir(), "keep-no-tty")
+
+	fmt.Fprint(w, "protocol=https\n")
+	w.Close()
+	if _, err := promptTerminal(nil, true); err != errNoTerminal {
+		t.Error("Expected errNoTerminal; got :", err)
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil || string(b) != "protocol=https\n" {
+		t.Errorf("Expected stdin to be left untouched; got : %q %v", b, err)
+	}
+}
@@ -0,0 +1,250 @@
+package keep
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/packet"
+)
+
+const (
+	// KeyPolicyRefuse refuses to encrypt to or sign with an expired or revoked key.
+	KeyPolicyRefuse = "refuse"
+	// KeyPolicyWarn prints a warning and uses the expired or revoked key anyway.
+	// An entity whose encryption subkeys have all expired cannot be encrypted to, even with this policy.
+	KeyPolicyWarn = "warn"
+)
+
+// validKeyPolicy returns an error if policy is neither empty, which means refuse, nor one of the KeyPolicy constants.
+func validKeyPolicy(policy string) error {
+	switch policy {
+	case "", KeyPolicyRefuse, KeyPolicyWarn:
+		return nil
+	}
+	return fmt.Errorf("Unknown KeyPolicy %q, expected %q or %q", policy, KeyPolicyRefuse, KeyPolicyWarn)
+}
+
+// primaryIdentity returns the Identity marked as primary or the first identity.
+func primaryIdentity(e *openpgp.Entity) *openpgp.Identity {
+	var first *openpgp.Identity
+	for _, i := range e.Identities {
+		if first == nil || i.Name < first.Name {
+			first = i
+		}
+		if i.SelfSignature != nil && i.SelfSignature.IsPrimaryId != nil && *i.SelfSignature.IsPrimaryId {
+			return i
+		}
+	}
+	return first
+}
+
+// PrimaryIdentity returns the name of the identity marked as primary, or of the first identity,
+// of the entity.
+func PrimaryIdentity(e *openpgp.Entity) string {
+	if i := primaryIdentity(e); i != nil {
+		return i.Name
+	}
+	return ""
+}
+
+// keyExpiry returns the time at which a key expires according to its self signature.
+// The zero time is returned if the key does not expire.
+func keyExpiry(pk *packet.PublicKey, sig *packet.Signature) time.Time {
+	if sig == nil || sig.KeyLifetimeSecs == nil || *sig.KeyLifetimeSecs == 0 {
+		return time.Time{}
+	}
+	return pk.CreationTime.Add(time.Duration(*sig.KeyLifetimeSecs) * time.Second)
+}
+
+func isExpired(expiry time.Time, now time.Time) bool {
+	return !expiry.IsZero() && now.After(expiry)
+}
+
+// subkeyRevoked returns true if a valid revocation signature exists for the subkey.
+// The openpgp package attaches revocation signatures following a binding
+// signature to the last identity so we look for them there as well.
+func subkeyRevoked(e *openpgp.Entity, subkey openpgp.Subkey) bool {
+	if subkey.Sig.SigType == packet.SigTypeSubkeyRevocation {
+		return true
+	}
+	for _, i := range e.Identities {
+		for _, sig := range i.Signatures {
+			if sig.SigType != packet.SigTypeSubkeyRevocation {
+				continue
+			}
+			if e.PrimaryKey.VerifyKeySignature(subkey.PublicKey, sig) == nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// encryptionSubkey returns the subkey that openpgp.Encrypt would choose, ignoring
+// its expiration, or nil if the entity has no encryption subkey.
+// When no such subkey is left, the most recent revoked subkey able to encrypt is returned so that
+// the revocation is reported: openpgp replaces the binding signature of a revoked subkey, and its
+// flags, by the revocation signature.
+func encryptionSubkey(e *openpgp.Entity) *openpgp.Subkey {
+	var candidate, revoked *openpgp.Subkey
+	for i, subkey := range e.Subkeys {
+		switch {
+		case !subkey.PublicKey.PubKeyAlgo.CanEncrypt():
+		case subkey.Sig.SigType == packet.SigTypeSubkeyRevocation:
+			if revoked == nil || subkey.Sig.CreationTime.After(revoked.Sig.CreationTime) {
+				revoked = &e.Subkeys[i]
+			}
+		case subkey.Sig.FlagsValid && subkey.Sig.FlagEncryptCommunications:
+			if candidate == nil || subkey.Sig.CreationTime.After(candidate.Sig.CreationTime) {
+				candidate = &e.Subkeys[i]
+			}
+		}
+	}
+	if candidate == nil {
+		return revoked
+	}
+	return candidate
+}
+
+// KeyExpiry returns when the entity stops being usable to encrypt: the earliest
+// of the primary key and of the encryption subkey expirations.
+// The zero time is returned if the entity does not expire.
+func KeyExpiry(e *openpgp.Entity) time.Time {
+	var expiry time.Time
+	if i := primaryIdentity(e); i != nil {
+		expiry = keyExpiry(e.PrimaryKey, i.SelfSignature)
+	}
+	if subkey := encryptionSubkey(e); subkey != nil {
+		sexpiry := keyExpiry(subkey.PublicKey, subkey.Sig)
+		if expiry.IsZero() || (!sexpiry.IsZero() && sexpiry.Before(expiry)) {
+			expiry = sexpiry
+		}
+	}
+	return expiry
+}
+
+// keyProblems returns a description of the reasons why the entity should not be used at the given time.
+// When encryption is true the encryption subkey is checked as well.
+func keyProblems(e *openpgp.Entity, now time.Time, encryption bool) []string {
+	var problems []string
+	fpr := Fingerprint(e.PrimaryKey)
+	if len(e.Revocations) > 0 {
+		problems = append(problems, fmt.Sprintf("key %s has been revoked", fpr))
+	}
+	if i := primaryIdentity(e); i != nil {
+		if expiry := keyExpiry(e.PrimaryKey, i.SelfSignature); isExpired(expiry, now) {
+			problems = append(problems, fmt.Sprintf("key %s expired on %s", fpr, expiry.Format("2006-01-02")))
+		}
+	}
+	if !encryption {
+		return problems
+	}
+	if subkey := encryptionSubkey(e); subkey != nil {
+		sfpr := Fingerprint(subkey.PublicKey)
+		if subkeyRevoked(e, *subkey) {
+			problems = append(problems, fmt.Sprintf("encryption subkey %s of %s has been revoked", sfpr, fpr))
+		}
+		if expiry := keyExpiry(subkey.PublicKey, subkey.Sig); isExpired(expiry, now) {
+			problems = append(problems, fmt.Sprintf("encryption subkey %s of %s expired on %s", sfpr, fpr, expiry.Format("2006-01-02")))
+		}
+	}
+	return problems
+}
+
+// canEncryptTo returns false if openpgp refuses to encrypt to the entity at the given time, which
+// happens when its encryption subkeys have expired whatever the KeyPolicy. It follows the choice
+// of the encryption key made by openpgp.Encrypt.
+func canEncryptTo(e *openpgp.Entity, now time.Time) bool {
+	for _, subkey := range e.Subkeys {
+		if subkey.Sig.FlagsValid &&
+			subkey.Sig.FlagEncryptCommunications &&
+			subkey.PublicKey.PubKeyAlgo.CanEncrypt() &&
+			!subkey.Sig.KeyExpired(now) {
+			return true
+		}
+	}
+	i := primaryIdentity(e)
+	if i == nil || i.SelfSignature == nil {
+		return false
+	}
+	return !i.SelfSignature.FlagsValid || i.SelfSignature.FlagEncryptCommunications &&
+		e.PrimaryKey.PubKeyAlgo.CanEncrypt() &&
+		!i.SelfSignature.KeyExpired(now)
+}
+
+// checkKeys applies the Config KeyPolicy to the problems found on the entities.
+// An error is returned when the policy is to refuse, otherwise the problems are reported as warnings.
+// With the warn policy an error is still returned for the entities openpgp cannot encrypt to.
+func (c *Config) checkKeys(el openpgp.EntityList, encryption bool) error {
+	err := validKeyPolicy(c.KeyPolicy)
+	if err != nil {
+		return err
+	}
+	var problems, unusable []string
+	now := time.Now()
+	for _, e := range el {
+		kp := keyProblems(e, now, encryption)
+		problems = append(problems, kp...)
+		if len(kp) > 0 && encryption && !canEncryptTo(e, now) {
+			unusable = append(unusable, Fingerprint(e.PrimaryKey))
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	if c.KeyPolicy != KeyPolicyWarn {
+		return fmt.Errorf("Refusing to use expired or revoked keys: %s", strings.Join(problems, ", "))
+	}
+	if len(unusable) > 0 {
+		return fmt.Errorf("Cannot encrypt to keys without a valid encryption subkey, even with the warn KeyPolicy: %s", strings.Join(unusable, ", "))
+	}
+	for _, p := range problems {
+		c.warnf("%s", p)
+	}
+	return nil
+}
+
+// KeyExpiration describes when a recipient key expires.
+type KeyExpiration struct {
+	Fingerprint string
+	Identity    string
+	Expires     time.Time
+	Revoked     bool
+}
+
+// ExpiringRecipients returns the recipient keys that expire within the given duration.
+// Keys that have already expired or have been revoked are included. The result is sorted by expiry.
+func (c *Config) ExpiringRecipients(within time.Duration) ([]KeyExpiration, error) {
+	el, err := c.EntityListRecipients()
+	if err != nil {
+		return nil, err
+	}
+	limit := time.Now().Add(within)
+	var expiring []KeyExpiration
+	for _, e := range el {
+		ke := KeyExpiration{
+			Fingerprint: Fingerprint(e.PrimaryKey),
+			Expires:     KeyExpiry(e),
+			Revoked:     len(e.Revocations) > 0,
+		}
+		if subkey := encryptionSubkey(e); subkey != nil && subkeyRevoked(e, *subkey) {
+			ke.Revoked = true
+		}
+		if i := primaryIdentity(e); i != nil {
+			ke.Identity = i.Name
+		}
+		if ke.Revoked || isExpired(ke.Expires, limit) {
+			expiring = append(expiring, ke)
+		}
+	}
+	sort.Slice(expiring, func(i, j int) bool {
+		if expiring[i].Expires.IsZero() {
+			return false
+		}
+		return expiring[j].Expires.IsZero() || expiring[i].Expires.Before(expiring[j].Expires)
+	})
+	return expiring, nil
+}
@@ -0,0 +1,155 @@
+package keep
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/packet"
+)
+
+func newTestEntity(t *testing.T) *openpgp.Entity {
+	e, err := openpgp.NewEntity("keep", "test", "keep@example.com", &packet.Config{RSABits: 1024})
+	if err != nil {
+		t.Fatal("An error occured while generating an entity", err)
+	}
+	return e
+}
+
+func Test_keyProblems(t *testing.T) {
+	now := time.Now()
+	e := newTestEntity(t)
+	if problems := keyProblems(e, now, true); len(problems) != 0 {
+		t.Error("Expected no problem for a fresh key; got :", problems)
+	}
+
+	lifetime := uint32(3600)
+	e.Subkeys[0].Sig.KeyLifetimeSecs = &lifetime
+	if problems := keyProblems(e, now.Add(2*time.Hour), false); len(problems) != 0 {
+		t.Error("Expected the subkey to be ignored when signing; got :", problems)
+	}
+	if problems := keyProblems(e, now.Add(2*time.Hour), true); len(problems) != 1 {
+		t.Error("Expected the encryption subkey to be expired; got :", problems)
+	}
+	if expiry := KeyExpiry(e); !expiry.Equal(e.Subkeys[0].PublicKey.CreationTime.Add(time.Hour)) {
+		t.Error("Unexpected expiry :", expiry)
+	}
+
+	e.Revocations = append(e.Revocations, &packet.Signature{SigType: packet.SigTypeKeyRevocation})
+	if problems := keyProblems(e, now, false); len(problems) != 1 {
+		t.Error("Expected the key to be revoked; got :", problems)
+	}
+}
+
+func Test_Config_checkKeys(t *testing.T) {
+	e := newTestEntity(t)
+	e.Revocations = append(e.Revocations, &packet.Signature{SigType: packet.SigTypeKeyRevocation})
+	el := openpgp.EntityList{e}
+
+	c := &Config{}
+	if err := c.checkKeys(el, true); err == nil {
+		t.Error("Expected the default policy to refuse a revoked key")
+	}
+
+	warnings := 0
+	c = &Config{
+		KeyPolicy: KeyPolicyWarn,
+		Warnf:     func(format string, a ...interface{}) { warnings++ },
+	}
+	if err := c.checkKeys(el, true); err != nil {
+		t.Error("Expected the warn policy to accept a revoked key; got :", err)
+	}
+	if warnings != 1 {
+		t.Errorf("Expected 1 warning; got : %d", warnings)
+	}
+}
+
+func Test_Config_checkKeys_Policy(t *testing.T) {
+	e := newTestEntity(t)
+	el := openpgp.EntityList{e}
+
+	c := &Config{KeyPolicy: "ignore"}
+	if err := c.checkKeys(el, true); err == nil {
+		t.Error("Expected an unknown policy to be rejected")
+	}
+
+	// An expired encryption subkey cannot be used by openpgp, even with the warn policy.
+	lifetime := uint32(1)
+	e.Subkeys[0].Sig.KeyLifetimeSecs = &lifetime
+	e.Subkeys[0].Sig.CreationTime = time.Now().Add(-time.Hour)
+	e.Subkeys[0].PublicKey.CreationTime = e.Subkeys[0].Sig.CreationTime
+	c = &Config{
+		KeyPolicy: KeyPolicyWarn,
+		Warnf:     func(format string, a ...interface{}) {},
+	}
+	if err := c.checkKeys(el, true); err == nil {
+		t.Error("Expected an error for a key without a valid encryption subkey")
+	}
+	if err := c.checkKeys(el, false); err != nil {
+		t.Error("Expected the expired encryption subkey to be ignored when signing; got :", err)
+	}
+}
+
+// revokeSubkey returns the entity read back from its public key followed by a revocation of its subkey.
+func revokeSubkey(t *testing.T, e *openpgp.Entity) *openpgp.Entity {
+	sig := &packet.Signature{
+		SigType:      packet.SigTypeSubkeyRevocation,
+		PubKeyAlgo:   e.PrimaryKey.PubKeyAlgo,
+		Hash:         e.Subkeys[0].Sig.Hash,
+		CreationTime: time.Now(),
+		IssuerKeyId:  &e.PrimaryKey.KeyId,
+	}
+	if err := sig.SignKey(e.Subkeys[0].PublicKey, e.PrivateKey, nil); err != nil {
+		t.Fatal("An error occured while revoking the subkey", err)
+	}
+	buf := bytes.NewBuffer(nil)
+	if err := e.Serialize(buf); err != nil {
+		t.Fatal(err)
+	}
+	if err := sig.Serialize(buf); err != nil {
+		t.Fatal(err)
+	}
+	el, err := openpgp.ReadKeyRing(buf)
+	if err != nil || len(el) != 1 {
+		t.Fatal("An error occured while reading the revoked key", err)
+	}
+	return el[0]
+}
+
+func Test_keyProblems_RevokedSubkey(t *testing.T) {
+	e := revokeSubkey(t, newTestEntity(t))
+	problems := keyProblems(e, time.Now(), true)
+	if len(problems) != 1 {
+		t.Fatal("Expected the encryption subkey to be revoked; got :", problems)
+	}
+	if canEncryptTo(e, time.Now()) {
+		t.Error("Expected a key whose only encryption subkey is revoked to be unusable")
+	}
+	c := &Config{KeyPolicy: KeyPolicyWarn, Warnf: func(format string, a ...interface{}) {}}
+	if err := c.checkKeys(openpgp.EntityList{e}, true); err == nil {
+		t.Error("Expected an error for a key without a valid encryption subkey")
+	}
+
+	dir, err := ioutil.TempDir("", "keep-revoked")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	buf := bytes.NewBuffer(nil)
+	e.Serialize(buf)
+	c = &Config{PubringDir: filepath.Join(dir, "pubring.gpg"), RecipientKeyIds: Fingerprint(e.PrimaryKey)}
+	if err := ioutil.WriteFile(c.PubringDir, buf.Bytes(), 0600); err != nil {
+		t.Fatal(err)
+	}
+	expiring, err := c.ExpiringRecipients(0)
+	if err != nil {
+		t.Fatal("An error occured while listing the expiring keys", err)
+	}
+	if len(expiring) != 1 || !expiring[0].Revoked {
+		t.Error("Expected the key to be listed as revoked; got :", expiring)
+	}
+}
@@ -0,0 +1,244 @@
+package keep
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha1"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	"golang.org/x/crypto/openpgp/packet"
+	"golang.org/x/crypto/openpgp/s2k"
+)
+
+const (
+	keyPairBits = 4096
+
+	packetTagSecretKey    = 5
+	packetTagSecretSubkey = 7
+
+	// s2kUsageSHA1 indicates that the secret key material is encrypted and followed by its SHA-1 hash.
+	s2kUsageSHA1 = 254
+	// cipherAES256 is the OpenPGP identifier of AES-256.
+	cipherAES256 = 9
+)
+
+// NewKeyPair returns a new RSA-4096 Entity, with an encryption subkey, for the given identity.
+// The self signatures are computed so that the Entity can be serialized.
+func NewKeyPair(name, email string) (*openpgp.Entity, error) {
+	return newKeyPair(name, email, keyPairBits)
+}
+
+func newKeyPair(name, email string, bits int) (*openpgp.Entity, error) {
+	config := &packet.Config{RSABits: bits, DefaultHash: crypto.SHA256}
+	e, err := openpgp.NewEntity(name, "keep", email, config)
+	if err != nil {
+		return nil, err
+	}
+	for _, i := range e.Identities {
+		i.SelfSignature.PreferredSymmetric = []uint8{uint8(packet.CipherAES256), uint8(packet.CipherAES192), uint8(packet.CipherAES128)}
+	}
+	// SerializePrivate is the only way to sign the identities and the subkey
+	err = e.SerializePrivate(ioutil.Discard, config)
+	if err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// readPacket splits the first packet of b into its tag, its body and the rest of b.
+// Only the new packet format with a definite length is supported, this is what packet.Serialize produces.
+func readPacket(b []byte) (tag byte, body []byte, rest []byte, err error) {
+	if len(b) < 2 || b[0]&0xc0 != 0xc0 {
+		return 0, nil, nil, fmt.Errorf("unsupported packet header")
+	}
+	tag = b[0] & 0x3f
+	var length, n int
+	switch l := int(b[1]); {
+	case l < 192:
+		length, n = l, 2
+	case l < 224 && len(b) >= 3:
+		length, n = ((l-192)<<8)+int(b[2])+192, 3
+	case l == 255 && len(b) >= 6:
+		length, n = int(b[2])<<24|int(b[3])<<16|int(b[4])<<8|int(b[5]), 6
+	default:
+		return 0, nil, nil, fmt.Errorf("unsupported packet length")
+	}
+	if len(b) < n+length {
+		return 0, nil, nil, fmt.Errorf("truncated packet")
+	}
+	return tag, b[n : n+length], b[n+length:], nil
+}
+
+func writePacket(w io.Writer, tag byte, body []byte) error {
+	var header []byte
+	switch length := len(body); {
+	case length < 192:
+		header = []byte{0xc0 | tag, byte(length)}
+	case length < 8384:
+		length -= 192
+		header = []byte{0xc0 | tag, 192 + byte(length>>8), byte(length)}
+	default:
+		header = []byte{0xc0 | tag, 255, byte(length >> 24), byte(length >> 16), byte(length >> 8), byte(length)}
+	}
+	_, err := w.Write(header)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(body)
+	return err
+}
+
+// rsaPublicKeyLength returns the length of the public part of an RSA secret key packet body:
+// version, creation time, algorithm and the n and e MPIs.
+func rsaPublicKeyLength(body []byte) (int, error) {
+	if len(body) < 6 || body[0] != 4 || packet.PublicKeyAlgorithm(body[5]) != packet.PubKeyAlgoRSA {
+		return 0, fmt.Errorf("only v4 RSA keys are supported")
+	}
+	offset := 6
+	for i := 0; i < 2; i++ {
+		if len(body) < offset+2 {
+			return 0, fmt.Errorf("truncated public key")
+		}
+		bits := int(body[offset])<<8 | int(body[offset+1])
+		offset += 2 + (bits+7)/8
+	}
+	if len(body) < offset {
+		return 0, fmt.Errorf("truncated public key")
+	}
+	return offset, nil
+}
+
+// encryptSecretKeyBody encrypts the secret MPIs of a clear secret key packet body
+// with a key derived from the passphrase, as described in RFC 4880 section 5.5.3.
+func encryptSecretKeyBody(body, passphrase []byte) ([]byte, error) {
+	pubLen, err := rsaPublicKeyLength(body)
+	if err != nil {
+		return nil, err
+	}
+	// public key | s2k usage (0) | secret MPIs | 2 bytes checksum
+	if len(body) < pubLen+3 || body[pubLen] != 0 {
+		return nil, fmt.Errorf("the secret key is already encrypted")
+	}
+	mpis := body[pubLen+1 : len(body)-2]
+
+	buf := bytes.NewBuffer(nil)
+	buf.Write(body[:pubLen])
+	buf.Write([]byte{s2kUsageSHA1, cipherAES256})
+	key := make([]byte, 32)
+	err = s2k.Serialize(buf, key, rand.Reader, passphrase, &s2k.Config{Hash: crypto.SHA256, S2KCount: symmetricConfig.S2KCount})
+	if err != nil {
+		return nil, err
+	}
+	iv := make([]byte, aes.BlockSize)
+	_, err = io.ReadFull(rand.Reader, iv)
+	if err != nil {
+		return nil, err
+	}
+	buf.Write(iv)
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha1.Sum(mpis)
+	clear := append(append([]byte{}, mpis...), sum[:]...)
+	encrypted := make([]byte, len(clear))
+	cipher.NewCFBEncrypter(block, iv).XORKeyStream(encrypted, clear)
+	buf.Write(encrypted)
+	return buf.Bytes(), nil
+}
+
+// SerializeEncryptedPrivate writes the Entity, including its private keys encrypted with passphrase, to w.
+// The Entity must have been created by NewKeyPair.
+func SerializeEncryptedPrivate(w io.Writer, e *openpgp.Entity, passphrase []byte) error {
+	if len(passphrase) == 0 {
+		return fmt.Errorf("The passphrase cannot be empty")
+	}
+	clear := bytes.NewBuffer(nil)
+	err := e.SerializePrivate(clear, nil)
+	if err != nil {
+		return err
+	}
+	b := clear.Bytes()
+	for len(b) > 0 {
+		tag, body, rest, err := readPacket(b)
+		if err != nil {
+			return err
+		}
+		if tag == packetTagSecretKey || tag == packetTagSecretSubkey {
+			body, err = encryptSecretKeyBody(body, passphrase)
+			if err != nil {
+				return err
+			}
+		}
+		err = writePacket(w, tag, body)
+		if err != nil {
+			return err
+		}
+		b = rest
+	}
+	return nil
+}
+
+// WriteKeyPair writes the public keyring, the secret keyring and an armored
+// backup of the secret key. The secret key is encrypted with the passphrase.
+// The backup is skipped if backupPath is empty.
+func WriteKeyPair(e *openpgp.Entity, passphrase []byte, pubringPath, secringPath, backupPath string) error {
+	secret := bytes.NewBuffer(nil)
+	err := SerializeEncryptedPrivate(secret, e, passphrase)
+	if err != nil {
+		return err
+	}
+	public := bytes.NewBuffer(nil)
+	err = e.Serialize(public)
+	if err != nil {
+		return err
+	}
+
+	files := map[string][]byte{
+		pubringPath: public.Bytes(),
+		secringPath: secret.Bytes(),
+	}
+	if backupPath != "" {
+		backup := bytes.NewBuffer(nil)
+		aw, err := armor.Encode(backup, openpgp.PrivateKeyType, nil)
+		if err != nil {
+			return err
+		}
+		_, err = aw.Write(secret.Bytes())
+		if err != nil {
+			return err
+		}
+		err = aw.Close()
+		if err != nil {
+			return err
+		}
+		files[backupPath] = backup.Bytes()
+	}
+
+	for path := range files {
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			return fmt.Errorf("Refusing to overwrite %s", path)
+		}
+	}
+	for path, content := range files {
+		err = os.MkdirAll(filepath.Dir(path), 0700)
+		if err != nil {
+			return err
+		}
+		err = ioutil.WriteFile(path, content, 0600)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
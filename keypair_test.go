@@ -0,0 +1,154 @@
+package keep
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/crypto/openpgp"
+)
+
+func Test_SerializeEncryptedPrivate(t *testing.T) {
+	// A small key keeps the test fast, NewKeyPair generates 4096 bits keys.
+	e, err := newKeyPair("keep", "keep@example.com", 1024)
+	if err != nil {
+		t.Fatal("An error occured while generating an entity", err)
+	}
+	buf := bytes.NewBuffer(nil)
+	err = SerializeEncryptedPrivate(buf, e, []byte("secret"))
+	if err != nil {
+		t.Fatal("An error occured while serializing the entity", err)
+	}
+	el, err := openpgp.ReadKeyRing(buf)
+	if err != nil {
+		t.Fatal("An error occured while reading the serialized entity", err)
+	}
+	if len(el) != 1 || len(el[0].Subkeys) != 1 {
+		t.Fatalf("Expected 1 entity with 1 subkey; got : %d", len(el))
+	}
+	priv := el[0].Subkeys[0].PrivateKey
+	if !priv.Encrypted {
+		t.Fatal("Expected the private subkey to be encrypted")
+	}
+	if err := priv.Decrypt([]byte("wrong")); err == nil {
+		t.Error("Expected an error while decrypting with a wrong passphrase")
+	}
+	if err := priv.Decrypt([]byte("secret")); err != nil {
+		t.Error("An error occured while decrypting the private key", err)
+	}
+	if err := el[0].PrivateKey.Decrypt([]byte("secret")); err != nil {
+		t.Error("An error occured while decrypting the primary private key", err)
+	}
+}
+
+func Test_WriteKeyPair(t *testing.T) {
+	e, err := newKeyPair("keep", "keep@example.com", 1024)
+	if err != nil {
+		t.Fatal("An error occured while generating an entity", err)
+	}
+	dir, err := ioutil.TempDir("", "keep-keypair")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	pubring := filepath.Join(dir, "pubring.gpg")
+	secring := filepath.Join(dir, "secring.gpg")
+	backup := filepath.Join(dir, "backup.asc")
+	err = WriteKeyPair(e, []byte("secret"), pubring, secring, backup)
+	if err != nil {
+		t.Fatal("An error occured while writing the key pair", err)
+	}
+	for _, path := range []string{pubring, secring, backup} {
+		fi, err := os.Stat(path)
+		if err != nil {
+			t.Error("Expected the file to exist :", path)
+		} else if fi.Mode().Perm() != 0600 {
+			t.Errorf("Unexpected permissions for %s : %v", path, fi.Mode().Perm())
+		}
+	}
+	c := &Config{
+		PubringDir:      pubring,
+		SecringDir:      secring,
+		AccountDir:      dir,
+		RecipientKeyIds: Fingerprint(e.PrimaryKey),
+		SignerKeyID:     Fingerprint(e.PrimaryKey),
+		PromptFunction:  promptFromString("secret"),
+	}
+	if r := c.checkRoundTrip(); r.Status != CheckPass {
+		t.Error("The round trip failed with the new key pair :", r.Detail)
+	}
+	if err := WriteKeyPair(e, []byte("secret"), pubring, secring, ""); err == nil {
+		t.Error("Expected an error when overwriting an existing keyring")
+	}
+}
+
+// Test_WriteKeyPair_Gpg makes sure that gpg can import the secret keyring and decrypt with it,
+// the encryption of the secret keys is not done by openpgp.
+func Test_WriteKeyPair_Gpg(t *testing.T) {
+	gpg, err := exec.LookPath("gpg")
+	if err != nil {
+		t.Skip("gpg is not installed")
+	}
+	e, err := newKeyPair("keep", "keep@example.com", 1024)
+	if err != nil {
+		t.Fatal("An error occured while generating an entity", err)
+	}
+	dir, err := ioutil.TempDir("", "keep-gpg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	home := filepath.Join(dir, "gnupg")
+	err = os.Mkdir(home, 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pubring := filepath.Join(dir, "pubring.gpg")
+	secring := filepath.Join(dir, "secring.gpg")
+	err = WriteKeyPair(e, []byte("secret"), pubring, secring, "")
+	if err != nil {
+		t.Fatal("An error occured while writing the key pair", err)
+	}
+	gpgCmd := func(args ...string) *exec.Cmd {
+		cmd := exec.Command(gpg, append([]string{"--homedir", home, "--batch", "--no-tty"}, args...)...)
+		cmd.Env = append(os.Environ(), "GNUPGHOME="+home)
+		return cmd
+	}
+	defer func() {
+		cmd := exec.Command("gpgconf", "--kill", "gpg-agent")
+		cmd.Env = append(os.Environ(), "GNUPGHOME="+home)
+		cmd.Run()
+	}()
+	out, err := gpgCmd("--pinentry-mode", "loopback", "--passphrase", "secret", "--import", secring).CombinedOutput()
+	if err != nil {
+		t.Fatalf("gpg cannot import the secret keyring : %v\n%s", err, out)
+	}
+
+	c := &Config{
+		PubringDir:      pubring,
+		SecringDir:      secring,
+		RecipientKeyIds: Fingerprint(e.PrimaryKey),
+	}
+	crypt, err := c.encrypt([]byte("decrypted by gpg"), nil, c.RecipientKeyIds)
+	if err != nil {
+		t.Fatal("An error occured while encrypting", err)
+	}
+	// gpg-agent keeps the key once it has been decrypted, the wrong passphrase is tried first
+	cmd := gpgCmd("--pinentry-mode", "loopback", "--passphrase", "wrong", "--decrypt")
+	cmd.Stdin = bytes.NewReader(crypt)
+	if err = cmd.Run(); err == nil {
+		t.Error("Expected gpg to refuse a wrong passphrase")
+	}
+	cmd = gpgCmd("--pinentry-mode", "loopback", "--passphrase", "secret", "--decrypt")
+	cmd.Stdin = bytes.NewReader(crypt)
+	out, err = cmd.Output()
+	if err != nil {
+		t.Fatal("gpg cannot decrypt with the imported secret key", err)
+	}
+	if string(out) != "decrypted by gpg" {
+		t.Errorf("got : %q - expected : %q", out, "decrypted by gpg")
+	}
+}
@@ -0,0 +1,19 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd && !solaris
+// +build !darwin,!dragonfly,!freebsd,!linux,!netbsd,!openbsd,!solaris
+
+package keep
+
+import "errors"
+
+func mlock(b []byte) error {
+	return errors.New("Locking memory is not supported on this platform")
+}
+
+func munlock(b []byte) error {
+	return nil
+}
+
+// DisableCoreDumps is not supported on this platform, it does nothing.
+func DisableCoreDumps() error {
+	return nil
+}
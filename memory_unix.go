@@ -0,0 +1,28 @@
+//go:build darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris
+// +build darwin dragonfly freebsd linux netbsd openbsd solaris
+
+package keep
+
+import (
+	"fmt"
+
+	"golang.org/x/sys/unix"
+)
+
+func mlock(b []byte) error {
+	return unix.Mlock(b)
+}
+
+func munlock(b []byte) error {
+	return unix.Munlock(b)
+}
+
+// DisableCoreDumps prevents the process from writing a core dump, which would contain the
+// decrypted accounts and the passphrases, when it crashes. It is called at startup by the commands.
+func DisableCoreDumps() error {
+	err := unix.Setrlimit(unix.RLIMIT_CORE, &unix.Rlimit{Cur: 0, Max: 0})
+	if err != nil {
+		return fmt.Errorf("An error occured while disabling the core dumps : %s", err)
+	}
+	return disableDumpable()
+}
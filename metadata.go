@@ -0,0 +1,561 @@
+package keep
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// field is a metadata line stored after the notes in the clear text of an account: "Key: value".
+// Storing the metadata inside the encrypted payload keeps it private and
+// readable with gpg -d, and makes it survive a copy or a sync of the files.
+type field struct {
+	key   string
+	value string
+}
+
+// The keys of the metadata fields.
+const (
+	fieldCreated         = "Created"
+	fieldUpdated         = "Updated"
+	fieldPasswordChanged = "Password-Changed"
+	fieldExpires         = "Expires"
+	fieldSharedWith      = "Shared-With"
+	fieldPrevious        = "Previous-Password"
+	fieldAttachment      = "Attachment"
+	fieldSSHKey          = "SSH-Key"
+)
+
+// maintainedFields are the keys of the metadata maintained by keep, they cannot be set with SetField.
+var maintainedFields = []string{fieldCreated, fieldUpdated, fieldPasswordChanged, fieldExpires, fieldSharedWith, fieldPrevious, fieldAttachment, fieldSSHKey}
+
+// fieldExtensionPrefix starts the keys of the metadata unknown to keep, e.g. written by a newer
+// version of keep, they are kept as is.
+const fieldExtensionPrefix = "X-"
+
+// isFieldKey returns true if key is the key of a metadata line: maintained by keep, URL or an extension.
+// The lines of the notes that look like "Key: value", e.g. an URL, have other keys.
+func isFieldKey(key string) bool {
+	if key == fieldURL || (strings.HasPrefix(key, fieldExtensionPrefix) && validFieldKey(key)) {
+		return true
+	}
+	for _, k := range maintainedFields {
+		if key == k {
+			return true
+		}
+	}
+	return false
+}
+
+// splitFields returns the lines of b preceding its trailing block of metadata lines, and the block.
+// The lines preceding the block are the end of notes written on several lines, e.g. by gpg or by
+// older versions of keep, a line whose value cannot be read is part of them. The block is a slice
+// of b, it holds the previous passwords.
+func splitFields(b []byte) (string, []byte) {
+	lines := bytes.Split(b, []byte("\n"))
+	start := len(lines)
+	for start > 0 {
+		line := lines[start-1]
+		if len(bytes.TrimSpace(line)) != 0 && !isFieldLine(line) {
+			break
+		}
+		start--
+	}
+	if start == 0 {
+		return "", b
+	}
+	off := 0
+	for _, line := range lines[:start] {
+		off += len(line) + 1
+	}
+	if off > len(b) {
+		return string(b), nil
+	}
+	return string(b[:off-1]), b[off:]
+}
+
+// isFieldLine returns true if the line is a metadata line, "Key: value" where the key is a metadata
+// key and the value can be read.
+func isFieldLine(line []byte) bool {
+	i := bytes.IndexByte(line, ':')
+	if i < 0 || !isFieldKey(string(line[:i])) {
+		return false
+	}
+	key, value := string(line[:i]), bytes.TrimPrefix(line[i+1:], []byte(" "))
+	if key == fieldPrevious {
+		// Only the time is checked, the password is not copied
+		if j := bytes.IndexByte(value, ' '); j >= 0 {
+			value = value[:j]
+		}
+		_, err := parseTime(string(value))
+		return err == nil
+	}
+	var scratch Account
+	return scratch.setField(key, string(value)) == nil
+}
+
+func validFieldKey(key string) bool {
+	return key != "" && !strings.ContainsAny(key, ": \t\n")
+}
+
+// parseFields sets the metadata of the account from metadata lines.
+// The previous passwords are decoded from b into Secrets, the other values are strings.
+func (a *Account) parseFields(b []byte) error {
+	for _, line := range bytes.Split(b, []byte("\n")) {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		i := bytes.IndexByte(line, ':')
+		if i < 0 {
+			return fmt.Errorf("invalid metadata line in %s: %q", a.Name, line)
+		}
+		// Only the space following the colon is removed, the values are stored as is
+		key, value := strings.TrimSpace(string(line[:i])), bytes.TrimPrefix(line[i+1:], []byte(" "))
+		var err error
+		if key == fieldPrevious {
+			var p PreviousPassword
+			p, err = parsePreviousPassword(value)
+			a.History = append(a.History, p)
+		} else {
+			err = a.setField(key, string(value))
+		}
+		if err != nil {
+			return fmt.Errorf("invalid %s in %s: %v", key, a.Name, err)
+		}
+	}
+	return nil
+}
+
+func (a *Account) setField(key, value string) error {
+	switch key {
+	case fieldCreated, fieldUpdated, fieldPasswordChanged, fieldExpires:
+		t, err := parseTime(value)
+		if err != nil {
+			return err
+		}
+		*a.timeField(key) = t
+	case fieldSharedWith:
+		s, err := parseShare(value)
+		if err != nil {
+			return err
+		}
+		a.Shares = append(a.Shares, s)
+	case fieldAttachment:
+		at, err := parseAttachment(value)
+		if err != nil {
+			return err
+		}
+		a.Attachments = append(a.Attachments, at)
+	case fieldSSHKey:
+		k, err := parseSSHKey(value)
+		if err != nil {
+			return err
+		}
+		a.SSHKey = k
+	default:
+		a.extra = append(a.extra, field{key, value})
+	}
+	return nil
+}
+
+// fields returns the metadata maintained by keep in the order they are written, they are followed
+// by the previous passwords, written by appendFields, and by the other metadata.
+func (a Account) fields() []field {
+	var fields []field
+	for _, key := range []string{fieldCreated, fieldUpdated, fieldPasswordChanged, fieldExpires} {
+		if t := *a.timeField(key); !t.IsZero() {
+			fields = append(fields, field{key, formatTime(t)})
+		}
+	}
+	for _, s := range a.Shares {
+		fields = append(fields, field{fieldSharedWith, s.String()})
+	}
+	for _, at := range a.Attachments {
+		fields = append(fields, field{fieldAttachment, at.String()})
+	}
+	if a.SSHKey != nil {
+		fields = append(fields, field{fieldSSHKey, a.SSHKey.String()})
+	}
+	return fields
+}
+
+// appendFields appends the metadata lines of the account whose key is selected by keep to b,
+// each preceded by a new line. The previous passwords are appended without being copied to a string.
+func (a Account) appendFields(b []byte, keep func(key string) bool) []byte {
+	appendField := func(key, value string) {
+		if keep(key) {
+			b = append(append(append(append(b, '\n'), key...), ": "...), value...)
+		}
+	}
+	for _, f := range a.fields() {
+		appendField(f.key, f.value)
+	}
+	if keep(fieldPrevious) {
+		for _, p := range a.History {
+			b = append(append(b, '\n'), fieldPrevious+": "...)
+			b = p.appendTo(b)
+		}
+	}
+	for _, f := range a.extra {
+		appendField(f.key, f.value)
+	}
+	return b
+}
+
+// fieldsSize returns the maximum length of the metadata lines, appendFields does not
+// grow a buffer of this capacity hence does not leave copies of the passwords behind.
+func (a Account) fieldsSize() int {
+	n := 0
+	for _, f := range append(a.fields(), a.extra...) {
+		n += len(f.key) + len(f.value) + 3
+	}
+	for _, p := range a.History {
+		n += len(fieldPrevious) + 3 + len(time.RFC3339) + 1 + maxQuotedLen(p.Password.Len())
+	}
+	return n
+}
+
+// Field returns the value of the named field of the account, the name is case insensitive.
+// The fields are name, username, password and notes followed by the keys of the metadata, e.g. Expires.
+func (a *Account) Field(name string) (string, error) {
+	switch strings.ToLower(name) {
+	case "name":
+		return a.Name, nil
+	case "username":
+		return a.Username, nil
+	case "password":
+		return string(a.Password.Bytes()), nil
+	case "notes":
+		return a.Notes, nil
+	}
+	if strings.EqualFold(name, fieldPrevious) && len(a.History) > 0 {
+		return string(a.History[0].appendTo(nil)), nil
+	}
+	for _, f := range append(a.fields(), a.extra...) {
+		if strings.EqualFold(f.key, name) {
+			return f.value, nil
+		}
+	}
+	return "", fmt.Errorf("%s has no field %q", a.Name, name)
+}
+
+// SetField sets the value of the named field of the account, it is not written until Save is called.
+// The metadata maintained by keep, e.g. Created or Attachment, cannot be set. URL and the keys
+// starting with X- are stored as is and can be read back with Field.
+func (a *Account) SetField(name, value string) error {
+	switch strings.ToLower(name) {
+	case "username":
+		if strings.ContainsAny(value, "\r\n") {
+			return errUsernameLineBreak
+		}
+		a.Username = value
+		return nil
+	case "password":
+		if strings.ContainsAny(value, "\r\n") {
+			return errPasswordLineBreak
+		}
+		a.Password = NewSecret([]byte(value))
+		return nil
+	case "notes":
+		if strings.Contains(value, "\n") {
+			return errNotesNewLine
+		}
+		a.Notes = value
+		return nil
+	case "name":
+		return fmt.Errorf("The name of an account cannot be set as a field")
+	}
+	if !validFieldKey(name) || strings.ContainsAny(value, "\n") {
+		return fmt.Errorf("Invalid field %q, the key cannot be empty or contain a colon or a space and neither can contain a new line", name)
+	}
+	for _, key := range maintainedFields {
+		if strings.EqualFold(key, name) {
+			return fmt.Errorf("The field %s is maintained by keep", key)
+		}
+	}
+	if !isFieldKey(name) {
+		return fmt.Errorf("Invalid field %q, the key must be %s or start with %s", name, fieldURL, fieldExtensionPrefix)
+	}
+	for i, f := range a.extra {
+		if strings.EqualFold(f.key, name) {
+			a.extra[i].value = value
+			return nil
+		}
+	}
+	a.extra = append(a.extra, field{name, value})
+	return nil
+}
+
+func (a *Account) timeField(key string) *time.Time {
+	switch key {
+	case fieldCreated:
+		return &a.Created
+	case fieldUpdated:
+		return &a.Updated
+	case fieldPasswordChanged:
+		return &a.PasswordChanged
+	}
+	return &a.Expires
+}
+
+// defaultPasswordHistory is the number of previous passwords kept when the profile does not set PasswordHistory.
+const defaultPasswordHistory = 5
+
+func (c *Config) historySize() int {
+	switch {
+	case c.PasswordHistory < 0:
+		return 0
+	case c.PasswordHistory == 0:
+		return defaultPasswordHistory
+	}
+	return c.PasswordHistory
+}
+
+// errNotesNewLine is returned when notes on several lines are written, the lines following the
+// first one could not be told apart from the metadata.
+var errNotesNewLine = errors.New("The notes cannot contain a new line")
+
+// errUsernameLineBreak and errPasswordLineBreak are returned when the username or the password
+// contain a line break, they would be read back as the following lines of the account.
+var (
+	errUsernameLineBreak = errors.New("The username cannot contain a line break")
+	errPasswordLineBreak = errors.New("The password cannot contain a line break")
+)
+
+// savedState describes the account as it was last read or written, it is used to detect the changes.
+type savedState struct {
+	content [sha256.Size]byte
+	// password is the Secret read or written, it is moved to the history when the password of
+	// the account is replaced by another Secret.
+	password *Secret
+	// notes are kept so that the notes read on several lines can be written back unchanged.
+	notes string
+	// username is kept so that a username read with a carriage return can be written back unchanged.
+	username string
+}
+
+func (a *Account) digest() *savedState {
+	clear := a.Bytes()
+	defer WipeBytes(clear)
+	return &savedState{
+		content:  sha256.Sum256(clear),
+		password: a.Password,
+		notes:    a.Notes,
+		username: a.Username,
+	}
+}
+
+// checkNotes returns errNotesNewLine if the notes have been changed and contain a new line.
+func (a *Account) checkNotes() error {
+	if strings.Contains(a.Notes, "\n") && (a.saved == nil || a.saved.notes != a.Notes) {
+		return errNotesNewLine
+	}
+	return nil
+}
+
+// checkLineBreaks returns an error if the username or the password have been changed and contain
+// a line break. A carriage return is kept in the values read from the accounts written with CRLF.
+func (a *Account) checkLineBreaks() error {
+	if strings.ContainsAny(a.Username, "\r\n") && (a.saved == nil || a.saved.username != a.Username) {
+		return errUsernameLineBreak
+	}
+	if bytes.ContainsAny(a.Password.Bytes(), "\r\n") && (a.saved == nil || !a.Password.Equal(a.saved.password)) {
+		return errPasswordLineBreak
+	}
+	return nil
+}
+
+// touch maintains the timestamps of the account before it is written.
+// Accounts that have not changed since they were read, e.g. re-encrypted for
+// new recipients, keep their timestamps.
+func (a *Account) touch(now time.Time) {
+	now = now.UTC().Truncate(time.Second)
+	d := a.digest()
+	switch {
+	case a.saved == nil:
+		if a.Created.IsZero() {
+			a.Created = now
+		}
+		if a.PasswordChanged.IsZero() {
+			a.PasswordChanged = now
+		}
+	case !d.password.Equal(a.saved.password):
+		a.PasswordChanged = now
+		a.History = append([]PreviousPassword{{a.saved.password, now}}, a.History...)
+	case d.content == a.saved.content:
+		return
+	}
+	a.Updated = now
+	if n := a.config.historySize(); len(a.History) > n {
+		for _, p := range a.History[n:] {
+			p.Password.Wipe()
+		}
+		a.History = a.History[:n]
+	}
+}
+
+// PreviousPassword is a password that has been replaced.
+type PreviousPassword struct {
+	Password *Secret
+	// Replaced is when the password stopped being the password of the account.
+	Replaced time.Time
+}
+
+// appendTo appends the representation of a PreviousPassword stored in the metadata to b: "<time> <quoted password>".
+// The password is quoted so that an empty password, its spaces and its control characters survive a round trip.
+func (p PreviousPassword) appendTo(b []byte) []byte {
+	b = append(append(b, formatTime(p.Replaced)...), ' ')
+	return appendQuoted(b, p.Password.Bytes())
+}
+
+// parsePreviousPassword reads a PreviousPassword, the passwords written unquoted by older versions of keep are read as is.
+func parsePreviousPassword(value []byte) (PreviousPassword, error) {
+	i := bytes.IndexByte(value, ' ')
+	if i < 0 {
+		i = len(value)
+	}
+	replaced, err := parseTime(string(value[:i]))
+	if err != nil {
+		return PreviousPassword{}, fmt.Errorf("expected a time and a password: %v", err)
+	}
+	var password []byte
+	if i < len(value) {
+		var ok bool
+		password, ok = unquote(value[i+1:])
+		if !ok {
+			password = append([]byte(nil), value[i+1:]...)
+		}
+	}
+	return PreviousPassword{Password: NewSecret(password), Replaced: replaced}, nil
+}
+
+const lowerhex = "0123456789abcdef"
+
+// maxQuotedLen returns the maximum length of n bytes quoted by appendQuoted.
+func maxQuotedLen(n int) int {
+	return 4*n + 2
+}
+
+// appendQuoted appends b quoted with the escapes of Go to dst, as strconv.Quote does for a string.
+func appendQuoted(dst, b []byte) []byte {
+	dst = append(dst, '"')
+	for len(b) > 0 {
+		r, size := utf8.DecodeRune(b)
+		if c := strings.IndexByte("\a\b\f\n\r\t\v", b[0]); c >= 0 {
+			dst = append(dst, '\\', "abfnrtv"[c])
+			b = b[1:]
+			continue
+		}
+		switch {
+		case r == utf8.RuneError && size == 1, r < utf8.RuneSelf && !strconv.IsPrint(r):
+			dst = append(dst, '\\', 'x', lowerhex[b[0]>>4], lowerhex[b[0]&0xf])
+		case r == '"' || r == '\\':
+			dst = append(dst, '\\', byte(r))
+		case strconv.IsPrint(r):
+			dst = append(dst, b[:size]...)
+		case r < 0x10000:
+			dst = appendHex(append(dst, '\\', 'u'), uint32(r), 4)
+		default:
+			dst = appendHex(append(dst, '\\', 'U'), uint32(r), 8)
+		}
+		b = b[size:]
+	}
+	return append(dst, '"')
+}
+
+func appendHex(dst []byte, v uint32, digits int) []byte {
+	for shift := uint(4 * (digits - 1)); ; shift -= 4 {
+		dst = append(dst, lowerhex[v>>shift&0xf])
+		if shift == 0 {
+			return dst
+		}
+	}
+}
+
+// unquote returns the content of a string quoted by appendQuoted or strconv.Quote, ok is false if q is not quoted.
+// Unlike strconv.Unquote it does not copy the content to a string.
+func unquote(q []byte) (b []byte, ok bool) {
+	if len(q) < 2 || q[0] != '"' || q[len(q)-1] != '"' {
+		return nil, false
+	}
+	q = q[1 : len(q)-1]
+	// The content is never longer than its quoted form, b is not grown
+	b = make([]byte, 0, len(q))
+	fail := func() ([]byte, bool) {
+		WipeBytes(b)
+		return nil, false
+	}
+	for len(q) > 0 {
+		switch {
+		case q[0] == '"':
+			return fail()
+		case q[0] != '\\':
+			b = append(b, q[0])
+			q = q[1:]
+			continue
+		case len(q) < 2:
+			return fail()
+		}
+		if c := strings.IndexByte(`abfnrtv\"`, q[1]); c >= 0 {
+			b = append(b, "\a\b\f\n\r\t\v\\\""[c])
+			q = q[2:]
+			continue
+		}
+		var digits int
+		switch q[1] {
+		case 'x':
+			digits = 2
+		case 'u':
+			digits = 4
+		case 'U':
+			digits = 8
+		}
+		if digits == 0 || len(q) < 2+digits {
+			return fail()
+		}
+		var v uint32
+		for _, c := range q[2 : 2+digits] {
+			d, ok := unhex(c)
+			if !ok {
+				return fail()
+			}
+			v = v<<4 | d
+		}
+		switch {
+		case q[1] == 'x':
+			b = append(b, byte(v))
+		case utf8.ValidRune(rune(v)):
+			var r [utf8.UTFMax]byte
+			b = append(b, r[:utf8.EncodeRune(r[:], rune(v))]...)
+		default:
+			return fail()
+		}
+		q = q[2+digits:]
+	}
+	return b, true
+}
+
+func unhex(c byte) (uint32, bool) {
+	switch {
+	case '0' <= c && c <= '9':
+		return uint32(c - '0'), true
+	case 'a' <= c && c <= 'f':
+		return uint32(c - 'a' + 10), true
+	case 'A' <= c && c <= 'F':
+		return uint32(c - 'A' + 10), true
+	}
+	return 0, false
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+func parseTime(s string) (time.Time, error) {
+	return time.Parse(time.RFC3339, s)
+}
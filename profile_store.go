@@ -2,10 +2,12 @@ package keep
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"strings"
 )
 
 const (
@@ -16,12 +18,23 @@ const (
 
 // Profile represents the information that can be persited to disk of a Config.
 type Profile struct {
-	Name            string
+	Name string
+	// Type is empty for profiles encrypted to GPG keys or "symmetric" for
+	// profiles encrypted with a passphrase.
+	Type            string
 	SecringDir      string
 	PubringDir      string
 	AccountDir      string
 	RecipientKeyIds string
 	SignerKeyID     string
+	// KeyPolicy is either "refuse" (default) or "warn" and describes what to do with expired or revoked keys.
+	// Any other value is rejected when the profiles are loaded.
+	KeyPolicy string
+	// MinPasswordScore is the minimum strength score, from 0 to 4, of the passwords of new accounts.
+	MinPasswordScore int
+	// PasswordHistory is the number of previous passwords kept in each account,
+	// 5 if it is not set and none if it is negative.
+	PasswordHistory int
 }
 
 // DefaultProfile returns the a Profile with customized information for a user.
@@ -50,37 +63,51 @@ func GetConfigPaths() (string, string) {
 	return filepath.Join(filepath.Dir(accountDir), "keep.conf"), accountDir
 }
 
-func initProfileStore() (ProfileStore, error) {
-	configFile, accountDir := GetConfigPaths()
+// ErrNoProfileStore is returned by LoadProfileStore when the configuration file does not exist yet.
+var ErrNoProfileStore = errors.New("No configuration file found, run `keep init` first")
+
+// InitProfileStore creates the configuration file with a single profile.
+// The account directory of the profile is created if needed.
+func InitProfileStore(profile Profile) (ProfileStore, error) {
+	configFile, _ := GetConfigPaths()
 
 	if _, err := os.Stat(configFile); !os.IsNotExist(err) {
 		return nil, fmt.Errorf("Do nothing because config file already exsit here : %s", configFile)
 	}
 
-	err := os.MkdirAll(accountDir, 0700)
+	err := os.MkdirAll(filepath.Dir(configFile), 0700)
 	if err != nil {
 		return nil, err
 	}
-	profile := DefaultProfile()
-	store := make(ProfileStore, 0)
-	store = append(store, *profile)
-	b, err := json.MarshalIndent(store, "", "\t")
+	err = os.MkdirAll(profile.AccountDir, 0700)
 	if err != nil {
 		return nil, err
 	}
-	err = ioutil.WriteFile(configFile, b, 0700)
+	store := make(ProfileStore, 0)
+	store = append(store, profile)
+	err = SaveProfileStore(store)
 	if err != nil {
 		return nil, err
 	}
 	return store, nil
 }
 
+// SaveProfileStore writes the ProfileStore to the configuration file.
+func SaveProfileStore(store ProfileStore) error {
+	configFile, _ := GetConfigPaths()
+	b, err := json.MarshalIndent(store, "", "\t")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(configFile, b, 0600)
+}
+
 // LoadProfileStore returns the ProfileStore with the information found in the configuration file.
 func LoadProfileStore() (ProfileStore, error) {
 	configFile, _ := GetConfigPaths()
 
 	if _, err := os.Stat(configFile); os.IsNotExist(err) {
-		return initProfileStore()
+		return nil, ErrNoProfileStore
 	}
 	store := make(ProfileStore, 0)
 	b, err := ioutil.ReadFile(configFile)
@@ -91,5 +118,50 @@ func LoadProfileStore() (ProfileStore, error) {
 	if err != nil {
 		return nil, err
 	}
+	if len(store) == 0 {
+		return nil, fmt.Errorf("No profile found in %s", configFile)
+	}
+	for _, p := range store {
+		err = validKeyPolicy(p.KeyPolicy)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid profile %s in %s : %s", p.Name, configFile, err)
+		}
+	}
 	return store, nil
 }
+
+// fingerprints returns the space separated list of fingerprints of the keys identified by ids.
+func fingerprints(keyringPath, ids string) (string, error) {
+	if ids == "" {
+		return "", nil
+	}
+	el, err := getKeyRing(keyringPath)
+	if err != nil {
+		return "", err
+	}
+	el, err = filterEntityList(el, ids)
+	if err != nil {
+		return "", err
+	}
+	fprs := make([]string, len(el))
+	for i, e := range el {
+		fprs[i] = Fingerprint(e.PrimaryKey)
+	}
+	return strings.Join(fprs, " "), nil
+}
+
+// Upgrade returns a copy of the Profile where RecipientKeyIds and SignerKeyID
+// are replaced by the full fingerprints of the keys they identify.
+func (p Profile) Upgrade() (Profile, error) {
+	recipients, err := fingerprints(p.PubringDir, p.RecipientKeyIds)
+	if err != nil {
+		return p, fmt.Errorf("cannot resolve the recipients: %v", err)
+	}
+	signer, err := fingerprints(p.SecringDir, p.SignerKeyID)
+	if err != nil {
+		return p, fmt.Errorf("cannot resolve the signer: %v", err)
+	}
+	p.RecipientKeyIds = recipients
+	p.SignerKeyID = signer
+	return p, nil
+}
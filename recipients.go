@@ -0,0 +1,205 @@
+package keep
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// RecipientsFile is the name of the file that overrides the RecipientKeyIds of
+// the profile for the accounts of a folder and of its sub folders.
+// It contains a list of key ids separated by spaces or new lines, lines starting with # are ignored.
+const RecipientsFile = ".keep-recipients"
+
+// checkAccountName returns an error if the name would put the account outside of the AccountDir
+// or in a hidden file.
+func (c *Config) checkAccountName(name string) error {
+	if name == "" {
+		return fmt.Errorf("The account name cannot be empty")
+	}
+	clean := path.Clean(filepath.ToSlash(name))
+	if path.IsAbs(clean) || clean == ".." || strings.HasPrefix(clean, "../") {
+		return fmt.Errorf("The account name %q is outside of the account directory", name)
+	}
+	for _, part := range strings.Split(clean, "/") {
+		if isHidden(part) {
+			return fmt.Errorf("The account name %q contains a hidden file or directory", name)
+		}
+	}
+	return nil
+}
+
+func readRecipientsFile(fpath string) (string, error) {
+	b, err := ioutil.ReadFile(fpath)
+	if err != nil {
+		return "", err
+	}
+	var ids []string
+	for _, line := range strings.Split(string(b), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		ids = append(ids, strings.Fields(line)...)
+	}
+	return strings.Join(ids, " "), nil
+}
+
+// RecipientsFor returns the space separated list of key ids an account should be encrypted to.
+// The nearest .keep-recipients file, starting from the folder of the account up to the
+// AccountDir, is used and the RecipientKeyIds of the Config otherwise.
+func (c *Config) RecipientsFor(name string) (string, error) {
+	return c.recipientsFor(name, nil)
+}
+
+// recipientsFor is RecipientsFor where the recipients of pending, indexed by folder, take the place
+// of the .keep-recipients files of these folders. They are used before the files are written.
+func (c *Config) recipientsFor(name string, pending map[string]string) (string, error) {
+	if c.IsSymmetric() {
+		return "", nil
+	}
+	dir := path.Dir(path.Clean(filepath.ToSlash(name)))
+	for {
+		if ids, ok := pending[dir]; ok {
+			return ids, nil
+		}
+		ids, err := readRecipientsFile(filepath.Join(c.AccountDir, filepath.FromSlash(dir), RecipientsFile))
+		if err == nil {
+			if ids == "" {
+				return "", fmt.Errorf("%s in %s is empty", RecipientsFile, dir)
+			}
+			return ids, nil
+		}
+		if !os.IsNotExist(err) {
+			return "", err
+		}
+		if dir == "." || dir == "/" {
+			break
+		}
+		dir = path.Dir(dir)
+	}
+	return c.RecipientKeyIds, nil
+}
+
+// SetFolderRecipients writes the .keep-recipients file of a folder of the AccountDir and
+// re-encrypts every account, and its attachments, under this folder. It returns the names of the re-encrypted accounts.
+// The accounts are re-encrypted to temporary files first, the .keep-recipients file is only written,
+// and the temporary files renamed, once every account has been re-encrypted.
+func (c *Config) SetFolderRecipients(folder, ids string) ([]string, error) {
+	if c.IsSymmetric() {
+		return nil, fmt.Errorf("Symmetric profiles do not have recipients")
+	}
+	folder = path.Clean(filepath.ToSlash(folder))
+	if folder != "." {
+		err := c.checkAccountName(folder)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Make sure that every key exists and is usable before touching anything
+	_, err := c.validEntityList(ids)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := c.ListAccountFiles("")
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	pending := map[string]string{folder: ids}
+	// staged maps the temporary files to the files they replace
+	staged := make(map[string]string)
+	defer func() {
+		for tmp := range staged {
+			os.Remove(tmp)
+		}
+	}()
+	var reencrypted []string
+	for _, f := range files {
+		if folder != "." && !strings.HasPrefix(f.Name(), folder+"/") {
+			continue
+		}
+		err = c.stageReencryption(f.Name(), pending, staged)
+		if err != nil {
+			return nil, fmt.Errorf("cannot re-encrypt %s: %v", f.Name(), err)
+		}
+		reencrypted = append(reencrypted, f.Name())
+	}
+
+	dir := filepath.Join(c.AccountDir, filepath.FromSlash(folder))
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, RecipientsFile), []byte(strings.Join(strings.Fields(ids), "\n")+"\n"), 0600)
+	if err != nil {
+		return nil, err
+	}
+	for tmp, fpath := range staged {
+		err = os.Rename(tmp, fpath)
+		if err != nil {
+			return nil, err
+		}
+		delete(staged, tmp)
+	}
+	return reencrypted, nil
+}
+
+// stageReencryption encrypts the account and its attachments to the recipients it has once the
+// pending .keep-recipients files are written. The encrypted files are written next to the
+// files they replace, under hidden names, and recorded in staged.
+func (c *Config) stageReencryption(name string, pending, staged map[string]string) error {
+	account, err := NewAccountFromFile(c, name)
+	if err != nil {
+		return err
+	}
+	recipients, err := c.recipientsFor(name, pending)
+	if err != nil {
+		return err
+	}
+	content, private, err := account.encryptFiles(recipients)
+	if err != nil {
+		return err
+	}
+	err = stageFile(account.Path(), content, staged)
+	if err != nil {
+		return err
+	}
+	if private != nil {
+		err = stageFile(account.privatePath(), private, staged)
+		if err != nil {
+			return err
+		}
+	}
+	recipients = account.withShares(recipients)
+	for _, at := range account.Attachments {
+		clear, err := account.ReadAttachment(at.Name)
+		if err != nil {
+			return err
+		}
+		content, err = account.encryptAttachment(at.Name, clear, recipients)
+		WipeBytes(clear)
+		if err != nil {
+			return err
+		}
+		err = stageFile(account.AttachmentPath(at.Name), content, staged)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// stageFile writes the content meant for fpath to a hidden temporary file next to it.
+func stageFile(fpath string, content []byte, staged map[string]string) error {
+	tmp := filepath.Join(filepath.Dir(fpath), "."+filepath.Base(fpath)+".keep-tmp")
+	err := ioutil.WriteFile(tmp, content, 0600)
+	if err != nil {
+		return err
+	}
+	staged[tmp] = fpath
+	return nil
+}
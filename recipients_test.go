@@ -0,0 +1,133 @@
+package keep
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func Test_Config_RecipientsFor(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keep-recipients")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	err = os.MkdirAll(filepath.Join(dir, "finance", "banks"), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "finance", RecipientsFile), []byte("# finance team\nAAAAAAAA\nBBBBBBBB CCCCCCCC\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &Config{AccountDir: dir, RecipientKeyIds: "DDDDDDDD"}
+	cases := map[string]string{
+		"finance/banks/acme": "AAAAAAAA BBBBBBBB CCCCCCCC",
+		"finance/payroll":    "AAAAAAAA BBBBBBBB CCCCCCCC",
+		"infra/db":           "DDDDDDDD",
+		"example.com":        "DDDDDDDD",
+	}
+	for name, expected := range cases {
+		got, err := c.RecipientsFor(name)
+		if err != nil {
+			t.Errorf("%s: an error occured while looking for the recipients : %v", name, err)
+		}
+		if got != expected {
+			t.Errorf("%s: got %q -- expected %q", name, got, expected)
+		}
+	}
+}
+
+func Test_Config_checkAccountName(t *testing.T) {
+	c := &Config{AccountDir: "/foo"}
+	for _, name := range []string{"bar", "finance/bar", "a/b/c"} {
+		if err := c.checkAccountName(name); err != nil {
+			t.Errorf("%s: unexpected error : %v", name, err)
+		}
+	}
+	for _, name := range []string{"", "../bar", "/etc/passwd", ".keep-recipients", "finance/.git/config"} {
+		if err := c.checkAccountName(name); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func Test_Config_SetFolderRecipients(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-recipients")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = dir
+
+	for _, name := range []string{"finance/bank", "infra/db"} {
+		a := &Account{config: c, Name: name, Username: "u", Password: NewSecret([]byte("p")), Notes: "n"}
+		if err := a.Save(); err != nil {
+			t.Fatal("An error occured while saving the account", err)
+		}
+	}
+	files, err := c.ListAccountFiles("")
+	if err != nil || len(files) != 2 {
+		t.Fatalf("Expected 2 accounts; got : %d (%v)", len(files), err)
+	}
+
+	if _, err := c.SetFolderRecipients("finance", "DEADBEEF"); err == nil {
+		t.Error("Expected an error for an unknown key")
+	}
+	reencrypted, err := c.SetFolderRecipients("finance", os.Getenv("GPGKEY"))
+	if err != nil {
+		t.Fatal("An error occured while setting the recipients", err)
+	}
+	if len(reencrypted) != 1 || reencrypted[0] != "finance/bank" {
+		t.Error("Expected only finance/bank to be re-encrypted; got :", reencrypted)
+	}
+	a, err := NewAccountFromFile(c, "finance/bank")
+	if err != nil {
+		t.Fatal("An error occured while reading the re-encrypted account", err)
+	}
+	if a.Name != "finance/bank" || a.Username != "u" {
+		t.Errorf("Unexpected account after re-encryption : %s %s", a.Name, a.Username)
+	}
+}
+
+func Test_Config_SetFolderRecipients_Failure(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-recipients")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = dir
+
+	a := &Account{config: c, Name: "finance/bank", Username: "u", Password: NewSecret([]byte("p")), Notes: "n"}
+	if err := a.Save(); err != nil {
+		t.Fatal("An error occured while saving the account", err)
+	}
+	before, err := ioutil.ReadFile(a.Path())
+	if err != nil {
+		t.Fatal(err)
+	}
+	// An account that cannot be decrypted makes the re-encryption fail
+	err = ioutil.WriteFile(filepath.Join(dir, "finance", "corrupt"), []byte("not encrypted"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.SetFolderRecipients("finance", os.Getenv("GPGKEY")); err == nil {
+		t.Fatal("Expected an error for an account that cannot be decrypted")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "finance", RecipientsFile)); !os.IsNotExist(err) {
+		t.Errorf("Expected no %s to be written; got : %v", RecipientsFile, err)
+	}
+	after, err := ioutil.ReadFile(a.Path())
+	if err != nil || !bytes.Equal(before, after) {
+		t.Errorf("Expected the account to be left untouched; got : %v", err)
+	}
+	names, _ := filepath.Glob(filepath.Join(dir, "finance", ".*"))
+	if len(names) != 0 {
+		t.Error("Expected the temporary files to be removed; got :", names)
+	}
+}
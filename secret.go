@@ -0,0 +1,130 @@
+package keep
+
+import (
+	"crypto/subtle"
+	"os"
+	"sync"
+	"unsafe"
+)
+
+// Secret is a buffer holding a password or a passphrase. It is locked in memory, where the
+// platform allows it, so that it is not written to the swap, and it is zeroed by Wipe once
+// it is not needed anymore.
+//
+// The strings of Go cannot be zeroed, a Secret only protects the copies made in its buffer.
+type Secret struct {
+	b      []byte
+	locked bool
+}
+
+// NewSecret returns a Secret holding b. The Secret takes the ownership of b, it is zeroed by Wipe.
+func NewSecret(b []byte) *Secret {
+	s := &Secret{b: b}
+	if len(b) > 0 {
+		s.locked = lockPages(b) == nil
+	}
+	return s
+}
+
+// Bytes returns the content of the Secret, it must not be used after Wipe.
+func (s *Secret) Bytes() []byte {
+	if s == nil {
+		return nil
+	}
+	return s.b
+}
+
+// Len returns the length of the Secret, 0 once it has been wiped.
+func (s *Secret) Len() int {
+	if s == nil {
+		return 0
+	}
+	return len(s.b)
+}
+
+// Copy returns a new Secret with a copy of the content, for callers that wipe it after use.
+func (s *Secret) Copy() *Secret {
+	b := make([]byte, s.Len())
+	copy(b, s.Bytes())
+	return NewSecret(b)
+}
+
+// Equal returns true if both Secrets hold the same content, a nil Secret is empty.
+// The contents are compared in constant time.
+func (s *Secret) Equal(o *Secret) bool {
+	return subtle.ConstantTimeCompare(s.Bytes(), o.Bytes()) == 1
+}
+
+// Wipe zeroes the content of the Secret and unlocks its memory. It is safe to call Wipe on a nil
+// Secret or more than once.
+func (s *Secret) Wipe() {
+	if s == nil || s.b == nil {
+		return
+	}
+	WipeBytes(s.b)
+	if s.locked {
+		unlockPages(s.b)
+		s.locked = false
+	}
+	s.b = nil
+}
+
+// WipeBytes zeroes b, e.g. a passphrase returned by a PromptFunction or a decrypted account.
+func WipeBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
+
+// lockedPages counts the Secrets locking each page of memory. The memory is locked by page, the
+// buffers of several Secrets can share a page which is only unlocked once all of them are wiped.
+var lockedPages = struct {
+	sync.Mutex
+	count map[uintptr]int
+}{count: make(map[uintptr]int)}
+
+// pages returns the address of the first page of b and the address following its last page.
+func pages(b []byte) (start, end uintptr) {
+	size := uintptr(os.Getpagesize())
+	addr := uintptr(unsafe.Pointer(&b[0]))
+	return addr &^ (size - 1), (addr + uintptr(len(b)) + size - 1) &^ (size - 1)
+}
+
+// lockPages locks the pages of b in memory and counts b as one more user of each of them.
+func lockPages(b []byte) error {
+	lockedPages.Lock()
+	defer lockedPages.Unlock()
+	if err := mlock(b); err != nil {
+		return err
+	}
+	start, end := pages(b)
+	for p := start; p < end; p += uintptr(os.Getpagesize()) {
+		lockedPages.count[p]++
+	}
+	return nil
+}
+
+// unlockPages unlocks the pages of b which are not used by another locked buffer anymore.
+func unlockPages(b []byte) {
+	lockedPages.Lock()
+	defer lockedPages.Unlock()
+	size := uintptr(os.Getpagesize())
+	addr := uintptr(unsafe.Pointer(&b[0]))
+	start, end := pages(b)
+	for p := start; p < end; p += size {
+		lockedPages.count[p]--
+		if lockedPages.count[p] > 0 {
+			continue
+		}
+		delete(lockedPages.count, p)
+		// munlock unlocks the whole page holding the part of b it is given
+		from, to := 0, len(b)
+		if p > addr {
+			from = int(p - addr)
+		}
+		if p+size < addr+uintptr(len(b)) {
+			to = int(p + size - addr)
+		}
+		munlock(b[from:to])
+	}
+}
@@ -0,0 +1,97 @@
+package keep
+
+import (
+	"bytes"
+	"testing"
+
+	"golang.org/x/crypto/openpgp"
+)
+
+func Test_Secret_Wipe(t *testing.T) {
+	b := []byte("correct horse battery staple")
+	s := NewSecret(b)
+	if !bytes.Equal(s.Bytes(), []byte("correct horse battery staple")) {
+		t.Fatalf("got : %q - expected : the content of the secret", s.Bytes())
+	}
+	c := s.Copy()
+	s.Wipe()
+	if !bytes.Equal(b, make([]byte, len(b))) {
+		t.Errorf("got : %q - expected : the buffer to be zeroed", b)
+	}
+	if s.Len() != 0 || s.Bytes() != nil {
+		t.Errorf("got : %d bytes - expected : an empty secret", s.Len())
+	}
+	if string(c.Bytes()) != "correct horse battery staple" {
+		t.Errorf("got : %q - expected : the copy to be kept", c.Bytes())
+	}
+	// Wiping twice or a nil Secret does nothing
+	s.Wipe()
+	var n *Secret
+	n.Wipe()
+	c.Wipe()
+}
+
+func Test_Secret_Wipe_SharedPage(t *testing.T) {
+	// Both Secrets are in the same page of memory
+	b := make([]byte, 64)
+	first, second := NewSecret(b[:32]), NewSecret(b[32:])
+	if !first.locked || !second.locked {
+		t.Skip("Locking memory is not allowed here")
+	}
+	start, _ := pages(second.Bytes())
+	first.Wipe()
+	lockedPages.Lock()
+	count := lockedPages.count[start]
+	lockedPages.Unlock()
+	if count != 1 {
+		t.Errorf("got : %d - expected : the page to stay locked for the second secret", count)
+	}
+	second.Wipe()
+	lockedPages.Lock()
+	_, ok := lockedPages.count[start]
+	lockedPages.Unlock()
+	if ok {
+		t.Error("Expected the page to be unlocked once both secrets are wiped")
+	}
+}
+
+func Test_Secret_Equal(t *testing.T) {
+	a := NewSecret([]byte("same"))
+	defer a.Wipe()
+	for _, tc := range []struct {
+		other    *Secret
+		expected bool
+	}{
+		{NewSecret([]byte("same")), true},
+		{NewSecret([]byte("other")), false},
+		{NewSecret([]byte("sam")), false},
+		{nil, false},
+	} {
+		if got := a.Equal(tc.other); got != tc.expected {
+			t.Errorf("%q == %q got : %v - expected : %v", a.Bytes(), tc.other.Bytes(), got, tc.expected)
+		}
+		tc.other.Wipe()
+	}
+	var n *Secret
+	if !n.Equal(NewSecret(nil)) {
+		t.Error("Expected a nil Secret to equal an empty one")
+	}
+}
+
+func Test_EntitySigner_WipesPassphrase(t *testing.T) {
+	c := NewConfig(nil)
+	var passphrase []byte
+	prompt := c.PromptFunction
+	c.PromptFunction = func(keys []openpgp.Key, symmetric bool) ([]byte, error) {
+		var err error
+		passphrase, err = prompt(keys, symmetric)
+		return passphrase, err
+	}
+	_, err := c.EntitySigner()
+	if err != nil {
+		t.Fatal("An error occured while decrypting the signer", err)
+	}
+	if len(passphrase) == 0 || !bytes.Equal(passphrase, make([]byte, len(passphrase))) {
+		t.Errorf("got : %q - expected : the passphrase to be wiped", passphrase)
+	}
+}
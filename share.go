@@ -0,0 +1,270 @@
+package keep
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/openpgp"
+)
+
+// PrivateDir is the hidden directory of the AccountDir where the private metadata of the accounts
+// shared in place is stored: their previous passwords, SSH key and shares. The file of such an
+// account is encrypted to the recipients it is shared with too, the private metadata of
+// the account f is stored in .private/f and only encrypted to the recipients of the profile.
+const PrivateDir = ".private"
+
+// isPrivateField returns true for the metadata that is never given to the recipients of a share.
+func isPrivateField(key string) bool {
+	switch key {
+	case fieldPrevious, fieldSSHKey, fieldSharedWith:
+		return true
+	}
+	return false
+}
+
+// privatePath returns the path of the encrypted file of the private metadata of the account.
+func (a *Account) privatePath() string {
+	return filepath.Join(a.config.AccountDir, PrivateDir, filepath.FromSlash(a.Name))
+}
+
+// privateBytes returns the clear text of the private metadata of the account, a field per line.
+func (a Account) privateBytes() []byte {
+	b := a.appendFields(make([]byte, 0, a.fieldsSize()), isPrivateField)
+	return bytes.TrimPrefix(b, []byte("\n"))
+}
+
+// sharedInPlace returns true if the recipients of a share have been added to the account.
+func (a *Account) sharedInPlace() bool {
+	for _, s := range a.Shares {
+		if s.Out == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// writePrivateFields writes the encrypted private metadata of the account, or removes the file if private is nil.
+func (a *Account) writePrivateFields(private []byte) error {
+	fpath := a.privatePath()
+	if private == nil {
+		err := os.Remove(fpath)
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+	err := os.MkdirAll(filepath.Dir(fpath), 0700)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(fpath, private, 0600)
+}
+
+// readPrivateFields adds the private metadata of an account shared in place to the account.
+func (a *Account) readPrivateFields() error {
+	md, err := a.config.decodeAccountFile(a.privatePath())
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("cannot read the private metadata of %s: %v", a.Name, err)
+	}
+	clear, err := ioutil.ReadAll(md.UnverifiedBody)
+	defer WipeBytes(clear)
+	if err != nil {
+		return err
+	}
+	if md.IsSigned && md.SignatureError != nil {
+		return fmt.Errorf("A signature error has been detected in the private metadata of %s : %v", a.Name, md.SignatureError)
+	}
+	err = a.parseFields(clear)
+	if err != nil {
+		return err
+	}
+	a.saved = a.digest()
+	return nil
+}
+
+// Share records that an account has been encrypted for recipients that are not part of its profile.
+type Share struct {
+	// Recipients is the space separated list of the fingerprints of the keys.
+	Recipients string
+	At         time.Time
+	// Out is the path of the copy encrypted only for the recipients or empty
+	// if the recipients have been added to the account itself.
+	Out string
+}
+
+// String returns the representation of a Share stored in the metadata: "<time> <fpr>[,<fpr>...] [<out>]".
+func (s Share) String() string {
+	str := formatTime(s.At) + " " + strings.Join(strings.Fields(s.Recipients), ",")
+	if s.Out != "" {
+		str += " " + s.Out
+	}
+	return str
+}
+
+func parseShare(value string) (Share, error) {
+	parts := strings.SplitN(value, " ", 3)
+	if len(parts) < 2 {
+		return Share{}, fmt.Errorf("expected a time and a list of fingerprints, got %q", value)
+	}
+	at, err := parseTime(parts[0])
+	if err != nil {
+		return Share{}, err
+	}
+	s := Share{
+		Recipients: strings.Join(strings.Split(parts[1], ","), " "),
+		At:         at,
+	}
+	if len(parts) == 3 {
+		s.Out = parts[2]
+	}
+	return s, nil
+}
+
+// ImportPublicKeys reads an armored public keyring and adds the keys that are not
+// already in the pubring of the Config. It returns all the keys read.
+func (c *Config) ImportPublicKeys(r io.Reader) (openpgp.EntityList, error) {
+	el, err := openpgp.ReadArmoredKeyRing(r)
+	if err != nil {
+		return nil, err
+	}
+	pubring, err := getKeyRing(c.PubringDir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	known := make(map[uint64]bool)
+	for _, e := range pubring {
+		known[e.PrimaryKey.KeyId] = true
+	}
+
+	buf := bytes.NewBuffer(nil)
+	for _, e := range el {
+		if known[e.PrimaryKey.KeyId] {
+			continue
+		}
+		err = e.Serialize(buf)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if buf.Len() == 0 {
+		return el, nil
+	}
+	f, err := os.OpenFile(c.PubringDir, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	_, err = buf.WriteTo(f)
+	if err != nil {
+		return nil, err
+	}
+	return el, nil
+}
+
+// Share encrypts the account, without its private metadata, for the recipients identified by ids and records it in the account metadata.
+// If out is empty the recipients are added to the account and to its attachments, which are saved in place, until Unshare is called.
+// Otherwise a copy encrypted only for the recipients is written to out.
+func (a *Account) Share(ids, out string) error {
+	if a.config.IsSymmetric() {
+		return fmt.Errorf("Accounts of symmetric profiles cannot be shared")
+	}
+	el, err := a.config.validEntityList(ids)
+	if err != nil {
+		return err
+	}
+	fprs := make([]string, len(el))
+	for i, e := range el {
+		fprs[i] = Fingerprint(e.PrimaryKey)
+	}
+	s := Share{Recipients: strings.Join(fprs, " "), At: time.Now(), Out: out}
+	a.Shares = append(a.Shares, s)
+
+	if out != "" {
+		// The copy is given away, it does not carry the private metadata
+		clear := a.bytes(false)
+		content, err := a.config.encrypt(clear, nil, s.Recipients)
+		WipeBytes(clear)
+		if err != nil {
+			return err
+		}
+		if dir := filepath.Dir(out); dir != "" {
+			err = os.MkdirAll(dir, 0700)
+			if err != nil {
+				return err
+			}
+		}
+		err = ioutil.WriteFile(out, content, 0600)
+		if err != nil {
+			return err
+		}
+	}
+	// Save the account to record the share
+	err = a.Save()
+	if err != nil {
+		return err
+	}
+	if out == "" {
+		return a.reencryptAttachments()
+	}
+	return nil
+}
+
+// Unshare removes the shares made with the key identified by id, or every share if id is empty,
+// and saves the account so that it is no longer encrypted to their recipients.
+// It returns the number of shares removed.
+func (a *Account) Unshare(id string) (int, error) {
+	var fpr string
+	if id != "" {
+		el, err := a.config.entityList(id)
+		if err != nil {
+			return 0, err
+		}
+		fpr = Fingerprint(el[0].PrimaryKey)
+	}
+	var kept []Share
+	for _, s := range a.Shares {
+		if fpr != "" && !strings.Contains(" "+s.Recipients+" ", " "+fpr+" ") {
+			kept = append(kept, s)
+		}
+	}
+	removed := len(a.Shares) - len(kept)
+	if removed == 0 {
+		return 0, nil
+	}
+	a.Shares = kept
+	err := a.Save()
+	if err != nil {
+		return 0, err
+	}
+	return removed, a.reencryptAttachments()
+}
+
+// SharedAccount is an account that has been shared outside of its profile.
+type SharedAccount struct {
+	Name   string
+	Shares []Share
+}
+
+// ListShares decrypts every account and returns the ones that have been shared.
+func (c *Config) ListShares() ([]SharedAccount, error) {
+	accounts, err := c.accounts()
+	if err != nil {
+		return nil, err
+	}
+	var shared []SharedAccount
+	for _, a := range accounts {
+		if len(a.Shares) > 0 {
+			shared = append(shared, SharedAccount{a.Name, a.Shares})
+		}
+	}
+	return shared, nil
+}
@@ -0,0 +1,229 @@
+package keep
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+)
+
+func Test_Account_Metadata(t *testing.T) {
+	at := time.Date(2017, 6, 1, 10, 0, 0, 0, time.UTC)
+	s := "p\nu\nn\nShared-With: 2017-06-01T10:00:00Z AAAA,BBBB out.asc\nX-Unknown: kept"
+	a, err := newAccountFromFileContent(nil, "name", []byte(s))
+	if err != nil {
+		t.Fatal("An error occured while parsing the account", err)
+	}
+	if len(a.Shares) != 1 {
+		t.Fatalf("Expected 1 share; got : %d", len(a.Shares))
+	}
+	expected := Share{Recipients: "AAAA BBBB", At: at, Out: "out.asc"}
+	if a.Shares[0] != expected {
+		t.Errorf("got : %v - expected : %v", a.Shares[0], expected)
+	}
+	if got := string(a.Bytes()); got != s {
+		t.Errorf("The metadata did not survive a round trip, got : %q", got)
+	}
+
+	a, err = newAccountFromFileContent(nil, "name", []byte("p"))
+	if err != nil || string(a.Password.Bytes()) != "p" || a.Username != "" {
+		t.Error("Expected a truncated account to be read; got :", a, err)
+	}
+}
+
+func Test_Account_Share(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-share")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = dir
+
+	outDir, err := ioutil.TempDir("", "keep-share-out")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(outDir)
+
+	a := &Account{config: c, Name: "example.com", Username: "u", Password: NewSecret([]byte("p")), Notes: "n"}
+	out := filepath.Join(outDir, "shared", "example.com.asc")
+	err = a.Share(os.Getenv("GPGKEY"), out)
+	if err != nil {
+		t.Fatal("An error occured while sharing the account", err)
+	}
+	if _, err := os.Stat(out); err != nil {
+		t.Error("Expected the shared copy to be written", err)
+	}
+
+	shared, err := c.ListShares()
+	if err != nil {
+		t.Fatal("An error occured while listing the shares", err)
+	}
+	if len(shared) != 1 || shared[0].Name != "example.com" || shared[0].Shares[0].Out != out {
+		t.Error("Unexpected shares :", shared)
+	}
+
+	read, err := NewAccountFromFile(c, "example.com")
+	if err != nil {
+		t.Fatal("An error occured while reading the account", err)
+	}
+	removed, err := read.Unshare("")
+	if err != nil || removed != 1 {
+		t.Errorf("Expected 1 share to be removed; got : %d (%v)", removed, err)
+	}
+}
+
+func Test_Account_Share_Private(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-share")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = dir
+
+	a := &Account{config: c, Name: "example.com", Username: "u", Password: NewSecret([]byte("old-password")), Notes: "n"}
+	if err := a.Save(); err != nil {
+		t.Fatal("An error occured while saving the account", err)
+	}
+	a.Password = NewSecret([]byte("new-password"))
+	if err := a.Save(); err != nil {
+		t.Fatal("An error occured while saving the account", err)
+	}
+	if len(a.History) != 1 {
+		t.Fatalf("Expected 1 previous password; got : %d", len(a.History))
+	}
+	readClear := func(path string) string {
+		md, err := c.decodeAccountFile(path)
+		if err != nil {
+			t.Fatal("An error occured while decrypting", path, err)
+		}
+		clear, err := ioutil.ReadAll(md.UnverifiedBody)
+		if err != nil {
+			t.Fatal("An error occured while decrypting", path, err)
+		}
+		return string(clear)
+	}
+
+	out := filepath.Join(dir, "example.com.asc")
+	if err := a.Share(os.Getenv("GPGKEY"), out); err != nil {
+		t.Fatal("An error occured while sharing the account", err)
+	}
+	if clear := readClear(out); strings.Contains(clear, "old-password") || strings.Contains(clear, fieldSharedWith) {
+		t.Errorf("Expected the shared copy to have no history and no shares; got : %q", clear)
+	}
+
+	if err := a.Share(os.Getenv("GPGKEY"), ""); err != nil {
+		t.Fatal("An error occured while sharing the account in place", err)
+	}
+	if clear := readClear(a.Path()); strings.Contains(clear, "old-password") || strings.Contains(clear, fieldSharedWith) {
+		t.Errorf("Expected the account shared in place to have no history and no shares; got : %q", clear)
+	}
+	read, err := NewAccountFromFile(c, a.Name)
+	if err != nil {
+		t.Fatal("An error occured while reading the account", err)
+	}
+	if len(read.History) != 1 || string(read.History[0].Password.Bytes()) != "old-password" || len(read.Shares) != 2 {
+		t.Errorf("Expected the private metadata to be read back; got : %v %v", read.History, read.Shares)
+	}
+
+	if _, err := read.Unshare(""); err != nil {
+		t.Fatal("An error occured while unsharing the account", err)
+	}
+	if _, err := os.Stat(read.privatePath()); !os.IsNotExist(err) {
+		t.Errorf("Expected the private metadata file to be removed; got : %v", err)
+	}
+	if clear := readClear(a.Path()); !strings.Contains(clear, "old-password") {
+		t.Errorf("Expected the history to be back in the account; got : %q", clear)
+	}
+}
+
+func Test_Config_ImportPublicKeys(t *testing.T) {
+	e, err := newKeyPair("contractor", "contractor@example.com", 1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := bytes.NewBuffer(nil)
+	aw, _ := armor.Encode(buf, openpgp.PublicKeyType, nil)
+	e.Serialize(aw)
+	aw.Close()
+	armored := buf.Bytes()
+
+	dir, err := ioutil.TempDir("", "keep-import")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c := &Config{PubringDir: filepath.Join(dir, "pubring.gpg")}
+
+	for i := 0; i < 2; i++ {
+		el, err := c.ImportPublicKeys(bytes.NewReader(armored))
+		if err != nil || len(el) != 1 {
+			t.Fatalf("An error occured while importing the key : %v", err)
+		}
+	}
+	pubring, err := getKeyRing(c.PubringDir)
+	if err != nil {
+		t.Fatal("An error occured while reading the pubring", err)
+	}
+	if len(pubring) != 1 {
+		t.Errorf("Expected the key to be imported once; got : %d", len(pubring))
+	}
+	if _, err := c.entityList("contractor@example.com"); err != nil {
+		t.Error("Expected the imported key to be found by email", err)
+	}
+}
+
+func Test_Account_MultiLineNotes(t *testing.T) {
+	// Accounts written with gpg or older versions of keep have notes on several lines
+	for _, tc := range []struct {
+		content, notes string
+	}{
+		{"p\nu\nfirst line\nsecond line", "first line\nsecond line"},
+		{"p\nu\nfirst line\n\nthird line: with a colon", "first line\n\nthird line: with a colon"},
+		{"p\nu\nfirst line\nsecond line\nCreated: 2017-06-01T10:00:00Z", "first line\nsecond line"},
+		// Lines that look like metadata are part of the notes unless their key is known and their value valid
+		{"p\nu\nsee\nhttps://example.com", "see\nhttps://example.com"},
+		{"p\nu\nsee\nhttps://example.com\nCreated: 2017-06-01T10:00:00Z", "see\nhttps://example.com"},
+		{"p\nu\nfirst line\nCreated: not a time", "first line\nCreated: not a time"},
+		{"p\nu\nfirst line\nTODO: renew", "first line\nTODO: renew"},
+	} {
+		a, err := newAccountFromFileContent(nil, "name", []byte(tc.content))
+		if err != nil {
+			t.Errorf("An error occured while parsing %q : %v", tc.content, err)
+			continue
+		}
+		if a.Notes != tc.notes {
+			t.Errorf("got : %q - expected : %q", a.Notes, tc.notes)
+		}
+		if got := string(a.Bytes()); got != tc.content {
+			t.Errorf("The notes did not survive a round trip, got : %q", got)
+		}
+	}
+
+	a := &Account{Name: "name"}
+	if err := a.SetField("notes", "first line\nCreated: 2017-06-01T10:00:00Z"); err == nil {
+		t.Error("Expected notes with a new line to be refused")
+	}
+	if err := a.SetField("TODO", "renew"); err == nil {
+		t.Error("Expected a field that would be read back as notes to be refused")
+	}
+	if err := a.SetField("X-Team", "ops"); err != nil {
+		t.Error("Expected an extension field to be set; got :", err)
+	}
+	a.Notes = "first line\nsecond line"
+	if err := a.checkNotes(); err == nil {
+		t.Error("Expected new notes with a new line to be refused")
+	}
+	a.saved = a.digest()
+	if err := a.checkNotes(); err != nil {
+		t.Error("Expected the notes read on several lines to be written back; got :", err)
+	}
+}
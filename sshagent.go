@@ -0,0 +1,275 @@
+package keep
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+)
+
+// SSHKey is an SSH private key stored in the metadata of an account.
+// It is only ever decrypted in memory, keep never writes it to disk in clear.
+type SSHKey struct {
+	// PEM is the private key in the PEM or the OpenSSH format, without passphrase.
+	PEM []byte
+	// Confirm requests the agent to ask the user before each use of the key.
+	Confirm bool
+	// Lifetime, if not zero, is how long the agent keeps the key once loaded.
+	Lifetime time.Duration
+}
+
+// String returns the representation of an SSHKey stored in the metadata: "<base64 key> [confirm] [lifetime=<duration>]".
+func (k SSHKey) String() string {
+	s := base64.StdEncoding.EncodeToString(k.PEM)
+	if k.Confirm {
+		s += " confirm"
+	}
+	if k.Lifetime > 0 {
+		s += " lifetime=" + k.Lifetime.String()
+	}
+	return s
+}
+
+func parseSSHKey(value string) (*SSHKey, error) {
+	parts := strings.Fields(value)
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("expected a base64 encoded private key")
+	}
+	pem, err := base64.StdEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, err
+	}
+	k := &SSHKey{PEM: pem}
+	for _, opt := range parts[1:] {
+		switch {
+		case opt == "confirm":
+			k.Confirm = true
+		case strings.HasPrefix(opt, "lifetime="):
+			k.Lifetime, err = time.ParseDuration(strings.TrimPrefix(opt, "lifetime="))
+			if err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unknown option %q", opt)
+		}
+	}
+	return k, nil
+}
+
+// SetSSHKey stores the private key in the account, it is not written until Save is called.
+// Keys protected by a passphrase are refused, the encryption of the account protects them.
+func (a *Account) SetSSHKey(pem []byte, confirm bool, lifetime time.Duration) error {
+	pem = bytes.TrimSpace(pem)
+	_, err := ssh.ParseRawPrivateKey(pem)
+	if _, ok := err.(*ssh.PassphraseMissingError); ok {
+		return fmt.Errorf("The SSH key is protected by a passphrase, remove it with ssh-keygen -p before storing it")
+	}
+	if err != nil {
+		return fmt.Errorf("An error occured while parsing the SSH key : %v", err)
+	}
+	if lifetime < 0 {
+		return fmt.Errorf("The lifetime of the SSH key cannot be negative")
+	}
+	a.SSHKey = &SSHKey{PEM: append(pem, '\n'), Confirm: confirm, Lifetime: lifetime}
+	return nil
+}
+
+// PublicKey returns the public key of the SSHKey.
+func (k *SSHKey) PublicKey() (ssh.PublicKey, error) {
+	signer, err := ssh.ParsePrivateKey(k.PEM)
+	if err != nil {
+		return nil, err
+	}
+	return signer.PublicKey(), nil
+}
+
+// AddedKey returns the key and its constraints as expected by Agent.Add.
+func (k *SSHKey) AddedKey(comment string) (agent.AddedKey, error) {
+	priv, err := ssh.ParseRawPrivateKey(k.PEM)
+	if err != nil {
+		return agent.AddedKey{}, err
+	}
+	return agent.AddedKey{
+		PrivateKey:       priv,
+		Comment:          comment,
+		ConfirmBeforeUse: k.Confirm,
+		// The lifetime is rounded up to the second, the unit of the protocol
+		LifetimeSecs: uint32((k.Lifetime + time.Second - 1) / time.Second),
+	}, nil
+}
+
+// SSHAgent is an ssh-agent keeping its keys in memory.
+// Unlike the keyring of golang.org/x/crypto/ssh/agent it honours the ConfirmBeforeUse constraint.
+type SSHAgent struct {
+	agent.ExtendedAgent
+	// Confirm is called before each use of a key added with ConfirmBeforeUse,
+	// the signature is refused if it is nil or returns false.
+	Confirm func(key *agent.Key) bool
+
+	mu sync.Mutex
+	// confirm holds the marshaled public keys that require a confirmation.
+	confirm map[string]bool
+	// prompt serializes the calls to Confirm without holding mu, the agent
+	// keeps answering the other requests while the user is asked.
+	prompt sync.Mutex
+}
+
+// NewSSHAgent returns an empty SSHAgent.
+func NewSSHAgent() *SSHAgent {
+	return &SSHAgent{
+		ExtendedAgent: agent.NewKeyring().(agent.ExtendedAgent),
+		confirm:       make(map[string]bool),
+	}
+}
+
+// Add adds the key to the agent and records its ConfirmBeforeUse constraint.
+func (s *SSHAgent) Add(key agent.AddedKey) error {
+	signer, err := ssh.NewSignerFromKey(key.PrivateKey)
+	if err != nil {
+		return err
+	}
+	err = s.ExtendedAgent.Add(key)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	pubs := []ssh.PublicKey{signer.PublicKey()}
+	if key.Certificate != nil {
+		pubs = append(pubs, key.Certificate)
+	}
+	for _, pub := range pubs {
+		if key.ConfirmBeforeUse {
+			s.confirm[string(pub.Marshal())] = true
+		} else {
+			delete(s.confirm, string(pub.Marshal()))
+		}
+	}
+	return nil
+}
+
+// Remove removes the key from the agent.
+func (s *SSHAgent) Remove(key ssh.PublicKey) error {
+	err := s.ExtendedAgent.Remove(key)
+	if err == nil {
+		s.mu.Lock()
+		delete(s.confirm, string(key.Marshal()))
+		s.mu.Unlock()
+	}
+	return err
+}
+
+// RemoveAll removes all the keys from the agent.
+func (s *SSHAgent) RemoveAll() error {
+	err := s.ExtendedAgent.RemoveAll()
+	if err == nil {
+		s.mu.Lock()
+		s.confirm = make(map[string]bool)
+		s.mu.Unlock()
+	}
+	return err
+}
+
+// Sign signs the data with the key, after the confirmation of the user if it has been requested.
+func (s *SSHAgent) Sign(key ssh.PublicKey, data []byte) (*ssh.Signature, error) {
+	return s.SignWithFlags(key, data, 0)
+}
+
+// SignWithFlags signs like Sign with the given flags.
+func (s *SSHAgent) SignWithFlags(key ssh.PublicKey, data []byte, flags agent.SignatureFlags) (*ssh.Signature, error) {
+	err := s.confirmUse(key)
+	if err != nil {
+		return nil, err
+	}
+	return s.ExtendedAgent.SignWithFlags(key, data, flags)
+}
+
+// confirmUse asks the user to confirm the use of the key if it requires it.
+// The confirmations are asked one at a time.
+func (s *SSHAgent) confirmUse(key ssh.PublicKey) error {
+	wanted := key.Marshal()
+	s.mu.Lock()
+	required := s.confirm[string(wanted)]
+	s.mu.Unlock()
+	if !required {
+		return nil
+	}
+	keys, err := s.ExtendedAgent.List()
+	if err != nil {
+		return err
+	}
+	for _, k := range keys {
+		if bytes.Equal(k.Marshal(), wanted) {
+			s.prompt.Lock()
+			defer s.prompt.Unlock()
+			if s.Confirm == nil || !s.Confirm(k) {
+				return fmt.Errorf("The use of the key %s has been refused", k.Comment)
+			}
+			return nil
+		}
+	}
+	// The key has expired or the agent is locked, the keyring reports it
+	return nil
+}
+
+// LoadSSHKeys adds the SSH keys of the named accounts to the agent, using the name of the
+// account as comment. When no name is given, every account holding an SSH key is loaded.
+// It returns the names of the accounts whose key has been loaded.
+func (c *Config) LoadSSHKeys(a agent.Agent, names ...string) ([]string, error) {
+	var accounts []*Account
+	if len(names) == 0 {
+		all, err := c.accounts()
+		if err != nil {
+			return nil, err
+		}
+		for _, account := range all {
+			if account.SSHKey != nil {
+				accounts = append(accounts, account)
+			}
+		}
+	}
+	for _, name := range names {
+		account, err := NewAccountFromFile(c, name)
+		if err != nil {
+			return nil, err
+		}
+		if account.SSHKey == nil {
+			return nil, fmt.Errorf("%s has no SSH key", name)
+		}
+		accounts = append(accounts, account)
+	}
+	loaded := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		key, err := account.SSHKey.AddedKey(account.Name)
+		if err != nil {
+			return nil, fmt.Errorf("An error occured while parsing the SSH key of %s : %v", account.Name, err)
+		}
+		err = a.Add(key)
+		if err != nil {
+			return nil, fmt.Errorf("An error occured while adding the SSH key of %s : %v", account.Name, err)
+		}
+		loaded = append(loaded, account.Name)
+	}
+	return loaded, nil
+}
+
+// ServeSSHAgent serves the ssh-agent protocol on the connections accepted by l.
+// It returns the error of Accept, e.g. once l has been closed.
+func ServeSSHAgent(l net.Listener, a agent.Agent) error {
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			agent.ServeAgent(a, conn)
+		}()
+	}
+}
@@ -0,0 +1,161 @@
+package keep
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ssh/agent"
+)
+
+func newTestSSHKey(t *testing.T) []byte {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der})
+}
+
+func Test_SSHAgent(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-ssh-agent")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = filepath.Join(dir, "passwords")
+
+	if err := NewAccount(c, "servers/web", "root", "p", "").SetSSHKey([]byte("not a key"), false, 0); err == nil {
+		t.Error("Expected an error for an invalid SSH key")
+	}
+	for _, k := range []struct {
+		name     string
+		confirm  bool
+		lifetime time.Duration
+	}{
+		{"servers/web", false, 0},
+		{"servers/db", true, 90 * time.Minute},
+	} {
+		a := NewAccount(c, k.name, "root", "p", "")
+		err = a.SetSSHKey(newTestSSHKey(t), k.confirm, k.lifetime)
+		if err != nil {
+			t.Fatal("An error occured while setting the SSH key", err)
+		}
+		err = a.Save()
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := NewAccount(c, "mail", "me", "p", "").Save(); err != nil {
+		t.Fatal(err)
+	}
+
+	read, err := NewAccountFromFile(c, "servers/db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if read.SSHKey == nil || !read.SSHKey.Confirm || read.SSHKey.Lifetime != 90*time.Minute {
+		t.Fatal("Expected the SSH key and its constraints to be read; got :", read.SSHKey)
+	}
+
+	a := NewSSHAgent()
+	confirmed := 0
+	a.Confirm = func(k *agent.Key) bool {
+		confirmed++
+		return confirmed == 1
+	}
+	loaded, err := c.LoadSSHKeys(a)
+	if err != nil || len(loaded) != 2 {
+		t.Fatal("Expected the 2 SSH keys to be loaded; got :", loaded, err)
+	}
+	if _, err := c.LoadSSHKeys(NewSSHAgent(), "mail"); err == nil {
+		t.Error("Expected an error for an account without SSH key")
+	}
+
+	l, err := net.Listen("unix", filepath.Join(dir, "agent.sock"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go ServeSSHAgent(l, a)
+
+	conn, err := net.Dial("unix", l.Addr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := agent.NewClient(conn)
+	keys, err := client.List()
+	if err != nil || len(keys) != 2 {
+		t.Fatal("Expected the agent to list 2 keys; got :", keys, err)
+	}
+
+	data := []byte("challenge")
+	for _, k := range keys {
+		sig, err := client.Sign(k, data)
+		if err != nil {
+			t.Fatal("An error occured while signing with", k.Comment, err)
+		}
+		if err := k.Verify(data, sig); err != nil {
+			t.Error("Expected a valid signature from", k.Comment, err)
+		}
+	}
+	if confirmed != 1 {
+		t.Error("Expected only the key of servers/db to be confirmed; got :", confirmed)
+	}
+	// The second confirmation is refused
+	for _, k := range keys {
+		_, err := client.Sign(k, data)
+		if refused := err != nil; refused != (k.Comment == "servers/db") {
+			t.Errorf("%s: unexpected error %v", k.Comment, err)
+		}
+	}
+}
+
+func Test_SSHAgent_ConfirmUnlocked(t *testing.T) {
+	a := NewSSHAgent()
+	newKey := func() *ecdsa.PrivateKey {
+		priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return priv
+	}
+	key := newKey()
+	if err := a.Add(agent.AddedKey{PrivateKey: key, Comment: "confirmed", ConfirmBeforeUse: true}); err != nil {
+		t.Fatal(err)
+	}
+	// The agent keeps answering while the user is asked
+	a.Confirm = func(k *agent.Key) bool {
+		return a.Add(agent.AddedKey{PrivateKey: newKey(), Comment: "added"}) == nil
+	}
+	keys, err := a.List()
+	if err != nil || len(keys) != 1 {
+		t.Fatal("Expected the agent to list 1 key; got :", keys, err)
+	}
+	done := make(chan error, 1)
+	go func() {
+		_, err := a.Sign(keys[0], []byte("challenge"))
+		done <- err
+	}()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Error("An error occured while signing", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("The agent is locked while the use of the key is confirmed")
+	}
+}
@@ -0,0 +1,741 @@
+package keep
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// The strength estimator follows the approach of zxcvbn: the password is split in
+// the sequence of patterns (dictionary words, keyboard walks, repetitions, sequences,
+// years and brute force) that an attacker would need the fewest guesses to find.
+
+const (
+	// bruteforceCardinality is the number of guesses per character not matched by a pattern.
+	bruteforceCardinality = 10
+	// minGuessesBeforeGrowingSequence penalizes the segmentation in many short patterns.
+	minGuessesBeforeGrowingSequence = 10000
+	minSubmatchGuessesSingleChar    = 10
+	minSubmatchGuessesMultiChar     = 50
+	minYearSpace                    = 20
+	maxSequenceDelta                = 5
+	// maxStrengthLength is the number of characters analysed, longer passwords
+	// are only estimated on their beginning.
+	maxStrengthLength = 100
+)
+
+const (
+	patternDictionary = "dictionary"
+	patternSpatial    = "spatial"
+	patternRepeat     = "repeat"
+	patternSequence   = "sequence"
+	patternYear       = "year"
+	patternBruteforce = "bruteforce"
+
+	dictPasswords  = "passwords"
+	dictWords      = "words"
+	dictNames      = "names"
+	dictUserInputs = "user inputs"
+)
+
+var scoreLabels = []string{"very weak", "weak", "fair", "strong", "very strong"}
+
+// Strength is the estimated strength of a password.
+type Strength struct {
+	// Score goes from 0, too guessable, to 4, very unguessable.
+	Score int
+	// Guesses is the estimated number of guesses needed to find the password.
+	Guesses float64
+	// Warning explains what makes the password weak, it is empty for strong passwords.
+	Warning     string
+	Suggestions []string
+}
+
+// Label returns a human readable version of the score.
+func (s Strength) Label() string {
+	return scoreLabels[s.Score]
+}
+
+// String returns the score and its label, e.g. "1/4 (weak)".
+func (s Strength) String() string {
+	return strconv.Itoa(s.Score) + "/4 (" + s.Label() + ")"
+}
+
+// match is a part of the password, between the runes i and j included, found by one of the matchers.
+type match struct {
+	pattern string
+	i, j    int
+	token   string
+	guesses float64
+
+	// dictionary
+	dictName string
+	rank     int
+	reversed bool
+	l33t     bool
+	// spatial
+	turns int
+	// repeat
+	baseToken string
+	// sequence
+	ascending bool
+}
+
+var (
+	rankedDictionaries     map[string]map[string]int
+	rankedDictionariesOnce sync.Once
+)
+
+func buildRankedDictionary(words []string) map[string]int {
+	ranked := make(map[string]int, len(words))
+	for i, w := range words {
+		w = strings.ToLower(w)
+		if _, ok := ranked[w]; !ok {
+			ranked[w] = i + 1
+		}
+	}
+	return ranked
+}
+
+func dictionaries() map[string]map[string]int {
+	rankedDictionariesOnce.Do(func() {
+		rankedDictionaries = map[string]map[string]int{
+			dictPasswords: buildRankedDictionary(commonPasswords),
+			dictWords:     buildRankedDictionary(commonWords),
+			dictNames:     buildRankedDictionary(commonNames),
+		}
+	})
+	return rankedDictionaries
+}
+
+// userInputsDictionary returns the dictionary of the words the password should not be built from,
+// like the username or the account name, split on non alphanumeric characters.
+func userInputsDictionary(inputs []string) map[string]int {
+	var words []string
+	for _, input := range inputs {
+		input = strings.ToLower(strings.TrimSpace(input))
+		if input == "" {
+			continue
+		}
+		words = append(words, input)
+		for _, w := range strings.FieldsFunc(input, func(r rune) bool { return !unicode.IsLetter(r) && !unicode.IsDigit(r) }) {
+			if len(w) >= 3 && w != input {
+				words = append(words, w)
+			}
+		}
+	}
+	return buildRankedDictionary(words)
+}
+
+// EstimateStrength returns the estimated strength of the password. The user inputs,
+// e.g. the username or the account name, are considered as known by the attacker.
+func EstimateStrength(password string, userInputs ...string) Strength {
+	runes := []rune(password)
+	if len(runes) > maxStrengthLength {
+		runes = runes[:maxStrengthLength]
+	}
+	dicts := map[string]map[string]int{dictUserInputs: userInputsDictionary(userInputs)}
+	for name, d := range dictionaries() {
+		dicts[name] = d
+	}
+	guesses, sequence := mostGuessableSequence(runes, omnimatch(runes, dicts))
+	s := Strength{Guesses: guesses, Score: guessesToScore(guesses)}
+	s.Warning, s.Suggestions = feedback(s.Score, sequence)
+	return s
+}
+
+func guessesToScore(guesses float64) int {
+	const delta = 5
+	switch {
+	case guesses < 1e3+delta:
+		return 0
+	case guesses < 1e6+delta:
+		return 1
+	case guesses < 1e8+delta:
+		return 2
+	case guesses < 1e10+delta:
+		return 3
+	default:
+		return 4
+	}
+}
+
+func omnimatch(runes []rune, dicts map[string]map[string]int) []match {
+	var matches []match
+	matches = append(matches, dictionaryMatches(runes, dicts)...)
+	matches = append(matches, spatialMatches(runes)...)
+	matches = append(matches, repeatMatches(runes, dicts)...)
+	matches = append(matches, sequenceMatches(runes)...)
+	matches = append(matches, yearMatches(runes)...)
+	return matches
+}
+
+// l33tTable maps the common substitutions to the letters they replace.
+var l33tTable = map[rune][]rune{
+	'4': {'a'}, '@': {'a'}, '8': {'b'}, '(': {'c'}, '{': {'c'}, '[': {'c'}, '<': {'c'},
+	'3': {'e'}, '6': {'g'}, '9': {'g'}, '1': {'i', 'l'}, '!': {'i'}, '|': {'i', 'l'},
+	'0': {'o'}, '$': {'s'}, '5': {'s'}, '+': {'t'}, '7': {'t'}, '%': {'x'}, '2': {'z'},
+}
+
+// unl33t returns the possible translations of token, a lower case string, and the number of substitutions made.
+// At most 8 translations are returned for tokens with several ambiguous substitutions.
+func unl33t(token []rune) ([]string, int) {
+	var subs int
+	variants := [][]rune{make([]rune, 0, len(token))}
+	for _, r := range token {
+		letters, ok := l33tTable[r]
+		if !ok {
+			for i := range variants {
+				variants[i] = append(variants[i], r)
+			}
+			continue
+		}
+		subs++
+		var next [][]rune
+		for _, v := range variants {
+			for _, l := range letters {
+				next = append(next, append(append([]rune{}, v...), l))
+			}
+		}
+		// Avoid the combinatorial explosion on long tokens made of ambiguous substitutions
+		if len(next) > 8 {
+			next = next[:8]
+		}
+		variants = next
+	}
+	if subs == 0 {
+		return nil, 0
+	}
+	translated := make([]string, len(variants))
+	for i, v := range variants {
+		translated[i] = string(v)
+	}
+	return translated, subs
+}
+
+func dictionaryMatches(runes []rune, dicts map[string]map[string]int) []match {
+	var matches []match
+	lower := []rune(strings.ToLower(string(runes)))
+	if len(lower) != len(runes) {
+		// Some runes change size when lower cased, only work on the original runes
+		lower = runes
+	}
+	for i := 0; i < len(runes); i++ {
+		for j := i + 2; j < len(runes); j++ {
+			token := string(runes[i : j+1])
+			word := string(lower[i : j+1])
+			reversed := reverseRunes(lower[i : j+1])
+			translated, subs := unl33t(lower[i : j+1])
+			for name, d := range dicts {
+				if rank, ok := d[word]; ok {
+					matches = append(matches, newDictionaryMatch(i, j, token, name, rank, false, 0))
+				}
+				if rank, ok := d[reversed]; ok && reversed != word {
+					matches = append(matches, newDictionaryMatch(i, j, token, name, rank, true, 0))
+				}
+				for _, t := range translated {
+					if rank, ok := d[t]; ok {
+						matches = append(matches, newDictionaryMatch(i, j, token, name, rank, false, subs))
+						break
+					}
+				}
+			}
+		}
+	}
+	return matches
+}
+
+func newDictionaryMatch(i, j int, token, dictName string, rank int, reversed bool, subs int) match {
+	guesses := float64(rank) * uppercaseVariations(token)
+	if reversed {
+		guesses *= 2
+	}
+	if subs > 0 {
+		// Each substitution at most doubles the number of guesses
+		guesses *= math.Min(math.Pow(2, float64(subs)), float64(len([]rune(token))))
+	}
+	return match{
+		pattern:  patternDictionary,
+		i:        i,
+		j:        j,
+		token:    token,
+		guesses:  guesses,
+		dictName: dictName,
+		rank:     rank,
+		reversed: reversed,
+		l33t:     subs > 0,
+	}
+}
+
+func reverseRunes(runes []rune) string {
+	reversed := make([]rune, len(runes))
+	for i, r := range runes {
+		reversed[len(runes)-1-i] = r
+	}
+	return string(reversed)
+}
+
+func binomial(n, k int) float64 {
+	if k > n {
+		return 0
+	}
+	if k == 0 {
+		return 1
+	}
+	r := 1.0
+	for d := 1; d <= k; d++ {
+		r *= float64(n)
+		r /= float64(d)
+		n--
+	}
+	return r
+}
+
+// uppercaseVariations returns the number of ways the case of a word could have been chosen.
+func uppercaseVariations(token string) float64 {
+	var upper, lower int
+	runes := []rune(token)
+	for _, r := range runes {
+		if unicode.IsUpper(r) {
+			upper++
+		} else if unicode.IsLower(r) {
+			lower++
+		}
+	}
+	if upper == 0 {
+		return 1
+	}
+	// All upper case, first or last letter capitalized
+	if lower == 0 || (upper == 1 && (unicode.IsUpper(runes[0]) || unicode.IsUpper(runes[len(runes)-1]))) {
+		return 2
+	}
+	var variations float64
+	for i := 1; i <= upper && i <= lower; i++ {
+		variations += binomial(upper+lower, i)
+	}
+	return variations
+}
+
+// keyboardRows describes a qwerty keyboard, each row is shifted so that the key at index c
+// is adjacent to the keys c and c+1 of the previous row and c-1 and c of the next row.
+var keyboardRows = [][2]string{
+	{"`1234567890-=", "~!@#$%^&*()_+"},
+	{" qwertyuiop[]\\", " QWERTYUIOP{}|"},
+	{" asdfghjkl;'", " ASDFGHJKL:\""},
+	{" zxcvbnm,./", " ZXCVBNM<>?"},
+}
+
+type keyPosition struct {
+	row, col int
+	shifted  bool
+}
+
+var (
+	keyboard     map[rune]keyPosition
+	keyboardOnce sync.Once
+)
+
+func keyboardPositions() map[rune]keyPosition {
+	keyboardOnce.Do(func() {
+		keyboard = make(map[rune]keyPosition)
+		for row, keys := range keyboardRows {
+			for shifted, chars := range keys {
+				for col, r := range []rune(chars) {
+					if r != ' ' {
+						keyboard[r] = keyPosition{row, col, shifted == 1}
+					}
+				}
+			}
+		}
+	})
+	return keyboard
+}
+
+// keyDirection returns the direction, from 1 to 6, to go from a to b on the keyboard or 0 if they are not adjacent.
+func keyDirection(a, b rune) int {
+	kb := keyboardPositions()
+	pa, ok := kb[a]
+	if !ok {
+		return 0
+	}
+	pb, ok := kb[b]
+	if !ok {
+		return 0
+	}
+	switch {
+	case pb.row == pa.row && pb.col == pa.col-1:
+		return 1
+	case pb.row == pa.row && pb.col == pa.col+1:
+		return 2
+	case pb.row == pa.row-1 && pb.col == pa.col:
+		return 3
+	case pb.row == pa.row-1 && pb.col == pa.col+1:
+		return 4
+	case pb.row == pa.row+1 && pb.col == pa.col-1:
+		return 5
+	case pb.row == pa.row+1 && pb.col == pa.col:
+		return 6
+	}
+	return 0
+}
+
+// keyboardStats returns the number of keys and the average number of neighbours of a key.
+func keyboardStats() (float64, float64) {
+	kb := keyboardPositions()
+	var keys, neighbours float64
+	for a, pa := range kb {
+		if pa.shifted {
+			continue
+		}
+		keys++
+		for b, pb := range kb {
+			if !pb.shifted && keyDirection(a, b) != 0 {
+				neighbours++
+			}
+		}
+	}
+	return keys, neighbours / keys
+}
+
+func spatialMatches(runes []rune) []match {
+	var matches []match
+	kb := keyboardPositions()
+	for i := 0; i < len(runes)-2; {
+		j := i
+		turns := 0
+		lastDirection := -1
+		for j+1 < len(runes) {
+			d := keyDirection(runes[j], runes[j+1])
+			if d == 0 {
+				break
+			}
+			if d != lastDirection {
+				turns++
+				lastDirection = d
+			}
+			j++
+		}
+		if j-i >= 2 {
+			var shifted int
+			for _, r := range runes[i : j+1] {
+				if kb[r].shifted {
+					shifted++
+				}
+			}
+			matches = append(matches, newSpatialMatch(i, j, string(runes[i:j+1]), turns, shifted))
+			i = j
+			continue
+		}
+		i++
+	}
+	return matches
+}
+
+func newSpatialMatch(i, j int, token string, turns, shifted int) match {
+	startingPositions, averageDegree := keyboardStats()
+	length := j - i + 1
+	var guesses float64
+	for l := 2; l <= length; l++ {
+		for t := 1; t <= turns && t <= l-1; t++ {
+			guesses += binomial(l-1, t-1) * startingPositions * math.Pow(averageDegree, float64(t))
+		}
+	}
+	if shifted > 0 {
+		unshifted := length - shifted
+		if unshifted == 0 {
+			guesses *= 2
+		} else {
+			var variations float64
+			for k := 1; k <= shifted && k <= unshifted; k++ {
+				variations += binomial(shifted+unshifted, k)
+			}
+			guesses *= variations
+		}
+	}
+	return match{pattern: patternSpatial, i: i, j: j, token: token, guesses: guesses, turns: turns}
+}
+
+func repeatMatches(runes []rune, dicts map[string]map[string]int) []match {
+	var matches []match
+	for i := 0; i < len(runes)-1; {
+		bestSpan, bestBase := 0, 0
+		for base := 1; i+2*base <= len(runes); base++ {
+			count := 1
+			for i+(count+1)*base <= len(runes) && string(runes[i+count*base:i+(count+1)*base]) == string(runes[i:i+base]) {
+				count++
+			}
+			if count > 1 && count*base > bestSpan {
+				bestSpan, bestBase = count*base, base
+			}
+		}
+		if bestSpan == 0 {
+			i++
+			continue
+		}
+		base := runes[i : i+bestBase]
+		baseGuesses, _ := mostGuessableSequence(base, omnimatch(base, dicts))
+		matches = append(matches, match{
+			pattern:   patternRepeat,
+			i:         i,
+			j:         i + bestSpan - 1,
+			token:     string(runes[i : i+bestSpan]),
+			guesses:   baseGuesses * float64(bestSpan/bestBase),
+			baseToken: string(base),
+		})
+		i += bestSpan
+	}
+	return matches
+}
+
+func runeClass(r rune) int {
+	switch {
+	case r >= 'a' && r <= 'z':
+		return 1
+	case r >= 'A' && r <= 'Z':
+		return 2
+	case r >= '0' && r <= '9':
+		return 3
+	}
+	return 0
+}
+
+func sequenceMatches(runes []rune) []match {
+	var matches []match
+	for i := 0; i < len(runes)-2; {
+		delta := runes[i+1] - runes[i]
+		class := runeClass(runes[i])
+		if class == 0 || delta == 0 || delta > maxSequenceDelta || delta < -maxSequenceDelta {
+			i++
+			continue
+		}
+		j := i + 1
+		for j+1 < len(runes) && runes[j+1]-runes[j] == delta && runeClass(runes[j+1]) == class {
+			j++
+		}
+		if j-i < 2 || runeClass(runes[j]) != class {
+			i++
+			continue
+		}
+		matches = append(matches, newSequenceMatch(i, j, string(runes[i:j+1]), delta > 0))
+		i = j
+	}
+	return matches
+}
+
+func newSequenceMatch(i, j int, token string, ascending bool) match {
+	var base float64
+	switch first := []rune(token)[0]; {
+	case strings.ContainsRune("aAzZ019", first):
+		base = 4
+	case unicode.IsDigit(first):
+		base = 10
+	default:
+		base = 26
+	}
+	if !ascending {
+		base *= 2
+	}
+	return match{pattern: patternSequence, i: i, j: j, token: token, guesses: base * float64(j-i+1), ascending: ascending}
+}
+
+func yearMatches(runes []rune) []match {
+	var matches []match
+	now := time.Now().Year()
+	for i := 0; i+4 <= len(runes); i++ {
+		token := string(runes[i : i+4])
+		year, err := strconv.Atoi(token)
+		if err != nil || year < 1900 || year > 2099 {
+			continue
+		}
+		space := math.Max(math.Abs(float64(year-now)), minYearSpace)
+		matches = append(matches, match{pattern: patternYear, i: i, j: i + 3, token: token, guesses: space})
+	}
+	return matches
+}
+
+func factorial(n int) float64 {
+	f := 1.0
+	for i := 2; i <= n; i++ {
+		f *= float64(i)
+	}
+	return f
+}
+
+// log10Add returns log10(10^a + 10^b) without overflowing.
+func log10Add(a, b float64) float64 {
+	if a < b {
+		a, b = b, a
+	}
+	return a + math.Log10(1+math.Pow(10, b-a))
+}
+
+// mostGuessableSequence returns the minimum number of guesses needed to find the password
+// and the sequence of matches that achieves it. Like zxcvbn the number of guesses of a
+// sequence of l matches is l! * product(guesses) + minGuessesBeforeGrowingSequence^(l-1).
+func mostGuessableSequence(runes []rune, matches []match) (float64, []match) {
+	n := len(runes)
+	if n == 0 {
+		return 1, nil
+	}
+	byEnd := make([][]match, n)
+	for _, m := range matches {
+		if m.j-m.i+1 < n {
+			min := float64(minSubmatchGuessesMultiChar)
+			if m.i == m.j {
+				min = minSubmatchGuessesSingleChar
+			}
+			m.guesses = math.Max(m.guesses, min)
+		}
+		byEnd[m.j] = append(byEnd[m.j], m)
+	}
+	for i := 0; i < n; i++ {
+		for j := i; j < n; j++ {
+			guesses := math.Pow(bruteforceCardinality, float64(j-i+1))
+			if j-i+1 < n {
+				guesses = math.Max(guesses, minSubmatchGuessesMultiChar+1)
+			}
+			byEnd[j] = append(byEnd[j], match{pattern: patternBruteforce, i: i, j: j, token: string(runes[i : j+1]), guesses: guesses})
+		}
+	}
+
+	// best[k][l] is the log10 of the product of the guesses of l matches covering the k first runes.
+	type step struct {
+		logProduct float64
+		m          match
+		set        bool
+	}
+	best := make([][]step, n+1)
+	for k := range best {
+		best[k] = make([]step, n+1)
+	}
+	best[0][0] = step{set: true}
+	for k := 1; k <= n; k++ {
+		for _, m := range byEnd[k-1] {
+			for l := 0; l <= m.i; l++ {
+				prev := best[m.i][l]
+				if !prev.set {
+					continue
+				}
+				logProduct := prev.logProduct + math.Log10(m.guesses)
+				if cur := best[k][l+1]; !cur.set || logProduct < cur.logProduct {
+					best[k][l+1] = step{logProduct: logProduct, m: m, set: true}
+				}
+			}
+		}
+	}
+
+	bestL, bestLog := 0, math.Inf(1)
+	for l := 1; l <= n; l++ {
+		if !best[n][l].set {
+			continue
+		}
+		logGuesses := log10Add(math.Log10(factorial(l))+best[n][l].logProduct, float64(l-1)*math.Log10(minGuessesBeforeGrowingSequence))
+		if logGuesses < bestLog {
+			bestL, bestLog = l, logGuesses
+		}
+	}
+
+	sequence := make([]match, bestL)
+	for k, l := n, bestL; l > 0; l-- {
+		m := best[k][l].m
+		sequence[l-1] = m
+		k = m.i
+	}
+	return math.Pow(10, bestLog), sequence
+}
+
+// feedback returns a warning and suggestions explaining how to improve a weak password.
+func feedback(score int, sequence []match) (string, []string) {
+	if len(sequence) == 0 {
+		return "", []string{"Use a few words, avoid common phrases"}
+	}
+	if score > 2 {
+		return "", nil
+	}
+	suggestions := []string{"Add another word or two. Uncommon words are better."}
+	var longest match
+	for _, m := range sequence {
+		if len(m.token) > len(longest.token) {
+			longest = m
+		}
+	}
+	switch longest.pattern {
+	case patternDictionary:
+		return dictionaryFeedback(longest, len(sequence) == 1, suggestions)
+	case patternSpatial:
+		if longest.turns == 1 {
+			return "Straight rows of keys are easy to guess", append(suggestions, "Use a longer keyboard pattern with more turns")
+		}
+		return "Short keyboard patterns are easy to guess", append(suggestions, "Use a longer keyboard pattern with more turns")
+	case patternRepeat:
+		if len([]rune(longest.baseToken)) == 1 {
+			return `Repeats like "aaa" are easy to guess`, append(suggestions, "Avoid repeated words and characters")
+		}
+		return `Repeats like "abcabcabc" are only slightly harder to guess than "abc"`, append(suggestions, "Avoid repeated words and characters")
+	case patternSequence:
+		return "Sequences like abc or 6543 are easy to guess", append(suggestions, "Avoid sequences")
+	case patternYear:
+		return "Recent years are easy to guess", append(suggestions, "Avoid recent years and years that are associated with you")
+	}
+	return "", suggestions
+}
+
+func dictionaryFeedback(m match, alone bool, suggestions []string) (string, []string) {
+	var warning string
+	switch m.dictName {
+	case dictPasswords:
+		switch {
+		case alone && !m.l33t && !m.reversed && m.rank <= 10:
+			warning = "This is a top-10 common password"
+		case alone && !m.l33t && !m.reversed && m.rank <= 100:
+			warning = "This is a top-100 common password"
+		default:
+			warning = "This is similar to a commonly used password"
+		}
+	case dictWords:
+		if alone {
+			warning = "A word by itself is easy to guess"
+		}
+	case dictNames:
+		if alone {
+			warning = "Names and surnames by themselves are easy to guess"
+		} else {
+			warning = "Common names and surnames are easy to guess"
+		}
+	case dictUserInputs:
+		warning = "The password contains the account name or the username"
+	}
+	runes := []rune(m.token)
+	if strings.ToUpper(m.token) == m.token && strings.ToLower(m.token) != m.token {
+		suggestions = append(suggestions, "All-uppercase is almost as easy to guess as all-lowercase")
+	} else if unicode.IsUpper(runes[0]) {
+		suggestions = append(suggestions, "Capitalization doesn't help very much")
+	}
+	if m.reversed && len(runes) >= 4 {
+		suggestions = append(suggestions, "Reversed words aren't much harder to guess")
+	}
+	if m.l33t {
+		suggestions = append(suggestions, "Predictable substitutions like '@' instead of 'a' don't help very much")
+	}
+	return warning, suggestions
+}
+
+// Strength estimates the strength of the password of the account, considering
+// that its name and its username are known to the attacker.
+func (a *Account) Strength() Strength {
+	return EstimateStrength(string(a.Password.Bytes()), append(strings.Split(a.Name, "/"), a.Username)...)
+}
+
+// CheckStrength returns the strength of the password of the account and an
+// error if its score is below the MinPasswordScore of the Config.
+func (a *Account) CheckStrength() (Strength, error) {
+	s := a.Strength()
+	if s.Score < a.config.MinPasswordScore {
+		return s, fmt.Errorf("The password is too weak, its score is %d and the profile requires at least %d", s.Score, a.config.MinPasswordScore)
+	}
+	return s, nil
+}
@@ -0,0 +1,76 @@
+package keep
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+)
+
+func Test_EstimateStrength(t *testing.T) {
+	weak := []string{"", "password", "password1", "P@ssw0rd", "qwerty", "zxcvbnm", "aaaaaaaa", "abcabcabc", "abcdefgh", "98765432", "1990"}
+	for _, p := range weak {
+		if s := EstimateStrength(p); s.Score != 0 {
+			t.Errorf("Expected %q to score 0; got : %v", p, s)
+		}
+	}
+	strong := []string{"kP3nQ8zR2wX5yT7m", "correcthorsebatterystaple"}
+	for _, p := range strong {
+		if s := EstimateStrength(p); s.Score < 3 {
+			t.Errorf("Expected %q to score at least 3; got : %v", p, s)
+		}
+	}
+
+	s := EstimateStrength("qwertyuiop")
+	if s.Warning == "" || len(s.Suggestions) == 0 {
+		t.Error("Expected feedback for a weak password; got :", s)
+	}
+	if s := EstimateStrength("kP3nQ8zR2wX5yT7m"); s.Warning != "" {
+		t.Error("Expected no warning for a strong password; got :", s.Warning)
+	}
+
+	// The user inputs are known to the attacker
+	if without, with := EstimateStrength("jdoe4242"), EstimateStrength("jdoe4242", "jdoe"); with.Guesses >= without.Guesses {
+		t.Errorf("Expected the username to weaken the password; got : %v - %v", with.Guesses, without.Guesses)
+	}
+}
+
+func Test_Account_CheckStrength(t *testing.T) {
+	c := NewConfig(nil)
+	c.MinPasswordScore = 3
+	a := NewAccount(c, "example.com", "jdoe", "example2017", "")
+	if _, err := a.CheckStrength(); err == nil {
+		t.Error("Expected a weak password to be refused")
+	}
+	a.Password = NewSecret([]byte("kP3nQ8zR2wX5yT7m"))
+	if _, err := a.CheckStrength(); err != nil {
+		t.Error("Expected a strong password to be accepted; got :", err)
+	}
+}
+
+func Test_Config_AuditStrength(t *testing.T) {
+	c := NewConfig(nil)
+	dir, err := ioutil.TempDir("", "keep-strength")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	c.AccountDir = dir
+
+	for name, password := range map[string]string{"strong": "kP3nQ8zR2wX5yT7m", "weak": "password", "fair": "dragon2019!"} {
+		err = NewAccount(c, name, "u", password, "").Save()
+		if err != nil {
+			t.Fatal("An error occured while saving the account", err)
+		}
+	}
+	strengths, err := c.AuditStrength()
+	if err != nil {
+		t.Fatal("An error occured while auditing the accounts", err)
+	}
+	var names []string
+	for _, s := range strengths {
+		names = append(names, s.Name)
+	}
+	if len(names) != 3 || names[0] != "weak" || names[2] != "strong" {
+		t.Error("Expected the accounts to be ranked from the weakest; got :", names)
+	}
+}
@@ -0,0 +1,3390 @@
+package keep
+
+import "strings"
+
+// The bundled word lists used by the strength estimator, ordered by frequency.
+// The rank of a word in its list is the number of guesses an attacker needs to find it.
+//
+// The lists are the frequency lists of zxcvbn (https://github.com/dropbox/zxcvbn, MIT license),
+// as distributed with github.com/nbutton23/zxcvbn-go: the words are ranked by how often they are
+// used, not sorted. They are truncated to keep the binary small, a word beyond the lists is
+// estimated by the brute force guesses instead.
+
+// commonPasswords are the 7141 passwords kept by zxcvbn from Mark Burnett's list of the 10,000 most
+// common passwords, ranked by frequency.
+var commonPasswords = strings.Fields(`
+password 123456 12345678 1234 qwerty 12345 dragon pussy baseball football letmein monkey 696969
+abc123 mustang shadow master 111111 2000 jordan superman harley 1234567 fuckme hunter fuckyou
+trustno1 ranger buster tigger soccer fuck batman test pass killer hockey charlie love sunshine
+asshole 6969 pepper access 123456789 654321 maggie starwars silver dallas yankees 123123 666666
+hello orange biteme freedom computer sexy thunder ginger hammer summer corvette fucker austin 1111
+merlin 121212 golfer cheese princess chelsea diamond yellow bigdog secret asdfgh sparky cowboy
+camaro matrix falcon iloveyou guitar purple scooter phoenix aaaaaa tigers porsche mickey maverick
+cookie nascar peanut 131313 money horny samantha panties steelers snoopy boomer whatever iceman
+smokey gateway dakota cowboys eagles chicken dick black zxcvbn ferrari knight hardcore compaq coffee
+booboo bitch bulldog xxxxxx welcome player ncc1701 wizard scooby junior internet bigdick brandy
+tennis blowjob banana monster spider lakers rabbit enter mercedes fender yamaha diablo boston tiger
+marine chicago rangers gandalf winter bigtits barney raiders porn badboy blowme spanky bigdaddy
+chester london midnight blue fishing 000000 hannah slayer 11111111 sexsex redsox thx1138 asdf
+marlboro panther zxcvbnm arsenal qazwsx mother 7777777 jasper winner golden butthead viking iwantu
+angels prince cameron girls madison hooters startrek captain maddog jasmine butter booger golf
+rocket theman liverpoo flower forever muffin turtle sophie redskins toyota sierra winston giants
+packers newyork casper bubba 112233 lovers mountain united driver helpme fucking pookie lucky
+maxwell 8675309 bear suckit gators 5150 222222 shithead fuckoff jaguar hotdog tits gemini lover
+xxxxxxxx 777777 canada florida 88888888 rosebud metallic doctor trouble success stupid tomcat
+warrior peaches apples fish qwertyui magic buddy dolphins rainbow gunner 987654 freddy alexis braves
+cock 2112 1212 cocacola xavier dolphin testing bond007 member voodoo 7777 samson apollo fire tester
+beavis voyager porno rush2112 beer apple scorpio skippy sydney red123 power beaver star jackass
+flyers boobs 232323 zzzzzz scorpion doggie legend ou812 yankee blazer runner birdie bitches 555555
+topgun asdfasdf heaven viper animal 2222 bigboy 4444 private godzilla lifehack phantom rock august
+sammy cool platinum jake bronco heka6w2 copper cumshot garfield willow cunt slut 69696969 kitten
+super jordan23 eagle1 shelby america 11111 free 123321 chevy bullshit broncos horney surfer nissan
+999999 saturn airborne elephant shit action adidas qwert 1313 explorer police christin december wolf
+sweet therock online dickhead brooklyn cricket racing penis 0000 teens redwings dreams michigan
+hentai magnum 87654321 donkey trinity digital 333333 cartman guinness 123abc speedy buffalo kitty
+pimpin eagle einstein nirvana vampire xxxx playboy pumpkin snowball test123 sucker mexico beatles
+fantasy celtic cherry cassie 888888 sniper genesis hotrod reddog alexande college jester passw0rd
+bigcock lasvegas slipknot 3333 death 1q2w3e eclipse 1q2w3e4r drummer montana music aaaa carolina
+colorado creative hello1 goober friday bollocks scotty abcdef bubbles hawaii fluffy horses thumper
+5555 pussies darkness asdfghjk boobies buddha sandman naughty honda azerty 6666 shorty money1 beach
+loveme 4321 simple poohbear 444444 badass destiny vikings lizard assman nintendo 123qwe november
+xxxxx october leather bastard 101010 extreme password1 pussy1 lacrosse hotmail spooky amateur alaska
+badger paradise maryjane poop mozart video vagina spitfire cherokee cougar 420420 horse enigma
+raider brazil blonde 55555 dude drowssap lovely 1qaz2wsx booty snickers nipples diesel rocks eminem
+westside suzuki passion hummer ladies alpha suckme 147147 pirate semperfi jupiter redrum freeuser
+wanker stinky ducati paris babygirl windows spirit pantera monday patches brutus smooth penguin
+marley forest cream 212121 flash maximus nipple vision pokemon champion fireman indian softball
+picard system cobra enjoy lucky1 boogie marines security dirty admin wildcats pimp dancer hardon
+fucked abcd1234 abcdefg ironman wolverin freepass bigred squirt justice hobbes pearljam mercury
+domino 9999 rascal hitman mistress bbbbbb peekaboo naked budlight electric sluts stargate saints
+bondage bigman zombie swimming duke qwerty1 babes scotland disney rooster mookie swordfis hunting
+blink182 8888 samsung bubba1 whore general passport aaaaaaaa erotic liberty arizona abcd newport
+skipper rolltide balls happy1 galore christ weasel 242424 wombat digger classic bulldogs poopoo
+accord popcorn turkey bunny mouse 007007 titanic liverpool dreamer everton chevelle psycho nemesis
+pontiac connor eatme lickme cumming ireland spiderma patriots goblue devils empire asdfg cardinal
+shaggy froggy qwer kawasaki kodiak phpbb 54321 chopper hooker whynot lesbian snake teen ncc1701d
+qqqqqq airplane britney avalon sugar sublime wildcat raven scarface elizabet 123654 trucks wolfpack
+pervert redhead american bambam woody shaved snowman tiger1 chicks raptor 1969 stingray shooter
+france stars madmax sports 789456 simpsons lights chronic hahaha packard hendrix service spring
+srinivas spike 252525 bigmac suck single popeye tattoo texas bullet taurus sailor wolves panthers
+japan strike pussycat chris1 loverboy berlin sticky tarheels russia wolfgang testtest mature catch22
+juice michael1 nigger 159753 alpha1 trooper hawkeye freaky dodgers pakistan machine pyramid vegeta
+katana moose tinker coyote infinity pepsi letmein1 bang hercules james1 tickle outlaw browns
+billybob pickle test1 sucks pavilion changeme caesar prelude darkside bowling wutang sunset alabama
+danger zeppelin pppppp 2001 ping darkstar madonna qwe123 bigone casino charlie1 mmmmmm integra
+wrangler apache tweety qwerty12 bobafett transam 2323 seattle ssssss openup pandora pussys trucker
+indigo storm malibu weed review babydoll doggy dilbert pegasus joker catfish flipper fuckit detroit
+cheyenne bruins smoke marino fetish xfiles stinger pizza babe stealth manutd gundam cessna longhorn
+presario mnbvcxz wicked mustang1 victory 21122112 awesome athena q1w2e3r4 holiday knicks redneck
+12341234 gizmo scully dragon1 devildog triumph bluebird shotgun peewee angel1 metallica madman
+impala lennon omega access14 enterpri search smitty blizzard unicorn tight asdf1234 trigger truck
+beauty thailand 1234567890 cadillac castle bobcat buddy1 sunny stones asian butt loveyou hellfire
+hotsex indiana panzer lonewolf trumpet colors blaster 12121212 fireball precious jungle atlanta gold
+corona polaris timber theone baller chipper skyline dragons dogs licker engineer kong pencil
+basketba hornet barbie wetpussy indians redman foobar travel morpheus target 141414 hotstuff photos
+rocky1 fuck_inside dollar turbo design hottie 202020 blondes 4128 lestat avatar goforit random
+abgrtyu jjjjjj cancer q1w2e3 smiley express virgin zipper wrinkle1 babylon consumer monkey1 serenity
+samurai 99999999 bigboobs skeeter joejoe master1 aaaaa chocolat christia stephani tang 1234qwer
+98765432 sexual maxima 77777777 buckeye highland seminole reaper bassman nugget lucifer airforce
+nasty warlock 2121 dodge chrissy burger snatch pink gang maddie huskers piglet photo dodger paladin
+chubby buckeyes hamlet abcdefgh bigfoot sunday manson goldfish garden deftones icecream blondie
+spartan charger stormy juventus galaxy escort zxcvb planet blues david1 ncc1701e 1966 51505150
+cavalier gambit ripper oicu812 nylons aardvark whiskey bing plastic anal babylon5 loser racecar
+insane yankees1 mememe hansolo chiefs fredfred freak frog salmon concrete zxcv shamrock atlantis
+wordpass rommel 1010 predator massive cats sammy1 mister stud marathon rubber ding trunks desire
+montreal justme faster irish 1999 jessica1 alpine diamonds 00000 swinger shan stallion pitbull
+letmein2 ming shadow1 clitoris fuckers jackoff bluesky sundance renegade hollywoo 151515 wolfman
+soldier ling goddess manager sweety titans fang ficken niners bubble hello123 ibanez sweetpea
+stocking 323232 tornado content aragorn trojan christop rockstar geronimo pascal crimson google
+fatcat lovelove cunts stimpy finger wheels viper1 latin greenday 987654321 creampie hiphop snapper
+funtime duck trombone adult cookies mulder westham latino jeep ravens drizzt madness energy kinky
+314159 slick rocker 55555555 mongoose speed dddddd catdog cheng ghost gogogo tottenha curious
+butterfl mission january shark techno lancer lalala chichi orion trixie delta bobbob bomber kang
+1968 spunky liquid beagle granny network kkkkkk 1973 biggie beetle teacher toronto anakin genius
+cocks dang karate snakes bangkok fuckyou2 pacific daytona infantry skywalke sailing raistlin
+vanhalen huang blackie tarzan strider sherlock gong dietcoke ultimate shai sprite ting artist chai
+chao devil python ninja ytrewq superfly 456789 tian jing jesus1 freedom1 drpepper chou hobbit shen
+nolimit mylove biscuit yahoo shasta sex4me smoker pebbles pics philly tong tintin lesbians cactus
+frank1 tttttt chun danni emerald showme pirates lian dogg xiao xian tazman tanker toshiba gotcha
+rang keng jazz bigguy yuan tomtom chaos fossil racerx creamy bobo musicman warcraft blade shuang
+shun lick jian microsoft rong feng getsome quality 1977 beng wwwwww yoyoyo zhang seng harder qazxsw
+qian cong chuan deng nang boeing keeper western 1963 subaru sheng thuglife teng jiong miao mang
+maniac pussie a1b2c3 zhou zhuang xing stonecol spyder liang jiang memphis ceng magic1 logitech
+chuang sesame shao poison titty kuan kuai mian guan hamster guai ferret geng duan pang maiden quan
+velvet nong neng nookie buttons bian bingo biao zhong zeng zhun ying zong xuan zang 0.0.000 suan
+shei shui sharks shang shua peng pian piao liao meng miami reng guang cang ruan diao luan qing chui
+chuo cuan nuan ning heng huan kansas muscle weng 1passwor bluemoon zhui zhua xiang zheng zhen zhei
+zhao zhan yomama zhai zhuo zuan tarheel shou shuo tiao leng kuang jiao 13579 basket qiao qiong qiang
+chuai nian niao niang huai 22222222 zhuan zhuai shuan shuai stardust jumper 66666666 charlott qwertz
+bones waterloo 2002 11223344 oldman trains vertigo 246810 black1 swallow smiles standard alexandr
+parrot user 1976 surfing pioneer apple1 asdasd auburn hannibal frontier panama welcome1 vette blue22
+shemale 111222 baggins groovy global 181818 1979 blades spanking byteme lobster dawg japanese 1970
+1964 2424 polo coco deedee mikey 1972 171717 1701 strip jersey green1 capital putter vader seven7
+banshee grendel dicks hidden iloveu 1980 ledzep 147258 female bugger buffett molson 2020 wookie
+sprint jericho 102030 ranger1 trebor deepthroat bonehead molly1 mirage models 1984 2468 showtime
+squirrel pentium anime gator powder twister connect neptune engine eatshit mustangs woody1 shogun
+septembe pooh jimbo russian sabine voyeur 2525 363636 camel germany giant qqqq nudist bone sleepy
+tequila fighter obiwan makaveli vacation walnut 1974 ladybug cantona ccbill satan rusty1 passwor1
+columbia kissme motorola william1 1967 zzzz skater smut matthew1 valley coolio dagger boner bull
+horndog jason1 penguins rescue griffey 8j4ye3uz californ champs qwertyuiop portland colt45 xxxxxxx
+xanadu tacoma carpet gggggg safety palace italia picturs picasso thongs tempest asd123 hairy foxtrot
+nimrod hotboy 343434 1111111 asdfghjkl goose overlord stranger 454545 shaolin sooners socrates
+spiderman peanuts 13131313 andrew1 filthy ohyeah africa intrepid pickles assass fright potato hhhhhh
+kingdom weezer 424242 pepsi1 throat looker puppy butch sweets megadeth analsex nymets ddddddd
+bigballs oakland oooooo qweasd chucky carrot chargers discover dookie condor horny1 sunrise sinner
+jojo megapass martini assfuck ffffff mushroom jamaica 7654321 77777 cccccc gizmodo tractor mypass
+hongkong 1975 blue123 pissing thomas1 redred basketball satan666 dublin bollox kingkong 1971 22222
+272727 sexx bbbb grizzly passat defiant bowler knickers monitor wisdom slappy thor letsgo robert1
+brownie 098765 playtime lightnin atomic goku llllll qwaszx cosmos bosco knights beast slapshot
+assword frosty dumbass mallard dddd 159357 titleist aussie golfing doobie loveit werewolf vipers
+1965 blabla surf sucking tardis thegame legion rebels sarah1 onelove loulou toto blackcat 0007
+tacobell soccer1 jedi method poopie boob breast kittycat belly pikachu thunder1 thankyou celtics
+frogger scoobydo sabbath coltrane budman jackal zzzzz licking gopher geheim lonestar primus pooper
+newpass brasil heather1 husker element moomoo beefcake zzzzzzzz shitty smokin jjjj anthony1 anubis
+backup gorilla fuckface lowrider punkrock traffic delta1 amazon fatass dodgeram dingdong qqqqqqqq
+breasts boots honda1 spidey poker temp johnjohn 147852 asshole1 dogdog tricky crusader syracuse
+spankme speaker meridian amadeus harley1 falcons turkey50 kenwood keyboard ilovesex 1978 shazam
+shalom lickit jimbob roller fatman sandiego magnus cooldude clover mobile plumber texas1 tool topper
+mariners rebel caliente celica oxford osiris orgasm punkin porsche9 tuesday breeze bossman kangaroo
+latinas astros scruffy qwertyu hearts jammer java 1122 goodtime chelsea1 freckles flyboy doodle
+nebraska bootie kicker webmaster vulcan 191919 blueeyes 321321 farside rugby director pussy69 power1
+hershey hermes monopoly birdman blessed blackjac southern peterpan thumbs fuckyou1 rrrrrr a1b2c3d4
+coke bohica elvis1 blacky sentinel snake1 richard1 1234abcd guardian candyman fisting scarlet dildo
+pancho mandingo lucky7 condom munchkin billyboy summer1 sword skiing site sony thong rootbeer
+assassin fffff fitness durango postal achilles kisses warriors plymouth topdog asterix hallo
+cameltoe fuckfuck eeeeee sithlord theking avenger backdoor chevrole trance cosworth houses homers
+eternity kingpin verbatim incubus 1961 blond zaphod shiloh spurs mighty aliens charly dogman omega1
+printer aggies deadhead bitch1 stone55 pineappl thekid rockets camels formula oracle pussey porkchop
+abcde clancy mystic inferno blackdog steve1 alfa grumpy flames puffy proxy valhalla unreal herbie
+engage yyyyyy 010101 pistol celeb gggg portugal a12345 newbie mmmm 1qazxsw2 zorro writer stripper
+sebastia spread links metal 1221 565656 funfun trojans cyber hurrican moneys 1x2zkg8w zeus tomato
+lion atlantic usa123 trans aaaaaaa homerun hyperion kevin1 blacks 44444444 skittles fart gangbang
+fubar sailboat oilers buster1 hithere immortal sticks pilot lexmark jerkoff maryland cheers possum
+cutter muppet swordfish sport sonic peter1 jethro rockon asdfghj pass123 pornos ncc1701a bootys
+buttman bonjour 1960 bears 362436 spartans tinman threesom maxmax 1414 bbbbb camelot chewie gogo
+fusion saint dilligaf nopass hustler hunter1 whitey beast1 yesyes spank smudge pinkfloy patriot
+lespaul hammers formula1 sausage scooter1 orioles oscar1 colombia cramps exotic iguana suckers slave
+topcat lancelot magelan racer crunch british steph 456123 skinny seeking rockhard filter freaks
+sakura pacman poontang newlife homer1 klingon watcher walleye tasty sinatra starship steel starbuck
+poncho amber1 gonzo catherin candle firefly goblin scotch diver usmc huskies kentucky kitkat beckham
+bicycle yourmom studio 33333333 splash jimmy1 12344321 sapphire mailman raiders1 ddddd excalibu
+illini imperial lansing maxx gothic golfball facial front242 macdaddy qwer1234 vectra cowboys1
+crazy1 dannyboy aquarius franky ffff sassy pppp pppppppp prodigy noodle eatpussy vortex wanking
+billy1 siemens phillies groups chevy1 cccc gggggggg doughboy dracula nurses loco lollipop utopia
+chrono cooler nevada wibble summit 1225 capone fugazi panda qazwsxed puppies triton 9876 nnnnnn
+momoney iforgot wolfie studly hamburg 81fukkc 741852 catman china gagging scott1 oregon qweqwe
+crazybab daniel1 cutlass holes mothers music1 walrus 1957 bigtime xtreme simba ssss rookie bathing
+rotten maestro turbo1 99999 butthole hhhh yoda shania phish thecat rightnow baddog greatone gateway1
+abstr napster brian1 bogart hitler wildfire jackson1 1981 beaner yoyo 0.0.0.000 super1 select
+snuggles slutty phoenix1 technics toon raven1 rayray 123789 1066 albion greens gesperrt brucelee
+hehehe kelly1 mojo 1998 bikini woofwoof yyyy strap sites central f**k nyjets punisher username
+vanilla twisted bunghole viagra veritas pony titts labtec jenny1 masterbate mayhem redbull govols
+gremlin 505050 gmoney rovers diamond1 trident abnormal deskjet cuddles bristol milano vh5150 jarhead
+1982 bigbird bizkit sixers slider star69 starfish penetration tommy1 john316 caligula flicks films
+railroad cosmo cthulhu br0d3r bearbear swedish spawn patrick1 reds anarchy groove fuckher oooo
+airbus cobra1 clips delete duster kitty1 mouse1 monkeys jazzman 1919 262626 swinging stroke stocks
+sting pippen labrador jordan1 justdoit meatball females vector cooter defender nike bubbas bonkers
+kahuna wildman 4121 sirius static piercing terror teenage leelee microsof mechanic robotech rated
+chaser salsero macross quantum tsunami daddy1 cruise newpass6 nudes hellyeah 1959 zaq12wsx striker
+spice spectrum smegma thumb jjjjjjjj mellow cancun cartoon sabres samiam oranges oklahoma lust
+denali nude noodles brest hooter mmmmmmmm warthog blueblue zappa wolverine sniffing jjjjj calico
+freee rover pooter closeup bonsai emily1 keystone iiii 1955 yzerman theboss tolkien megaman rasta
+bbbbbbbb hal9000 goofy gringo gofish gizmo1 samsam scuba onlyme tttttttt corrado clown clapton bulls
+jayhawk wwww sharky seeker ssssssss pillow thesims lighter lkjhgf melissa1 marcius2 guiness gymnast
+casey1 goalie godsmack lolo rangers1 poppy clemson clipper deeznuts holly1 eeee kingston yosemite
+sucked sex123 sexy69 pic's tommyboy masterbating gretzky happyday frisco orchid orange1 manchest
+aberdeen ne1469 boxing korn intercourse 161616 1985 ziggy supersta stoney amature babyboy bcfields
+goliath hack hardrock frodo scout scrappy qazqaz tracker active craving commando cohiba cyclone
+bubba69 katie1 mpegs vsegda irish1 sexy1 smelly squerting lions jokers jojojo meathead ashley1
+groucho cheetah champ firefox gandalf1 packer love69 tyler1 typhoon tundra bobby1 kenworth village
+volley wolf359 0420 000007 swimmer skydive smokes peugeot pompey legolas redhot rodman redalert
+grapes 4runner carrera floppy ou8122 quattro cloud9 davids nofear busty homemade mmmmm whisper
+vermont webmaste wives insertion jayjay philips topher temptress midget ripken havefun canon
+celebrity ghetto ragnarok usnavy conover cruiser dalshe nicole1 buzzard hottest kingfish misfit
+milfnew warlord wassup bigsexy blackhaw zippy tights kungfu labia meatloaf area51 batman1 bananas
+636363 ggggg paradox queens adults aikido cigars hoosier eeyore moose1 warez interacial streaming
+313131 pertinant pool6123 mayday animated banker baddest gordon24 ccccc fantasies aisan deadman
+homepage ejaculation whocares iscool jamesbon 1956 1pussy womam sweden skidoo spock sssss pepper1
+pinhead micron allsop amsterda gunnar 666999 february fletch george1 sapper sasha1 luckydog lover1
+magick popopo ultima cypress businessbabe brandon1 vulva vvvv jabroni bigbear yummy 010203 searay
+secret1 sinbad sexxxx soleil software piccolo thirteen leopard legacy memorex redwing rasputin
+134679 anfield greenbay catcat feather scanner pa55word contortionist danzig daisy1 hores exodus
+iiiiii 1001 subway snapple sneakers sonyfuck picks poodle test1234 llll junebug marker mellon
+ronaldo roadkill amanda1 asdfjkl beaches great1 cheerleaers doitnow ozzy boxster brighton housewifes
+kkkk mnbvcx moocow vides 1717 bigmoney blonds 1000 storys stereo 4545 420247 seductive sexygirl
+lesbean justin1 124578 cabbage canadian gangbanged dodge1 dimas malaka puss probes coolman nacked
+hotpussy erotica kool implants intruder bigass zenith woohoo womans tango pisces laguna maxell
+andyod22 barcelon chainsaw chickens flash1 orgasms magicman profit pusyy pothead coconut chuckie
+clevelan builder budweise hotshot horizon experienced mondeo wifes 1962 stumpy smiths slacker
+pitchers passwords laptop allmine alliance bbbbbbb asscock halflife 88888 chacha saratoga sandy1
+doogie qwert40 transexual close-up ib6ub9 volvo jacob1 iiiii beastie sunnyday stoned sonics starfire
+snapon pictuers pepe testing1 tiberius lisalisa lesbain litle retard ripple austin1 badgirl golfgolf
+flounder royals dragoon dickie passwor majestic poppop trailers nokia bobobo br549 minime mikemike
+whitesox 1954 3232 353535 seamus solo sluttey pictere titten lback 1024 goodluck fingerig gallaries
+goat passme oasis lockerroom logan1 rainman treasure custom cyclops nipper bucket homepage- hhhhh
+momsuck indain 2345 beerbeer bimmer stunner 456456 tootsie testerer reefer 1012 harcore gollum
+545454 chico caveman fordf150 fishes gaymen saleen doodoo pa55w0rd presto qqqqq cigar bogey helloo
+dutch kamikaze wasser vietnam visa japanees 0123 swords slapper peach masterbaiting redwood 1005
+ametuer chiks fucing sadie1 panasoni mamas rambo unknown absolut dallas1 housewife keywest kipper
+18436572 1515 zxczxc 303030 shaman terrapin masturbation mick redfish 1492 angus goirish hardcock
+forfun galary freeporn duchess olivier lotus pornographic ramses purdue traveler crave brando enter1
+killme moneyman welder windsor wifey indon yyyyy taylor1 4417 picher pickup thumbnils johnboy jets
+ameteur amateurs apollo13 hambone goldwing 5050 sally1 doghouse padres pounding quest truelove
+underdog trader climber bolitas hohoho beanie beretta wrestlin stroker sexyman jewels johannes mets
+rhino bdsm balloons grils happy123 flamingo route66 devo outkast paintbal magpie llllllll twilight
+critter cupcake nickel bullseye knickerless videoes binladen xerxes slim slinky pinky thanatos
+meister menace retired albatros balloon goten 5551212 getsdown donuts nwo4life tttt comet deer
+dddddddd deeznutz nasty1 nonono enterprise eeeee misfit99 milkman vvvvvv 1818 blueboy bigbutt tech
+toolman juggalo jetski barefoot 50spanks gobears scandinavian cubbies nitram kings bilbo yumyum
+zzzzzzz stylus 321654 shannon1 server squash starman steeler phrases techniques laser 135790 athens
+cbr600 chemical fester gangsta fucku2 droopy objects passwd lllll manchester vedder clit chunky
+darkman buckshot buddah boobed henti winter1 bigmike beta zidane talon slave1 pissoff thegreat lexus
+matador readers armani goldstar 5656 fmale fuking fucku ggggggg sauron diggler pacers looser pounded
+premier triangle cosmic depeche norway helmet mustard misty1 jagger 3x7pxr silver1 snowboar
+penetrating photoes lesbens lindros roadking rockford 1357 143143 asasas goodboy 898989 chicago1
+ferrari1 galeries godfathe gawker gargoyle gangster rubble rrrr onetime pussyman pooppoop trapper
+cinder newcastl boricua bunny1 boxer hotred hockey1 edward1 moscow mortgage bigtit snoopdog joshua1
+july 1230 assholes frisky sanity divine dharma lucky13 akira butterfly hotbox hootie howdy earthlink
+kiteboy westwood 1988 blackbir biggles wrench wrestle slippery pheonix penny1 pianoman thedude jenn
+jonjon jones1 roadrunn arrow azzer seahawks diehard dotcom tunafish chivas cinnamon clouds deluxe
+northern boobie momomo modles volume 23232323 bluedog wwwwwww zerocool yousuck pluto limewire joung
+awnyce gonavy haha films+pic+galeries girsl fuckthis girfriend uncencored a123456 chrisbln combat
+cygnus cupoi netscape hhhhhhhh eagles1 elite knockers 1958 tazmania shonuf pharmacy thedog midway
+arsenal1 anaconda australi gromit gotohell 787878 66666 carmex2 camber gator1 ginger1 fuzzy seadoo
+lovesex rancid uuuuuu 911911 bulldog1 heater monalisa mmmmmmm whiteout virtual jamie1 japanes
+james007 2727 2469 blam bitchass zephyr stiffy sweet1 southpar spectre tigger1 tekken lakota
+lionking jjjjjjj megatron 1369 hawaiian gymnastic golfer1 gunners 7779311 515151 sanfran optimus
+panther1 love1 maggie1 pudding aaron1 delphi niceass bounce house1 killer1 momo musashi jammin 2003
+234567 wp2003wp submit sssssss spikes sleeper passwort kume meme medusa mantis reebok 1017 artemis
+harry1 cafc91 fettish oceans oooooooo mango ppppp trainer uuuu 909090 death1 bullfrog hokies
+holyshit eeeeeee jasmine1 &amp &amp; spinner jockey babyblue gooner 474747 cheeks pass1234 parola
+okokok poseidon 989898 crusher cubswin nnnn kotaku mittens whatsup vvvvv iomega insertions bengals
+biit yellow1 012345 spike1 sowhat pitures pecker theend hayabusa hawkeyes florian qaz123 usarmy
+twinkle chuckles hounddog hover hothot europa kenshin kojak mikey1 water1 196969 wraith zebra wwwww
+33333 simon1 spider1 snuffy philippe thunderb teddy1 marino13 maria1 redline renault aloha handyman
+cerberus gamecock gobucks freesex duffman ooooo nuggets magician longbow preacher porno1 chrysler
+contains dalejr navy buffy1 hedgehog hoosiers honey1 hott heyhey dutchess everest wareagle ihateyou
+sunflowe 3434 senators shag spoon sonoma stalker poochie terminal terefon maradona 1007 142536
+alibaba america1 bartman astro goth chicken1 cheater ghost1 passpass oral r2d2c3po civic cicero
+myxworld kkkkk missouri wishbone infiniti 1a2b3c 1qwerty wonderboy shojou sparky1 smeghead poiuy
+titanium lantern jelly 1213 bayern basset gsxr750 cattle fishing1 fullmoon gilles dima obelix popo
+prissy ramrod bummer hotone dynasty entry konyor missy1 282828 xyz123 426hemi 404040 seinfeld
+pingpong lazarus marine1 12345a beamer babyface greece gustav 7007 ccccccc faggot foxy gladiato
+duckie dogfood packers1 longjohn radical tuna clarinet danny1 novell bonbon kashmir kiki mortimer
+modelsne moondog vladimir insert 1953 zxc123 supreme 3131 sexxx softail poipoi pong mars martin1
+rogue avalanch audia4 55bgates cccccccc came11 figaro dogboy dnsadm dipshit paradigm othello
+operator tripod chopin coucou cocksuck borussia heritage hiziad homerj mullet whisky 4242 speedo
+starcraf skylar spaceman piggy tiger2 legos jezebel joker1 mazda 727272 chester1 rrrrrrrr dundee
+lumber ppppppp tranny aaliyah admiral comics delight buttfuck homeboy eternal kilroy violin wingman
+walmart bigblue blaze beemer beowulf bigfish yyyyyyy woodie yeahbaby 0123456 tbone syzygy starter
+linda1 merlot mexican 11235813 banner bangbang badman barfly grease charles1 ffffffff doberman
+dogshit overkill coolguy claymore demo nomore hhhhhhh hondas iamgod enterme electron eastside
+minimoni mybaby wildbill wildcard ipswich 200000 bearcat zigzag yyyyyyyy sweetnes 369369 skyler
+skywalker pigeon tipper asdf123 alphabet asdzxc babybaby banane guyver graphics chinook florida1
+flexible fuckinside ursitesux tototo adam12 christma chrome buddie bombers hippie misfits 292929
+woofer wwwwwwww stubby sheep sparta stang spud sporty pinball just4fun maxxxx rebecca1 fffffff
+freeway garion rrrrr sancho outback maggot puddin 987456 hoops mydick 19691969 bigcat shiner
+silverad templar lamer juicy mike1 maximum 1223 10101010 arrows alucard haggis cheech safari dog123
+orion1 paloma qwerasdf presiden vegitto 969696 adonis cookie1 newyork1 buddyboy hellos heineken
+eraser moritz millwall visual jaybird 1983 beautifu zodiac steven1 sinister slammer smashing slick1
+sponge teddybea ticklish jonny 1211 aptiva applepie bailey1 guitar1 canyon gagged fuckme1 digital1
+dinosaur 98765 90210 clowns cubs deejay nigga naruto boxcar icehouse hotties electra widget 1986
+2004 bluefish bingo1 ***** stratus sultan storm1 44444 4200 sentnece sexyboy sigma smokie spam pippo
+temppass manman 1022 bacchus aztnm axio bamboo hakr gregor hahahaha 5678 camero1 dolphin1 paddle
+magnet qwert1 pyon porsche1 tripper noway burrito bozo highheel hookem eddie1 entropy kkkkkkkk
+kkkkkkk illinois 1945 1951 24680 21212121 100000 stonecold taco subzero sexxxy skolko skyhawk spurs1
+sputnik testpass jiggaman 1224 hannah1 525252 4ever carbon scorpio1 rt6ytere madison1 loki coolness
+coldbeer citadel monarch morgan1 washingt 1997 bella1 yaya superb taxman studman 3636 pizzas
+tiffany1 lassie larry1 joseph1 mephisto reptile razor 1013 hammer1 gypsy grande camper chippy cat123
+chimera fiesta glock domain dieter dragonba onetwo nygiants password2 quartz prowler prophet towers
+ultra cocker corleone dakota1 cumm nnnnnnn boxers heynow iceberg kittykat wasabi vikings1 beerman
+splinter snoopy1 pipeline mickey1 mermaid micro meowmeow redbird baura chevys caravan frogman diving
+dogger draven drifter oatmeal paris1 longdong quant4307s rachel1 vegitta cobras corsair dadada
+mylife bowwow hotrats eastwood moonligh modena illusion iiiiiii jayhawks swingers shocker shrimp
+sexgod squall poiu tigers1 toejam tickler julie1 jimbo1 jefferso michael2 rodeo robot 1023 annie1
+bball happy2 charter flasher falcon1 fiction fastball gadget scrabble diaper dirtbike oliver1 paco
+macman poopy popper postman ttttttt acura cowboy1 conan daewoo nemrac58 nnnnn nextel bobdylan eureka
+kimmie kcj9wx5n killbill musica volkswag wage windmill wert vintage iloveyou1 itsme zippo 311311
+starligh smokey1 snappy soulmate plasma krusty just4me marius rebel1 1123 audi fick goaway rusty2
+dogbone doofus ooooooo oblivion mankind mahler lllllll pumper puck pulsar valkyrie tupac compass
+concorde cougars delaware niceguy nocturne bob123 boating bronze herewego hewlett houhou earnhard
+eeeeeeee mingus mobydick venture verizon imation 1950 1948 1949 223344 bigbig wowwow sissy spiker
+snooker sluggo player1 jsbach jumbo medic reddevil reckless 123456a 1125 1031 astra gumby 757575
+585858 chillin fuck1 radiohea upyours trek coolcool classics choochoo nikki1 nitro boytoy excite
+kirsty wingnut wireless icu812 1master beatle bigblock wolfen summer99 sugar1 tartar sexysexy senna
+sexman soprano platypus pixies telephon laura1 laurent rimmer 1020 12qwaszx hamish halifax fishhead
+forum dododo doit paramedi lonesome mandy1 uuuuu uranus ttttt bruce1 helper hopeful eduard dusty1
+kathy1 moonbeam muscles monster1 monkeybo windsurf vvvvvvv vivid install 1947 187187 1941 1952
+susan1 31415926 sinned sexxy smoothie snowflak playstat playa playboy1 toaster jerry1 marie1 mason1
+merlin1 roger1 roadster 112358 1121 andrea1 bacardi hardware 789789 5555555 captain1 fergus sascha
+rrrrrrr dome onion lololo qqqqqqq undertak uuuuuuuu uuuuuuu cobain cindy1 coors descent nimbus nomad
+nanook norwich bombay broker hookup kiwi winners jackpot 1a2b3c4d 1776 beardog bighead bird33 0987
+spooge pelican peepee titan thedoors jeremy1 altima baba hardone 5454 catwoman finance farmboy
+farscape genesis1 salomon loser1 r2d2 pumpkins chriss cumcum ninjas ninja1 killers miller1 islander
+jamesbond intel 19841984 2626 bizzare blue12 biker yoyoma sushi shitface spanker steffi sphinx
+please1 paulie pistons tiburon maxwell1 mdogg rockies armstron alejandr arctic banger audio asimov
+753951 4you chilly care1839 flyfish fantasia freefall sandrine oreo ohshit macbeth madcat loveya
+qwerqwer colnago chocha cobalt crystal1 dabears nevets nineinch broncos1 epsilon kestrel winston1
+warrior1 iiiiiiii iloveyou2 1616 woowoo sloppy specialk tinkerbe jellybea reader redsox1 1215 1112
+arcadia baggio 555666 cayman cbr900rr gabriell glennwei sausages disco pass1 lovebug macmac puffin
+vanguard trinitro airwolf aaa111 cocaine cisco datsun bricks bumper eldorado kidrock wizard1
+whiskers wildwood istheman 25802580 bigones woodland wolfpac strawber 3030 sheba1 sixpack peace1
+physics tigger2 toad megan1 meow ringo amsterdam 717171 686868 5424 canuck football1 footjob fulham
+seagull orgy lobo mancity vancouve vauxhall acidburn derf myspace1 boozer buttercu hola minemine
+munch 1dragon biology bestbuy bigpoppa blackout blowfish bmw325 bigbob stream talisman tazz sundevil
+3333333 skate shutup shanghai spencer1 slowhand pinky1 tootie thecrow jubilee jingle matrix1 manowar
+messiah resident redbaron romans andromed athlon beach1 badgers guitars harald harddick gotribe 6996
+7grout 5wr2i7h8 635241 chase1 fallout fiddle fenris francesc fortuna fairlane felix1 gasman fucks
+sahara sassy1 dogpound dogbert divx1 manila pornporn quasar venom 987987 access1 clippers daman
+crusty nathan1 nnnnnnnn bruno1 budapest kittens kerouac mother1 waldo1 whistler whatwhat wanderer
+idontkno 1942 1946 bigdawg bigpimp zaqwsx 414141 3000gt 434343 serpent smurf pasword thisisit john1
+robotics redeye rebelz 1011 alatam asians bama banzai harvest 575757 5329 fatty fender1 flower2
+funky sambo drummer1 dogcat oedipus osama prozac private1 rampage concord cinema cornwall cleaner
+ciccio clutch corvet07 daemon bruiser boiler hjkl egghead mordor jamess iverson3 bluesman zouzou
+090909 1002 stone1 4040 sexo smith1 sperma sneaky polska thewho terminat krypton lekker johnson1
+johann rockie aspire goodie cheese1 fenway fishon fishin fuckoff1 girls1 doomsday pornking ramones
+rabbits transit aaaaa1 boyz bookworm bongo bunnies buceta highbury henry1 eastern mischief mopar
+ministry vienna wildone bigbooty beavis1 xxxxxx1 yogibear 000001 0815 zulu 420000 sigmar sprout
+stalin lkjhgfds lagnaf rolex redfox referee 123123123 1231 angus1 ballin attila greedy grunt 747474
+carpedie caramel foxylady gatorade futbol frosch saiyan drums donner doggy1 drum doudou nutmeg
+quebec valdepen tosser tuscl comein cola deadpool bremen hotass hotmail1 eskimo eggman koko kieran
+katrin kordell1 komodo mone munich vvvvvvvv jackson5 2222222 bergkamp bigben zanzibar xxx123 sunny1
+373737 slayer1 snoop peachy thecure little1 jennaj rasta69 1114 aries havana gratis calgary checkers
+flanker salope dirty1 draco dogface luv2epus rainbow6 qwerty123 umpire turnip vbnm tucson troll
+codered commande neon nico nightwin boomer1 bushido hotmail0 enternow keepout karen1 mnbv viewsoni
+volcom wizards 1995 berkeley woodstoc tarpon shinobi starstar phat toolbox julien johnny1 joebob
+riders reflex 120676 1235 angelus anthrax atlas grandam harlem hawaii50 655321 cabron challeng
+callisto firewall firefire flyer flower1 gambler frodo1 sam123 scania dingo papito passmast ou8123
+randy1 twiggy travis1 treetop addict admin1 963852 aceace cirrus bobdole bonjovi bootsy boater
+elway7 kenny1 moonshin montag wayne1 white1 jazzy jakejake 1994 1991 2828 bluejays belmont sensei
+southpark peeper pharao pigpen tomahawk teensex leedsutd jeepster jimjim josephin melons matthias
+robocop 1003 1027 antelope azsxdc gordo hazard granada 8989 7894 ceasar cabernet cheshire chelle
+candy1 fergie fidelio giorgio fuckhead dominion qawsed trucking chloe1 daddyo nostromo boyboy
+booster bucky honolulu esquire dynamite mollydog windows1 waffle wealth vincent1 jabber jaguars
+javelin irishman idefix bigdog1 blue42 blanked blue32 biteme1 bearcats yessir sylveste sunfire tbird
+stryker 3ip76k2 sevens pilgrim tenchi titman leeds lithium linkin marijuan mariner markie midnite
+reddwarf 1129 123asd 12312312 allstar albany asdf12 aspen hardball goldfing 7734 49ers carnage
+callum carlos1 fitter fandango gofast gamma fucmy69 scrapper dogwood django magneto premium 9999999
+abc1234 newyear bookie bounty brown1 bologna elway killjoy klondike mouser wayer impreza insomnia
+24682468 2580 24242424 billbill bellaco blues1 blunts teaser sf49ers shovel solitude spikey pimpdadd
+timeout toffee lefty johndoe johndeer mega manolo ratman robin1 1124 1210 1028 1226 babylove
+barbados gramma 646464 carpente chaos1 fishbone fireblad frogs screamer scuba1 ducks doggies dicky
+obsidian rams tottenham aikman comanche corolla cumslut cyborg boston1 houdini helmut elvisp keksa12
+monty1 wetter watford wiseguy 1989 1987 20202020 biatch beezer bigguns blueball bitchy wyoming
+yankees2 wrestler stupid1 sealteam sidekick simple1 smackdow sporting spiral smeller plato tophat
+test2 toomuch jello junkie maxim maxime meadow remingto roofer 124038 1018 1269 1227 123457 arkansas
+aramis beaker barcelona baltimor googoo goochi 852456 4711 catcher champ1 fortress fishfish firefigh
+geezer rsalinas samuel1 saigon scooby1 dick1 doom dontknow magpies manfred vader1 universa tulips
+mygirl bowtie holycow honeys enforcer waterboy 1992 23skidoo bimbo blue11 birddog zildjian 030303
+stinker stoppedby sexybabe speakers slugger spotty smoke1 polopolo perfect1 torpedo lakeside jimmys
+junior1 masamune 1214 april1 grinch 767676 5252 cherries chipmunk cezer121 carnival capecod finder
+fearless goats funstuff gideon savior seabee sandro schalke salasana disney1 duckman pancake
+pantera1 malice love123 qwert123 tracer creation cwoui nascar24 hookers erection ericsson edthom
+kokoko kokomo mooses inter 1michael 1993 19781978 25252525 shibby shamus skibum sheepdog sex69
+spliff slipper spoons spanner snowbird toriamos temp123 tennesse lakers1 jomama mazdarx7 recon
+revolver 1025 1101 barney1 babycake gotham gravity hallowee 616161 515000 caca cannabis chilli fdsa
+getout fuck69 gators1 sable rumble dolemite dork duffer dodgers1 onions logger lookout magic32 poon
+twat coventry citroen civicsi cocksucker coochie compaq1 nancy1 buzzer boulder butkus bungle hogtied
+hotgirls heidi1 eggplant mustang6 monkey12 wapapapa wendy1 volleyba vibrate blink birthday4 xxxxx1
+stephen1 suburban sheeba start1 soccer10 starcraft soccer12 peanut1 plastics penthous peterbil
+tetsuo torino tennis1 termite lemmein lakewood jughead melrose megane redone angela1 goodgirl gonzo1
+golden1 gotyoass 656565 626262 capricor chains calvin1 getmoney gabber runaway salami dungeon
+dudedude opus paragon panhead pasadena opendoor odyssey magellan printing prince1 trustme nono
+buffet hound kajak killkill moto winner1 vixen whiteboy versace voyager1 indy jackjack bigal beech
+biggun blake1 blue99 big1 synergy success1 336699 sixty9 shark1 simba1 sebring spongebo spunk
+springs sliver phialpha password9 pizza1 pookey tickling lexingky lawman joe123 mike123 romeo1
+redheads apple123 backbone aviation green123 carlitos byebye cartman1 camden chewy camaross
+favorite6 forumwp ginscoot fruity sabrina1 devil666 doughnut pantie oldone paintball lumina rainbow1
+prosper umbrella ajax 951753 achtung abc12345 compact corndog deerhunt darklord dank nimitz brandy1
+hetfield holein1 hillbill hugetits evolutio kenobi whiplash wg8e3wjf istanbul invis 1996 bigjohn
+bluebell beater benji bluejay xyzzy suckdick taichi stellar shaker semper splurge squeak pearls
+playball pooky titfuck joemama johnny5 marcello maxi rhubarb ratboy reload 1029 1030 1220 bbking
+baritone gryphon 57chevy 494949 celeron fishy gladiator fucker1 roswell dougie dicker diva donjuan
+nympho racers truck1 trample acer cricket1 climax denmark cuervo notnow nittany neutron bosco1 buffa
+breaker hello2 hydro kisskiss kittys montecar modem mississi 20012001 bigdick1 benfica yahoo1
+striper tabasco supra 383838 456654 seneca shuttle penguin1 pathfind testibil thethe jeter2 marma
+mark1 metoo republic rollin redleg redbone redskin 1245 anthony7 altoids barley asswipe bauhaus
+bbbbbb1 gohome harrier golfpro goldeney 818181 6666666 5000 5rxypn cameron1 checker calibra freefree
+faith1 fdm7ed giraffe giggles fringe scamper rrpass1 screwyou dimples pacino ontario passthie oberon
+quest1 postov1000 puppydog puffer qwerty7 tribal adam25 a1234567 collie cleopatr davide namaste
+buffalo1 bonovox bukkake burner bordeaux burly hun999 enters mohawk vgirl jayden 1812 1943 222333
+bigjim bigd zoom wordup ziggy1 yahooo workout young1 xmas zzzzzz1 surfer1 strife sunlight tasha1
+skunk sprinter peaches1 pinetree plum pimping theforce thedon toocool laddie lkjh jupiter1 matty
+redrose 1200 102938 antares austin31 goose1 737373 78945612 789987 6464 calimero caster casper1
+cement chevrolet chessie caddy canucks fellatio f00tball gateway2 gamecube rugby1 scheisse dshade
+dixie1 offshore lucas1 macaroni manga pringles puff trouble1 ussy coolhand colonial colt darthvad
+cygnusx1 natalie1 newark hiking errors elcamino koolaid knight1 murphy1 volcano idunno 2005 2233
+blueberr biguns yamahar1 zapper zorro1 0911 3006 sixsix shopper sextoy snowboard speedway pokey
+playboy2 titi toonarmy lambda joecool juniper max123 mariposa met2002 reggae ricky1 1236 1228 1016
+all4one baberuth asgard 484848 5683 6669 catnip charisma capslock cashmone galant frenchy gizmodo1
+girlies screwy doubled divers dte4uw dragonfl treble twinkie tropical crescent cococo dabomb daffy
+dandfa cyrano nathanie boners helium hellas espresso killa kikimora w4g8at ilikeit iforget 1944
+20002000 birthday1 beatles1 blue1 bigdicks beethove blacklab blazers benny1 woodwork 0069 0101 taffy
+4567 shodan pavlov pinnacle petunia tito teenie lemonade lalakers lebowski lalalala ladyboy jeeper
+joyjoy mercury1 mantle mannn rocknrol riversid 123aaa 11112222 121314 1021 1004 1120 allen1 ambers
+amstel alice1 alleycat allegro ambrosia gspot goodsex hattrick harpoon 878787 8inches 4wwvte
+cassandr charlie123 gatsby generic gareth fuckme2 samm seadog satchmo scxakv santafe dipper
+outoutout madmad london1 qbg26i pussy123 tzpvaw vamp comp cowgirl coldplay dawgs nt5d27 novifarm
+notredam newness mykids bryan1 bouncer hihihi honeybee iceman1 hotlips dynamo kappa kahlua muffy
+mizzou wannabe wednesda whatup waterfal willy1 bear1 billabon youknow yyyyyy1 zachary1 01234567
+070462 zurich superstar stiletto strat 427900 sigmachi shells sexy123 smile1 sophie1 stayout
+somerset playmate pinkfloyd phish1 payday thebear telefon laetitia kswbdu jerky metro revoluti 1216
+1201 1204 1222 1115 archange barry1 handball 676767 chewbacc furball gocubs fullback gman dewalt
+dominiqu diver1 dhip6a olemiss mandrake mangos pretzel pusssy tripleh vagabond clovis dandan
+csfbr5yy deadspin ninguna ncc74656 bootsie bp2002 bourbon bumble heyyou houston1 hemlock hippo
+hornets horseman excess extensa muffin1 virginie werdna idontknow jack1 1bitch 151nxjmt bendover
+bmwbmw zaq123 wxcvbn supernov tahoe shakur sexyone seviyi smart1 speed1 pepito phantom1 playoffs
+terry1 terrier laser1 lite lancia johngalt jenjen midori maserati matteo miami1 riffraff ronald1
+1218 1026 123987 1015 1103 armada architec austria gotmilk cambridg camero flex foreplay getoff
+glacier glotest froggie gerbil rugger sanity72 donna1 orchard oyster palmtree pajero m5wkqf magenta
+luckyone treefrog vantage usmarine tyvugq uptown abacab aaaaaa1 chuck1 darkange cyclones navajo
+bubba123 iawgk2 hrfzlz dylan1 enrico encore eclipse1 mutant mizuno mustang2 video1 viewer weed420
+whales jaguar1 1990 159159 1love bears1 bigtruck bigboss blitz xqgann yeahyeah zeke zardoz stickman
+3825 sentra shiva skipper1 singapor southpaw sonora squid slamdunk slimjim placid photon placebo
+pearl1 test12 therock1 tiger123 leinad legman jeepers joeblow mike23 redcar rhinos rjw7x4 1102
+13576479 112211 gwju3g greywolf 7bgiqk 7878 535353 4snz9g candyass cccccc1 catfight cali fister
+fosters finland frankie1 gizzmo royalty rugrat dodo oemdlg out3xf paddy opennow puppy1 qazwsxedc
+ramjet abraxas cn42qj dancer1 death666 nudity nimda2k buick bobb braves1 henrik hooligan everlast
+karachi mortis monies motocros wally1 willie1 inspiron 1test 2929 bigblack xytfu7 yackwin zaq1xsw2
+yy5rbfsc 100100 0660 tahiti takehana 332211 3535 sedona seawolf skydiver spleen slash spjfet
+special1 slimshad sopranos spock1 penis1 patches1 thierry thething toohot limpone mash4077 matchbox
+masterp maxdog ribbit rockin redhat 1113 14789632 1331 allday aladin andrey amethyst baseball1
+athome goofy1 greenman goofball ha8fyp goodday 778899 charon chappy caracas cardiff capitals canada1
+cajun catter freddy1 favorite2 forme forsaken feelgood gfxqx686 saskia sanjose salsa dilbert1
+dukeduke downhill longhair locutus lockdown malachi mamacita lolipop rainyday pumpkin1 punker
+prospect rambo1 rainbows quake trinity1 trooper1 citation coolcat default deniro d9ungl daddys
+nautica nermal bukowski bubbles1 bogota buds hulk hitachi ender export kikiki kcchiefs kram morticia
+montrose mongo waqw3p wizzard whdbtp whkzyc 154ugeiu 1fuck binky bigred1 blubber becky1 year2005
+wonderfu xrated 0001 tampabay survey tammy1 stuffer 3mpz4r 3000 3some sierra1 shampoo shyshy
+slapnuts standby spartan1 sprocket stanley1 poker1 theshit lavalamp light1 laserjet jediknig jjjjj1
+mazda626 menthol margaux medic1 rhino1 1209 1234321 amigos apricot asdfgh1 hairball hatter grimace
+7xm5rq 6789 cartoons capcom cashflow carrots fanatic format girlie safeway dogfart dondon outsider
+odin opiate lollol love12 mallrats prague primetime21 pugsley r29hqq valleywa airman abcdefg1
+darkone cummer natedogg nineball ndeyl5 natchez newone normandy nicetits buddy123 buddys homely
+husky iceland hr3ytm highlife holla earthlin exeter eatmenow kimkim k2trix kernel money123 moonman
+miles1 mufasa mousey whites warhamme jackass1 2277 20spanks blobby blinky bikers blackjack becca
+blue23 xman wyvern 085tzzqi zxzxzx zsmj2v suede t26gn4 sugars tantra swoosh 4226 4271 321123
+383pdjvl shane1 shelby1 spades smother sparhawk pisser photo1 pebble peavey pavement thistle kronos
+lilbit linux melanie1 marbles redlight 1208 1138 1008 alchemy aolsucks alexalex atticus auditt
+b929ezzh goodyear gubber 863abgsg 7474 797979 464646 543210 4zqauf 4949 ch5nmk carlito chewey
+carebear checkmat cheddar chachi forgetit forlife giants1 getit gerhard galileo g3ujwg ganja rufus1
+rushmore discus dudeman olympus oscars osprey madcow locust loyola mammoth proton rabbit1 ptfe3xxp
+pwxd5x purple1 punkass prophecy uyxnyd tyson1 aircraft access99 abcabc colts civilwar claudia1
+contour dddddd1 cypher dapzu455 daisydog noles hoochie hoser eldiablo kingrich mudvayne motown
+mp8o6d vipergts italiano 2055 2211 bloke blade1 yamato zooropa yqlgr667 050505 zxcvbnm1 zw6syj
+suckcock tango1 swampy 445566 333666 380zliki sexpot sexylady sixtynin sickboy spiffy skylark
+sparkles pintail phreak teller timtim thighs latex letsdoit lkjhg landmark lizzard marlins marauder
+metal1 manu righton 1127 alain alcat amigo basebal1 azertyui azrael hamper gotenks golfgti hawkwind
+h2slca grace1 6chid8 789654 canine casio cazzo cbr900 cabrio calypso capetown feline flathead
+fisherma flipmode fungus g9zns4 giggle gabriel1 fuck123 saffron dogmeat dreamcas dirtydog douche
+dresden dickdick destiny1 pappy oaktree luft4 puta ramada trumpet1 vcradq tulip tracy71 tycoon
+aaaaaaa1 conquest chitown creepers cornhole danman dada density d9ebk7 darth nirvana1 nestle brenda1
+bonanza hotspur hufmqw electro erasure elisabet etvww4 ewyuza eric1 kenken kismet klaatu milamber
+willi isacs155 igor 1million 1letmein x35v8l yogi ywvxpz xngwoj zippy1 020202 **** stonewal sentry
+sexsexsex sonysony smirnoff star12 solace star1 pkxe62 pilot1 pommes paulpaul tical tictac lighthou
+lemans kubrick letmein22 letmesee jys6wz jonesy jjjjjj1 jigga redstorm riley1 14141414 1126 allison1
+badboy1 asthma auggie hardwood gumbo 616913 57np39 56qhxs 4mnveh fatluvr69 fqkw5m fidelity feathers
+fresno godiva gecko gibson1 gogators general1 saxman rowing sammys scotts scout1 sasasa samoht
+dragon69 ducky dragonball driller p3wqaw papillon oneone openit optimist longshot rapier pussy2
+ralphie tuxedo undertow copenhag delldell culinary deltas mytime noname noles1 bucker bopper burnout
+ibilltes hihje863 hitter ekim espana eatme69 elpaso express1 eeeeee1 eatme1 karaoke mustang5
+wellingt willem waterski webcam jasons infinite iloveyou! jakarta belair bigdad beerme yoshi yinyang
+x24ik3 063dyjuy 0000007 ztmfcq stopit stooges symow8 strato 2hot4u skins shakes sex1 snacks softtail
+slimed123 pizzaman tigercat tonton lager lizzy juju john123 jesse1 jingles martian mario1 rootedit
+rochard redwine requiem riverrat 1117 1014 1205 amor amiga alpina atreides banana1 bahamut golfman
+happines 7uftyx 5432 5353 5151 4747 foxfire ffvdj474 foreskin gayboy gggggg1 gameover glitter funny1
+scoobydoo saxophon dingbat digimon omicron panda1 loloxx macintos lululu lollypop racer1 queen1
+qwertzui upnfmc tyrant trout1 9skw5g aceman acls2h aaabbb acapulco aggie comcast cloudy cq2kph
+d6o8pm cybersex davecole darian crumbs davedave dasani mzepab myporn narnia booger1 bravo1 budgie
+btnjey highlander hotel6 humbug ewtosi kristin1 kobe knuckles keith1 katarina muff muschi montana1
+wingchun wiggle whatthe vette1 vols virago intj3a ishmael jachin illmatic 199999 2010 blender
+bigpenis bengal blue1234 zaqxsw xray xxxxxxx1 zebras yanks tadpole stripes 3737 4343 3728 4444444
+368ejhih solar sonne sniffer sonata squirts playstation pktmxr pescator texaco lesbos l8v53x
+jo9k2jw2 jimbeam jimi jupiter2 jurassic marines1 rocket1 14725836 12345679 1219 123098 1233 alessand
+althor arch alpha123 basher barefeet balboa bbbbb1 badabing gopack golfnut gsxr1000 gregory1
+766rglqy 8520 753159 8dihc6 69camaro 666777 cheeba chino cheeky camel1 fishcake flubber gianni
+gnasher23 frisbee fuzzy1 fuzzball save13tx russell1 sandra1 scrotum scumbag sabre samdog dripping
+dragon12 dragster orwell mainland maine qn632o poophead rapper porn4life rapunzel velocity vanessa1
+trueblue vampire1 abacus 902100 crispy chooch d6wnro dabulls dehpye navyseal njqcw4 nownow nigger1
+nightowl nonenone nightmar bustle buddy2 boingo bugman bosshog hybrid hillside hilltop hotlegs
+hzze929b hhhhh1 hellohel evilone edgewise e5pftu eded embalmer excalibur elefant kenzie killah
+kleenex mouses mounta1n motors mutley muffdive vivitron w00t88 iloveit jarjar incest indycar
+17171717 1664 17011701 222777 2663 beelch benben yitbos yyyyy1 zzzzz1 stooge tangerin taztaz
+stewart1 summer69 system1 surveyor stirling 3qvqod 3way 456321 sizzle simhrq sparty ssptx452 sphere
+persian ploppy pn5jvw poobear pianos plaster testme tiff thriller master12 rockey 1229 1217 1478
+1009 anastasi amonra argentin albino azazel grinder 6uldv8 83y6pv 8888888 4tlved 515051 carsten
+flyers88 ffffff1 firehawk firedog flashman ggggg1 godspeed galway giveitup funtimes gohan giveme
+geryfe frenchie sayang rudeboy sandals dougal drag0n dga9la desktop onlyone otter pandas mafia
+luckys lovelife manders qqh92r qcmfd454 radar1 punani ptbdhw turtles undertaker trs8f7 ugejvp abba
+911turbo acdc abcd123 crash1 colony delboy davinci notebook nitrox borabora bonzai brisbane heeled
+hooyah hotgirl i62gbq horse1 hpk2qc epvjb6 mnbvc mommy1 munster wiccan 2369 bettyboo blondy bismark
+beanbag bjhgfi blackice yvtte545 ynot yess zlzfrh wolvie 007bond ****** tailgate tanya1 sxhq65
+stinky1 3234412 3ki42x seville shimmer sienna shitshit skillet sooners1 solaris smartass pedros
+pennywis pfloyd tobydog thetruth letme1n mario66 micky rocky2 rewq reindeer 1128 1207 1104 1432
+aprilia allstate bagels baggies barrage guru 72d5tn 606060 4wcqjn chance1 flange fartman geil gbhcf2
+fussball fuaqz4 gameboy geneviev rotary seahawk saab samadams devlt4 ditto drevil drinker deuce
+dipstick octopus ottawa losangel loverman porky q9umoz rapture pussy4me triplex ue8fpw turbos aaa340
+churchil crazyman cutiepie ddddd1 dejavu cuxldv nbvibt nikon niko nascar1 bubba2 boobear boogers
+bullwink bulldawg horsemen escalade eagle2 dynamic efyreg minnesot mogwai msnxbi mwq6qlzo werder
+verygood voodoo1 iiiiii1 159951 1624 1911a1 2244 bellagio bedlam belkin bill1 xirt2k ?????? susieq
+sundown sukebe swifty 2fast4u sexe shroom seaweed skeeter1 snicker spanky1 spook phaedrus pilots
+peddler thumper1 tiger7 tmjxn151 thematri l2g7k3 letmeinn jeffjeff johnmish mantra mike69 mazda6
+riptide robots 1107 1130 142857 11001001 1134 armored allnight amatuers bartok astral baboon balls1
+bassoon hcleeb happyman granite graywolf golf1 gomets 8vjzus 7890 789123 8uiazp 5757 474jdvff
+551scasi 50cent camaro1 cherry1 chemist firenze fishtank freewill glendale frogfrog ganesh scirocco
+devilman doodles okinawa olympic orpheus ohmygod paisley pallmall lunchbox manhatta mahalo mandarin
+qwqwqw qguvyt pxx3eftp rambler poppy1 turk182 vdlxuc tugboat valiant uwrl7c chris123 cmfnpu decimal
+debbie1 dandy daedalus natasha1 nissan1 nancy123 nevermin napalm newcastle bonghit ibxnsm hhhhhh1
+holger edmonton equinox dvader kimmy knulla mustafa monsoon mistral morgana monica1 mojave monterey
+mrbill vkaxcs victor1 violator vfdhif wilson1 wavpzt wildstar winter99 iqzzt580 imback 1914 19741974
+1monkey 1q2w3e4r5t 2500 2255 bigshow bigbucks blackcoc zoomer wtcacq wobble xmen xjznq5 yesterda
+yhwnqc zzzxxx 393939 2fchbg skinhead skilled shadow12 seaside sinful silicon smk7366 snapshot
+sniper1 soccer11 smutty peepers plokij pdiddy pimpdaddy thrust terran topaz today1 lionhear littlema
+lauren1 lincoln1 lgnu9d juneau methos rogue1 romulus redshift 1202 1469 12locked arizona1 alfarome
+al9agd aol123 altec apollo1 arse baker1 bbb747 axeman astro1 hawthorn goodfell hawks1 gstring hannes
+8543852 868686 4ng62t 554uzpad 5401 567890 5232 catfood fire1 flipflop fffff1 fozzie fluff fzappa
+rustydog scarab satin ruger samsung1 destin diablo2 dreamer1 detectiv doqvq3 drywall paladin1
+papabear offroad panasonic nyyankee luetdi qcfmtz pyf8ah puddles pussyeat ralph1 princeto trivia
+trewq tri5a3 advent 9898 agyvorc clarkie coach1 courier christo chowder cyzkhw davidb dad2ownu
+daredevi de7mdf nazgul booboo1 bonzo butch1 huskers1 hgfdsa hornyman elektra england1 elodie kermit1
+kaboom morten mocha monday1 morgoth weewee weenie vorlon wahoo ilovegod insider jayman 1911 1dallas
+1900 1ranger 201jedlz 2501 1qaz bignuts bigbad beebee billows belize wvj5np wu4etd yamaha1 wrinkle5
+zebra1 yankee1 zoomzoom 09876543 0311 ????? stjabn tainted 3tmnej skooter skelter starlite spice1
+stacey1 smithy pollux peternorth pixie piston poets toons topspin kugm7b legends jeepjeep joystick
+junkmail jojojojo jonboy midland mayfair riches reznor rockrock reboot renee1 roadway rasta220 1411
+1478963 1019 archery andyandy barks bagpuss auckland gooseman hazmat gucci grammy happydog 7kbe9d
+7676 6bjvpe 5lyedn 5858 5291 charlie2 c7lrwu candys chateau ccccc1 cardinals fihdfv fortune12 gocats
+gaelic fwsadn godboy gldmeo fx3tuo fubar1 generals gforce rxmtkp rulz sairam dunhill dogggg ozlq6qwm
+ov3ajy lockout makayla macgyver mallorca prima pvjegu qhxbij prelude1 totoro tusymo trousers tulane
+turtle1 tracy1 aerosmit abbey1 clticic cooper1 comets delpiero cyprus dante1 dave1 nounours nexus6
+nogard norfolk brent1 booyah bootleg bulls23 bulls1 booper heretic icecube hellno hounds honeydew
+hooters1 hoes hevnm4 hugohugo epson evangeli eeeee1 eyphed
+`)
+
+// commonWords are the 20000 most frequent english words of the Wiktionary frequency lists built
+// from the subtitles of US television and movies.
+var commonWords = strings.Fields(`
+you i to the a and that it of me what is in this know i'm for no have my don't just not do be on
+your was we it's with so but all well are he oh about right you're get here out going like yeah if
+her she can up want think that's now go him at how got there one did why see come good they really
+as would look when time will okay back can't mean tell i'll from hey were he's could didn't yes his
+been or something who because some had then say ok take an way us little make need gonna never we're
+too she's i've sure them more over our sorry where what's let thing am maybe down man has uh very by
+there's should anything said much any life even off doing thank give only thought help two talk
+people god still wait into find nothing again things let's doesn't call told great before better
+ever night than away first believe other feel everything work you've fine home after last these day
+keep does put around stop they're i'd guy isn't always listen wanted mr guys huh those big lot
+happened thanks won't trying kind wrong through talking made new being guess hi care bad mom
+remember getting we'll together dad leave place understand wouldn't actually hear baby nice father
+else stay done wasn't their course might mind every enough try hell came someone you'll own family
+whole another house yourself idea ask best must coming old looking woman which years room left knew
+tonight real son hope name same went um hmm happy pretty saw girl sir show friend already saying
+next three job problem minute found world thinking haven't heard honey matter myself couldn't
+exactly having ah probably happen we've hurt boy both while dead gotta alone since excuse start kill
+hard you'd today car ready until without wants hold wanna yet seen deal took once gone called
+morning supposed friends head stuff most used worry second part live truth school face forget true
+business each cause soon knows few telling wife who's use chance run move anyone person bye somebody
+dr heart such miss married point later making meet anyway many phone reason damn lost looks bring
+case turn wish tomorrow kids trust check change end late anymore five least town aren't ha working
+year makes taking means brother play hate ago says beautiful gave fact crazy party sit open afraid
+between important rest fun kid word watch glad everyone days sister minutes everybody bit couple
+whoa either mrs feeling daughter wow gets asked under break promise door set close hand easy
+question tried far walk needs mine though times different killed hospital anybody alright wedding
+shut able die perfect stand comes hit story ya mm waiting dinner against funny husband almost pay
+answer four office eyes news child shouldn't half side yours moment sleep read where's started men
+sounds sonny pick sometimes em bed also date line plan hours lose hands serious behind inside high
+ahead week wonderful fight past cut quite number he'll sick it'll game eat nobody goes along save
+seems finally lives worried upset carly met book brought seem sort safe living children weren't
+leaving front shot loved asking running clear figure hot felt six parents drink absolutely how's
+daddy alive sense meant happens special bet blood ain't kidding lie full meeting dear seeing sound
+fault water ten women buy months hour speak lady jen thinks christmas body order outside hang
+possible worse company mistake ooh handle spend totally giving control here's marriage realize
+president unless sex send needed taken died scared picture talked ass hundred changed completely
+explain playing certainly sign boys relationship loves hair lying choice anywhere future weird luck
+she'll turned known touch kiss crane questions obviously wonder pain calling somewhere throw
+straight cold fast words food none drive feelings they'll worked marry light drop cannot sent city
+dream protect twenty class surprise its sweetheart poor looked mad except gun y'know dance takes
+appreciate especially situation besides pull himself hasn't act worth sheridan amazing top given
+expect rather involved swear piece busy law decided happening movie we'd catch country less perhaps
+step fall watching kept darling dog win air honor personal moving till admit problems murder he'd
+evil definitely feels information honest eye broke missed longer dollars tired evening human
+starting red entire trip club niles suppose calm imagine fair caught blame street sitting favor
+apartment court terrible clean learn works frasier relax million accident wake prove smart message
+missing forgot interested table nbsp become mouth pregnant middle ring careful shall team ride
+figured wear shoot stick follow angry instead write stopped early ran war standing forgive jail
+wearing kinda lunch cristian eight greenlee gotten hoping phoebe thousand ridge paper tough tape
+state count boyfriend proud agree birthday seven they've history share offer hurry feet wondering
+decision building ones finish voice herself would've list mess deserve evidence cute dress
+interesting hotel quiet concerned road staying beat sweetie mention clothes finished fell neither
+mmm fix respect spent prison attention holding calls near surprised bar keeping gift hadn't putting
+dark self owe using ice helping normal aunt lawyer apart certain plans jax girlfriend floor whether
+everything's present earth box cover judge upstairs sake mommy possibly worst station acting accept
+blow strange saved conversation plane mama yesterday lied quick lately stuck report difference rid
+store she'd bag bought doubt listening walking cops deep dangerous buffy sleeping chloe rafe shh
+record lord moved join card crime gentlemen willing window return walked guilty likes fighting
+difficult soul joke favorite uncle promised public bother island seriously cell lead knowing broken
+advice somehow paid losing push helped killing usually earlier boss beginning liked innocent doc
+rules cop learned thirty risk letting speaking officer ridiculous support afternoon born apologize
+seat nervous across song charge patient boat how'd hide detective planning nine huge breakfast
+horrible age awful pleasure driving hanging picked sell quit apparently dying notice congratulations
+chief one's month visit could've c'mon letter decide double sad press forward fool showed smell
+seemed spell memory pictures slow seconds hungry board position hearing roz kitchen ma'am force fly
+during space should've realized experience kick others grab mother's discuss third cat fifty
+responsible fat reading idiot yep suddenly agent destroy bucks track shoes scene peace arms demon
+low livvie consider papers medical incredible witch drunk attorney tells knock ways gives department
+nose skye turns keeps jealous drug sooner cares plenty extra tea won attack ground whose outta
+weekend matters wrote type father's gosh opportunity impossible books waste pretend named jump
+eating proof complete slept career arrest breathe perfectly warm pulled twice easier goin dating
+suit romantic drugs comfortable finds checked fit divorce begin ourselves closer ruin although smile
+laugh treat god's fear what'd guy's otherwise excited mail hiding cost stole pacey noticed fired
+excellent lived bringing pop bottom note sudden bathroom flight honestly sing foot games remind bank
+charges witness finding places tree dare hardly that'll interest steal silly contact teach shop plus
+colonel fresh trial invited roll radio reach heh choose emergency dropped credit obvious cry locked
+loving positive nuts agreed prue goodbye condition guard fuckin grow cake mood dad's total crap
+crying belong lay partner trick pressure ohh arm dressed cup lies bus taste neck south something's
+nurse raise lots carry group whoever drinking they'd breaking file lock wine closed writing spot
+paying study assume asleep man's turning legal viki bedroom shower nikolas camera fill reasons forty
+bigger nope breath doctors pants level movies gee area folks ugh continue focus wild truly desk
+convince client threw band hurts spending allow grand answers shirt chair allowed rough doin sees
+government ought empty round hat wind shows aware dealing pack meaning hurting ship subject guest
+mom's pal match arrested salem confused surgery expecting deacon unfortunately goddamn lab passed
+bottle beyond whenever pool opinion held common starts jerk secrets falling played necessary barely
+dancing health tests copy cousin planned dry ahem twelve simply tess skin often fifteen speech names
+issue orders nah final results code believed complicated umm research nowhere escape biggest
+restaurant grateful usual burn address within someplace screw everywhere train film regret goodness
+mistakes details responsibility suspect corner hero dumb terrific further gas whoo hole memories
+o'clock following ended nobody's teeth ruined split airport bite stenbeck older liar showing project
+cards desperate themselves pathetic damage spoke quickly scare marah afford vote settle mentioned
+due stayed rule checking tie hired upon heads concern blew natural alcazar champagne connection
+tickets happiness form saving kissing hated personally suggest prepared build leg onto leaves
+downstairs ticket it'd taught loose holy staff sea duty convinced throwing defense kissed legs
+according loud practice saturday babies army where'd warning miracle carrying flying blind ugly
+shopping hates someone's sight bride coat account states clearly celebrate brilliant wanting add
+forrester lips custody center screwed buying size toast thoughts student stories however
+professional reality birth lexie attitude advantage grandfather sami sold opened grandma beg changes
+someday grade roof brothers signed ahh marrying powerful grown grandmother fake opening expected
+eventually must've ideas exciting covered familiar bomb bout television harmony color heavy schedule
+records capable practically including correct clue forgotten immediately appointment social nature
+deserves threat bloody lonely ordered shame local jacket hook destroyed scary investigation above
+invite shooting port lesson criminal growing caused victim professor followed funeral nothing's
+considering burning strength loss view gia sisters everybody's several pushed written somebody's
+shock pushing heat chocolate greatest miserable corinthos nightmare brings zander character became
+famous enemy crash chances sending recognize healthy boring feed engaged percent headed lines
+treated purpose knife rights drag san fan badly hire paint pardon built behavior closet warn
+gorgeous milk survive forced operation offered ends dump rent remembered lieutenant trade
+thanksgiving rain revenge physical available program prefer baby's spare pray disappeared aside
+statement sometime meat fantastic breathing laughing itself tip stood market affair ours depends
+main protecting jury national brave large jack's interview fingers murdered explanation process
+picking based style pieces blah assistant stronger aah pie handsome unbelievable anytime nearly
+shake everyone's oakdale cars wherever serve pulling points medicine facts waited lousy
+circumstances stage disappointed weak trusted license nothin community trash understanding slip cab
+sounded awake friendship stomach weapon threatened mystery official regular river vegas understood
+contract race basically switch frankly issues cheap lifetime deny painting ear clock weight garbage
+why'd tear ears dig selling setting indeed changing singing tiny particular draw decent avoid messed
+filled touched score people's disappear exact pills kicked harm recently fortune pretending raised
+insurance fancy drove cared belongs nights shape lorelai base lift stock sonny's fashion timing
+guarantee chest bridge woke source patients theory original burned watched heading selfish oil
+drinks failed period doll committed elevator freeze noise exist science pair edge wasting sat
+ceremony pig uncomfortable peg guns staring files bike weather name's mostly stress permission
+arrived thrown possibility example borrow release ate notes hoo library property negative fabulous
+event doors screaming xander term what're meal fellow apology anger honeymoon wet bail parking non
+protection fixed families chinese campaign map wash stolen sensitive stealing chose lets comfort
+worrying whom pocket mateo bleeding students shoulder ignore fourth neighborhood fbi talent tied
+garage dies demons dumped witches training rude crack model bothering radar grew remain soft
+meantime gimme connected kinds cast sky likely fate buried hug brother's concentrate prom messages
+east unit intend crew ashamed somethin manage guilt weapons terms interrupt guts tongue distance
+conference treatment shoe basement sentence purse glasses cabin universe towards repeat mirror wound
+travers tall reaction odd engagement therapy letters emotional runs magazine jeez decisions soup
+daughter's thrilled society managed stake chef moves extremely entirely moments expensive counting
+shots kidnapped square son's cleaning shift plate impressed smells trapped male tour aidan knocked
+charming attractive argue puts whip language embarrassed settled package laid animals hitting
+disease bust stairs alarm pure nail nerve incredibly walks dirt stamp sister's becoming terribly
+friendly easily damned jobs suffering disgusting stopping deliver riding helps federal disaster bars
+dna crossed rate create trap claim california talks eggs effect chick threatening spoken introduce
+confession embarrassing bags impression gate year's reputation attacked among knowledge presents inn
+europe chat suffer argument talkin crowd homework fought coincidence cancel accepted rip pride solve
+hopefully pounds pine mate illegal generous streets con separate outfit maid bath punch mayor
+freaked begging recall enjoying bug woman's prepare parts wheel signal direction defend signs
+painful yourselves rat maris amount that'd suspicious flat cooking button warned sixty pity parties
+crisis coach row yelling leads awhile pen confidence offering falls image farm pleased panic hers
+gettin role refuse determined hell's grandpa progress testify passing military choices uhh gym cruel
+wings bodies mental gentleman coma cutting proteus guests girl's expert benefit faces cases led
+jumped toilet secretary sneak mix firm halloween agreement privacy dates anniversary smoking reminds
+pot created twins swing successful season scream considered solid options commitment senior ill
+else's crush ambulance wallet discovered officially til rise reached eleven option laundry former
+assure stays skip fail accused wide challenge popular learning discussion clinic plant exchange
+betrayed bro sticking university members lower bored mansion soda sheriff suite handled busted
+senator load happier younger studying romance procedure ocean section sec commit assignment suicide
+minds swim ending bat yell llanview league chasing seats proper command believes humor hopes fifth
+winning solution leader theresa's sale lawyers nor material latest highly escaped audience parent
+tricks insist dropping cheer medication higher flesh district routine century shared sandwich handed
+false beating appear warrant family's awfully odds article treating thin suggesting fever sweat
+silent specific clever sweater request prize mall tries mile fully estate union sharing assuming
+judgment goodnight divorced despite surely steps jet confess math listened comin answered vulnerable
+bless dreaming rooms chip zero potential pissed nate kills tears knees chill carly's brains agency
+harvard degree unusual wife's joint packed dreamed cure covering newspaper lookin coast grave egg
+direct cheating breaks quarter mixed locker husband's gifts awkward toy thursday rare policy kid's
+joking competition classes assumed reasonable dozen curse quartermaine millions dessert rolling
+detail alien served delicious closing vampires released ancient wore value tail secure salad
+murderer hits toward spit screen offense dust conscience bread answering admitted lame invitation
+grief smiling path stands bowl pregnancy hollywood prisoner delivery guards virus shrink influence
+freezing concert wreck partners massimo chain birds life's wire technically presence blown anxious
+cave version holidays cleared wishes survived caring candles bound related charm yup pulse jumping
+jokes frame boom vice performance occasion silence opera nonsense frightened downtown americans
+slipped dimera blowing world's session relationships kidnapping actual spin civil roxy packing
+education blaming wrap obsessed fruit torture personality location effort daddy's commander trees
+there'll owner fairy per other's necessarily county contest seventy print motel fallen directly
+underwear grams exhausted believing particularly freaking carefully trace touching messing committee
+recovery intention consequences belt sacrifice courage officers enjoyed lack attracted appears bay
+yard returned remove nut carried today's testimony intense granted violence heal defending attempt
+unfair relieved political loyal approach slowly plays normally buzz alcohol actor surprises
+psychiatrist pre plain attic who'd uniform terrified sons pet cleaned zach threaten teaching mum
+motion fella enemies desert collection incident failure satisfied imagination hooked headache
+forgetting counselor andie acted opposite highest equipment badge italian visiting naturally frozen
+commissioner sakes labor appropriate trunk armed thousands received dunno costume temporary sixteen
+impressive zone kicking junk hon grabbed unlike understands describe clients owns affect witnesses
+starving instincts happily discussing deserved strangers leading intelligence host authority
+surveillance cow commercial admire questioning fund dragged barn object deeply amp wrapped wasted
+tense route reports hoped fellas election roommate mortal fascinating chosen stops shown arranged
+abandoned sides delivered becomes arrangements agenda began theater series literally propose honesty
+underneath forces services sauce promises lecture eighty torn shocked relief explained counter
+circle victims transfer response channel identity differently campus spy ninety interests guide deck
+biological pheebs ease creep will's waitress skills telephone ripped raising scratch rings prints
+wave thee arguing figures ephram asks reception pin oops diner annoying agents taggert goal mass
+ability sergeant julian's international gig blast basic tradition towel earned rub president's habit
+customers creature bermuda actions snap react prime paranoid wha handling eaten therapist comment
+charged tax sink reporter beats priority interrupting gain fed warehouse shy pattern loyalty
+inspector events pleasant media excuses threats permanent guessing financial demand assault tend
+praying motive los unconscious trained museum tracks range nap mysterious unhappy tone switched
+rappaport award sookie neighbor loaded gut childhood causing swore piss hundreds balance background
+toss mob misery valentine's thief squeeze lobby hah goa'uld geez exercise ego drama al's forth
+facing booked boo songs sandburg eighteen d'you bury perform everyday digging creepy compared
+wondered trail liver hmmm drawn device magical journey fits discussed supply moral helpful attached
+timmy's searching flew depressed aisle underground pro daughters cris amen vows proposal pit
+neighbors darn cents arrange annulment uses useless squad represent product joined afterwards
+adventure resist protected net fourteen celebrating piano inch flag debt violent tag sand gum dammit
+teal'c hip celebration below reminded claims tonight's replace phones paperwork emotions typical
+stubborn stable sheridan's pound papa lap designed current bum tension tank suffered steady provide
+overnight meanwhile chips beef wins suits boxes salt cassadine collect boy's tragedy therefore spoil
+realm profile degrees wipe surgeon stretch stepped nephew neat limo confident anti perspective
+designer climb title suggested punishment finest ethan's springfield occurred hint furniture blanket
+twist surrounded surface proceed lip fries worries refused niece gloves soap signature disappoint
+crawl convicted zoo result pages lit flip counsel doubts crimes accusing when's shaking remembering
+phase hallway halfway bothered useful makeup madam gather concerns cia cameras blackmail symptoms
+rope ordinary imagined concept cigarette supportive memorial explosion yay woo trauma ouch leo's
+furious cheat avoiding whew thick oooh boarding approve urgent shhh misunderstanding minister drawer
+sin phony joining jam interfere governor chapter catching bargain tragic schools respond punish
+penthouse hop thou remains rach ohhh insult doctor's bugs beside begged absolute strictly stefano
+socks senses ups sneaking yah serving reward polite checks tale physically instructions fooled blows
+tabby internal bitter adorable y'all tested suggestion string jewelry debate com alike pitch fax
+distracted shelter lessons foreign average twin friend's damnit constable circus audition tune
+shoulders mud mask helpless feeding explains dated robbery objection behave valuable shadows
+courtroom confusing tub talented struck smarter mistaken italy customer bizarre scaring punk
+motherfucker holds focused alert activity vecchio reverend highway foolish compliment bastards
+attend scheme aid worker wheelchair protective poetry gentle script reverse picnic knee intended
+construction cage wednesday voices toes stink scares pour effects cheated tower time's slide ruining
+recent jewish filling exit cottage corporate upside supplies proves parked instance grounds diary
+complaining basis wounded thing's politics confessed pipe merely massage data chop budget brief
+spill prayer costs betray begins arrangement waiter scam rats fraud flu brush anyone's adopted
+tables sympathy pill pee web seventeen landed expression entrance employee drawing cap bracelet
+principal pays jen's fairly facility dru deeper arrive unique tracking spite shed recommend oughta
+nanny naive menu grades diet corn authorities separated roses patch dime devastated description tap
+subtle include citizen bullets beans ric pile las executive confirm toe strings parade harbor
+charity's bow borrowed toys straighten steak status remote premonition poem planted honored youth
+specifically meetings exam convenient traveling matches laying insisted apply units technology dish
+aitoro sis kindly grandson donor temper teenager strategy richard's proven iron denial couples
+backwards tent swell noon happiest episode drives thinkin spirits potion fence affairs acts
+whatsoever rehearsal proved overheard nuclear lemme hostage faced constant bench tryin taxi shove
+sets moron limits impress entitled needle limit lad intelligent instant forms disagree stinks rianna
+recover paul's losers groom gesture developed constantly blocks bartender tunnel suspects sealed
+removed legally illness hears dresses aye vehicle thy teachers sheet receive psychic night's denied
+knocking judging bible behalf accidentally waking ton superior seek rumor natalie's manners homeless
+hollow desperately critical theme tapes referring personnel item genoa gear majesty fans exposed
+cried tons spells producer launch instinct belief quote motorcycle convincing appeal advance greater
+fashioned aids accomplished mommy's grip bump upsetting soldiers scheduled production needing
+invisible forgiveness feds complex compare bothers tooth territory sacred mon jessica's inviting
+inner earn compromise cocktail tramp temperature signing landing jabot intimate dignity dealt souls
+informed gods entertainment dressing cigarettes blessing billion alistair upper manner lightning
+leak heaven's fond corky alternative seduce players operate modern liquor fingerprints enchantment
+butters stuffed stavros rome filed emotionally division conditions uhm transplant tips passes oxygen
+nicely lunatic hid drill designs complain announcement visitors unfortunate slap prayers plug
+organization opens oath o'neill mutual graduate confirmed broad yacht spa remembers fried
+extraordinary bait appearance abuse warton sworn stare safely reunion plot burst aha might've
+experiment dive commission cells aboard returning independent expose environment buddies trusting
+smaller mountains booze sweep sore scudder properly parole manhattan effective ditch decides
+canceled bra antonio's speaks spanish reaching glow foundation women's wears thirsty skull ringing
+dorm dining bend unexpected systems sob pancakes michael's harsh flattered existence ahhh troubles
+proposed fights favourite eats driven computers rage luke's causes border undercover spoiled sloane
+shine rug identify destroying deputy deliberately conspiracy clothing thoughtful similar sandwiches
+plates nails miracles investment fridge drank contrary beloved allergic washed stalking solved sack
+misses hope's forgiven erica's cuz bent approval practical organized maciver involve industry fuel
+dragging cooked possession pointing foul editor dull beneath ages horror heels grass faking deaf
+stunt portrait painted jealousy hopeless fears cuts conclusion volunteer scenario satellite necklace
+men's crashed chapel accuse restraining jason's humans homicide helicopter formal firing shortly
+safer devoted auction videotape tore stores reservations pops appetite anybody's wounds vanquish
+symbol prevent patrol ironic flow fathers excitement anyhow tearing sends sam's rape laughed
+function core charmed whatever's sub lucy's dealer cooperate bachelor accomplish wakes struggle
+spotted sorts reservation ashes yards votes tastes supposedly loft intentions integrity wished
+towels suspected slightly qualified log investigating inappropriate immediate companies backed pan
+owned lipstick lawn compassion cafeteria belonged affected scarf precisely obsession management
+loses lighten jake's infection granddaughter explode chemistry balcony this'll storage spying
+publicity exists employees depend cue cracked conscious aww ally ace accounts absurd vicious tools
+strongly rap invented forbid directions defendant bare announce alcazar's screwing salesman robbed
+leap lakeview insanity injury genetic document why's reveal religious possibilities kidnap gown
+entering chairs wishing statue setup serial punished dramatic dismissed criminals seventh regrets
+raped quarters produce lamp dentist anyways anonymous added semester risks regarding owes magazines
+machines lungs explaining delicate child's tricked oldest liv eager doomed cafe bureau adoption
+traditional surrender stab sickness scum loop independence generation floating envelope entered
+combination chamber worn vault sorel pretended potatoes plea photograph payback misunderstood kiddo
+healing cascade capeside application stabbed remarkable cabinet brat wrestling sixth scale privilege
+passionate nerves lawsuit kidney disturbed crossing cozy associate tire shirts required posted oven
+ordering mill journal gallery delay clubs risky nest monsters honorable grounded favour culture
+closest brenda's breakdown attempted tony's placed conflict bald actress abandon steam scar pole duh
+collar worthless standards resources photographs introduced injured graduation enormous disturbing
+disturb distract deals conclusions vodka situations require mid measure dishes crawling congress
+children's briefcase wiped whistle sits roast rented pigs greek flirting existed deposit damaged
+bottles vanessa's types topic riot overreacting minimum logical impact hostile embarrass casual
+beacon amusing altar values recognized maintain goods covers claus battery survival skirt shave
+prisoners porch med ghosts favors drops dizzy chili begun beaten advise transferred strikes rehab
+raw photographer peaceful leery heavens fortunately fooling expectations draft citizens weakness ski
+ships ranch practicing musical movement individual homes executed examine documents cranes column
+bribe task species sail rum resort prescription operating hush fragile forensics expense drugged
+differences cows conduct comic bells avenue attacking assigned visitor suitcase sources sorta scan
+payment motor mini manticore inspired insecure imagining hardest clerk yea wrist what'll tube
+starters silk pump pale nicer haul flies demands boot arts african there'd limited how're elders
+connections quietly pulls idiots factor erase denying attacks ankle amnesia accepting ooo heartbeat
+gal devane confront backing phrase operations minus meets legitimate hurricane fixing communication
+boats auto arrogant supper studies slightest sins sayin recipe pier paternity humiliating genuine
+catholic snack rational pointed minded guessed grace's display dip brooke's advanced weddings unh
+tumor teams reported humiliated destruction copies closely bid aspirin academy wig throughout spray
+occur logic eyed equal drowning contacts shakespeare ritual perfume kelly's hiring hating generally
+error elected docks creatures visions thanking thankful sock replaced nineteen nick's fork comedy
+analysis yale throws teenagers studied stressed slice rolls requires plead ladder kicks detectives
+assured alison's widow tomorrow's tissue tellin shallow responsibilities repay rejected permanently
+girlfriends deadly comforting ceiling bonus verdict maintenance jar insensitive factory aim triple
+spilled respected recovered messy interrupted halliwell car's bleed benefits wardrobe takin
+significant objective murders doo chart backs workers waves underestimate ties registered multiple
+justify harmless frustrated fold enzo convention communicate bugging attraction arson whack salary
+rumors residence party's obligation medium liking laura's development develop dearest david's
+danny's congratulate vengeance switzerland severe rack puzzle puerto guidance fires courtesy caller
+blamed tops repair quiz prep now's involves headquarters curiosity codes circles barbecue troops
+sunnydale spinning scores pursue psychotic cough claimed accusations shares resent money's laughs
+gathered freshman envy drown cristian's bartlet asses sofa scientist poster islands highness dock
+apologies welfare victor's theirs stat stall spots somewhat ryan's realizes psych fools finishing
+album wee understandable unable treats theatre succeed stir relaxed makin inches gratitude faithful
+bin accent zip witter wandering regardless que locate inevitable gretel deed crushed controlling
+taxes smelled settlement robe poet opposed marked greenlee's gossip gambling determine cuba
+cosmetics cent accidents surprising stiff sincere shield rushed resume reporting refrigerator
+reference preparing nightmares mijo ignoring hunch fog fireworks drowned crown cooperation brass
+accurate whispering sophisticated religion luggage investigate hike explore emotion creek crashing
+contacted complications ceo acid shining rolled righteous reconsider inspiration goody geek
+frightening festival ethics creeps courthouse camping assistance affection vow smythe protest lodge
+haircut forcing essay chairman baked apologized vibe respects receipt mami includes hats exclusive
+destructive define defeat adore adopt voted tracked signals shorts rory's reminding relative ninth
+floors dough creations continues cancelled cabot barrel adam's snuck slight reporters rear pressing
+novel newspapers magnificent madame lazy glorious fiancee candidate brick bits australia activities
+visitation scholarship sane previous kindness ivy's shoulda rescued mattress maria's lounge lifted
+label importantly glove enterprises driver's disappointment condo cemetery beings admitting yelled
+waving screech satisfaction requested reads plants nun nailed described dedicated certificate
+centuries annual worm tick resting primary polish marvelous fuss funds defensive cortlandt compete
+chased provided pockets luckily lilith filing depression conversations consideration consciousness
+worlds innocence indicate grandmother's forehead bam appeared aggressive trailer slam retirement
+quitting pry person's narrow levels kay's inform encourage dug delighted daylight danced currently
+confidential billy's ben's aunts washing vic tossed spectra rick's permit marrow lined implying
+hatred grill efforts corpse clues sober relatives promotion offended morgue larger infected humanity
+eww emily's electricity electrical distraction cart broadcast wired violation suspended promising
+harassment glue gathering d'angelo cursed controlled calendar brutal assets warlocks wagon
+unpleasant proving priorities observation mustn't lease grows flame domestic disappearance
+depressing thrill sitter ribs offers naw flush exception earrings deadline corporal collapsed update
+snapped smack orleans offices melt figuring delusional coulda burnt actors trips tender sperm
+specialist scientific realise pork popped planes kev interrogation institution included esteem
+communications choosing choir undo pres prayed plague manipulate lifestyle insulting honour
+detention delightful coffeehouse chess betrayal apologizing adjust wrecked wont whipped rides
+reminder psychological principle monsieur injuries fame faint confusion christ's bon bake nearest
+korea industries execution distress definition creating correctly complaint blocked trophy tortured
+structure rot risking pointless household heir handing eighth dumping cups chloe's alibi absence
+vital tokyo thus struggling shiny risked refer mummy mint joey's involvement hose hobby fortunate
+fleischman fitting curtain counseling addition wit transport technical rode puppet opportunities
+modeling memo irresponsible humiliation hiya freakin fez felony choke blackmailing appreciated
+tabloid suspicion recovering rally psychology pledge panicked nursery louder jeans investigator
+identified homecoming helena's height graduated frustrating fabric distant buys busting buff wax
+sleeve products philosophy irony hospitals dope declare autopsy workin torch substitute scandal
+prick limb leaf lady's hysterical growth goddamnit fetch dimension day's crowded clip climbing
+bonding approved yeh woah ultimately trusts returns negotiate millennium majority lethal length iced
+deeds bore babysitter questioned outrageous medal kiriakis insulted grudge established driveway
+deserted definite capture beep wires suggestions searched owed originally nickname lighting lend
+drunken demanding costanza conviction characters bumped weigh touches tempted shout resolve relate
+poisoned pip phoebe's pete's occasionally molly's meals maker invitations haunted fur footage
+depending bogus autograph affects tolerate stepping spontaneous sleeps probation presentation
+performed manny identical fist cycle associates aaron's streak spectacular sector lasted isaac's
+increase hostages heroin havin habits encouraging cult consult burgers boyfriends bailed baggage
+association wealthy watches versus troubled torturing teasing sweetest stations sip shawn's rag
+qualities postpone pad overwhelmed malkovich impulse hut follows classy charging barbara's angel's
+amazed scenes rising revealed representing policeman offensive mug hypocrite humiliate hideous
+finals experiences d'ya courts costumes captured bluffing betting bein bedtime alcoholic vegetable
+tray suspicions spreading splendid shouting roots pressed nooo liza's jew intent grieving gladly
+fling eliminate disorder courtney's cereal arrives aaah yum technique statements sonofabitch servant
+roads republican paralyzed orb lotta locks guaranteed european dummy discipline despise dental
+corporation carries briefing bluff batteries atmosphere whatta tux sounding servants rifle presume
+kevin's handwriting goals gin fainted elements dried cape allright allowing acknowledge whacked
+toxic skating reliable quicker penalty panel overwhelming nearby lining importance harassing fatal
+endless elsewhere dolls convict bold ballet whatcha unlikely spiritual shutting separation recording
+positively overcome goddam failing essence dose diagnosis cured claiming bully airline ahold
+yearbook various tempting shelf rig pursuit prosecution pouring possessed partnership miguel's
+lindsay's countries wonders tsk thorough spine rath psychiatric meaningless latte jammed ignored
+fiance exposure exhibit evidently duties contempt compromised capacity cans weekends urge theft
+suing shipment scissors responding refuses proposition noises matching located ink hormones hiv hail
+grandchildren godfather gently establish crane's contracts compound buffy's worldwide smashed
+sexually sentimental senor scored patient's nicest marketing manipulated jaw intern handcuffs framed
+errands entertaining discovery crib carriage barge awards attending ambassador videos tab spends
+slipping seated rubbing rely reject recommendation reckon ratings headaches float embrace corners
+whining sweating sole skipped restore receiving population pep mountie motives mama's listens korean
+heroes heart's cristobel controls cheerleader balsom unnecessary stunning shipping scent santa's
+quartermaines praise pose montega luxury loosen kyle's keri's info hum haunt gracious git forgiving
+fleet errand emperor cakes blames abortion worship theories strict sketch shifts plotting physician
+perimeter passage pals mere mattered lonigan longest jews interference eyewitness enthusiasm
+encounter diapers craig's artists strongest shaken serves punched projects portal outer nazi hal's
+colleagues catches bearing backyard academic winds terrorists sabotage pea organs needy mentor
+measures listed lex cuff civilization caribbean articles writes woof who'll viki's valid rarely
+rabbi prank performing obnoxious mates improve hereby gabby faked cellar whitelighter void substance
+strangle sour skill senate purchase native muffins interfering hoh gina's demonic colored clearing
+civilian buildings boutique barrington trading terrace smoked seed righty relations quack published
+preliminary petey pact outstanding opinions knot ketchup items examined disappearing cordy coin
+circuit assist administration walt uptight ticking terrifying tease tabitha's syd swamp secretly
+rejection reflection realizing rays pennsylvania partly mentally marone jurisdiction frasier's
+doubted deception crucial congressman cheesy arrival visited supporting stalling scouts scoop ribbon
+reserve raid notion income immune grandma's expects edition destined constitution classroom bets
+appreciation appointed accomplice whitney's wander shoved sewer scroll retire paintings lasts
+fugitive freezer discount cranky crank clearance bodyguard anxiety accountant abby's whoops
+volunteered terrorist tales talents stinking resolved remotely protocol livvie's garlic decency cord
+beds asa's areas altogether uniforms tremendous restaurants rank profession popping philadelphia
+outa observe lung largest hangs feelin experts enforcement encouraged economy dudes donation
+disguise diane's curb continued competitive businessman bites antique advertising ads toothbrush
+retreat represents realistic profits predict nora's lid landlord hourglass hesitate frank's focusing
+equally consolation boyfriend's babbling aged troy's tipped stranded smartest sabrina's rhythm
+replacement repeating puke psst paycheck overreacted macho leadership kendall's juvenile john's
+images grocery freshen disposal cuffs consent caffeine arguments agrees abigail's vanished
+unfinished tobacco tin syndrome ripping pinch missiles isolated flattering expenses dinners cos
+colleague ciao buh belthazor belle's attorneys amber's woulda whereabouts wars waitin visits truce
+tripped tee tasted stu steer ruling poisoning nursing manipulative immature husbands heel granddad
+delivering deaths condoms automatically anchor trashed tournament throne raining prices pasta
+needles leaning leaders judges ideal detector coolest casting batch approximately appointments
+almighty achieve vegetables sum spark ruled revolution principles perfection pains momma mole
+interviews initiative hairs getaway employment den cracking counted compliments behold verge tougher
+timer tapped taped stakes specialty snooping shoots semi rendezvous pentagon passenger leverage
+jeopardize janitor grandparents forbidden examination communist clueless cities bidding arriving
+adding ungrateful unacceptable tutor soviet shaped serum scuse savings pub pajamas mouths modest
+methods lure irrational depth cries classified bombs beautifully arresting approaching vessel
+variety traitor sympathetic smug smash rental prostitute premonitions mild jumps inventory ing
+improved grandfather's developing darlin committing caleb's banging asap amendment worms violated
+vent traumatic traced tow swiss sweaty shaft recommended overboard literature insight healed grasp
+fluid experiencing crappy crab connecticut chunk chandler's awww applied witnessed traveled stain
+shack reacted pronounce presented poured occupied moms marriages jabez invested handful gob gag
+flipped fireplace expertise embarrassment disappears concussion bruises brakes anything's week's
+twisting tide swept summon splitting settling scientists reschedule regard purposes ohio notch
+mike's improvement hooray grabbing extend exquisite disrespect complaints colin's armor voting
+thornhart sustained straw slapped simon's shipped shattered ruthless reva's refill recorded payroll
+numb mourning marijuana manly jerry's involving hunk entertain earthquake drift dreadful doorstep
+confirmation chops bridget's appreciates announced vague tires stressful stem stashed stash sensed
+preoccupied predictable noticing madly halls gunshot embassy dozens dinner's confuse cleaners
+charade chalk cappuccino breed bouquet amulet addiction who've warming unlock transition satisfy
+sacrificed relaxing lone input hampshire girlfriend's elaborate concerning completed channels
+category cal blocking blend blankets america's addicted yuck voters professionals positions monica's
+mode initial hunger hamburger greeting greet gravy gram dreamt dice declared collecting caution
+brady's backpack agreeing writers whale tribe taller supervisor sacrifices radiation poo phew
+outcome ounce missile meter likewise irrelevant gran felon feature favorites farther fade
+experiments erased easiest disk convenience conceived compassionate challenged cane blair's
+backstage agony adores veins tweek thieves surgical strangely stetson recital proposing productive
+meaningful marching immunity hassle goddamned frighten directors dearly comments closure cease
+ambition wisconsin unstable sweetness salvage richer refusing raging pumping pressuring petition
+mortals lowlife jus intimidated intentionally inspire forgave eric's devotion despicable deciding
+dash comfy breach bo's bark alternate aaaah switching swallowed stove slot screamed scars russians
+relevant poof pipes persons pawn losses legit invest generations farewell experimental difficulty
+curtains civilized championship caviar boost token tends temporarily superstition supernatural sunk
+sadness reduced recorder psyched presidential owners motivated microwave lands karen's hallelujah
+gap fraternity engines dryer cocoa chewing additional acceptable unbelievably survivor smiled
+smelling sized simpler sentenced respectable remarks registration premises passengers organ
+occasional khasinau indication gutter grabs goo fulfill flashlight ellenor courses blooded blessings
+beware beth's bands advised water's uhhh turf swings slips shocking resistance privately olivia's
+mirrors lyrics locking instrument historical heartless fras decades comparison childish cassie's
+cardiac admission utterly tuscany ticked suspension stunned statesville sadly resolution reserved
+purely opponent noted lowest kiddin jerks hitch flirt fare extension establishment equals dismiss
+delayed decade christening casket c'mere breakup brad's biting antibiotics accusation abducted
+witchcraft whoever's traded thread spelling so's school's runnin remaining punching protein printed
+paramedics newest murdering mine's masks lawndale intact ins initials heights grampa democracy
+deceased colleen's choking charms careless bushes buns bummed accounting travels taylor's shred
+saves saddle rethink regards references precinct persuade patterns meds manipulating llanfair leash
+kenny's housing hearted guarantees flown feast extent educated disgrace determination deposition
+coverage corridor burial bookstore boil abilities vitals veil trespassing teaches sidewalk sensible
+punishing overtime optimistic occasions obsessing oak notify mornin jeopardy jaffa injection
+hilarious distinct directed desires curve confide challenging cautious alter yada wilderness
+where're vindictive vial tomb teeny subjects stroll sittin scrub rebuild rachel's posters parallel
+ordeal orbit o'brien nuns max's jennifer's intimacy inheritance fails exploded donate distracting
+despair democratic defended crackers commercials bryant's ammunition wildwind virtue thoroughly
+tails spicy sketches sights sheer shaving seize scarecrow refreshing prosecute possess platter
+phillip's napkin misplaced merchandise membership loony jinx heroic frankenstein fag efficient
+devil's corps clan boundaries attract ambitious virtually syrup solitary resignation resemblance
+reacting pursuing premature pod liz's lavery journalist honors harvey's genes flashes erm
+contribution company's client's cheque charts cargo awright acquainted wrapping untie salute ruins
+resign realised priceless partying myth moonlight lightly lifting kasnoff insisting glowing
+generator flowing explosives employer cutie confronted clause buts breakthrough blouse ballistic
+antidote analyze allowance adjourned vet unto understatement tucked touchy toll subconscious
+sequence screws sarge roommates reaches rambaldi programs offend nerd knives kin irresistible
+inherited incapable hostility goddammit fuse frat equation curfew centered blackmailed allows
+alleged walkin transmission text starve sleigh sarcastic recess rebound procedures pinned parlor
+outfits livin issued institute industrial heartache head's haired fundraiser doorman documentary
+discreet dilucca detect cracks cracker considerate climbed catering author apophis zoey vacuum urine
+tunnels todd's tanks strung stitches sordid sark referred protector portion phoned pets paths mat
+lengths kindergarten hostess flaw flavor discharge deveraux consumed confidentiality automatic
+amongst viktor victim's tactics straightened specials spaghetti soil prettier powerless por poems
+playin playground parker's paranoia nsa mainly mac's joe's instantly havoc exaggerating evaluation
+eavesdropping doughnuts diversion deepest cutest companion comb bela behaving avoided anyplace agh
+accessory zap whereas translate stuffing speeding slime polls personalities payments musician
+marital lurking lottery journalism interior imaginary hog guinea greetings game's fairwinds ethical
+equipped environmental elegant elbow customs cuban credibility credentials consistent collapse cloth
+claws chopped challenges bridal boards bedside babysitting authorized assumption ant youngest witty
+vast unforgivable underworld tempt tabs succeeded sophomore selfless secrecy runway restless
+programming professionally okey movin metaphor messes meltdown lecter incoming hence gasoline gained
+funding episodes diefenbaker contain comedian collected cam buckle assembly ancestors admired
+adjustment acceptance weekly warmth throats seduced ridge's reform rebecca's queer poll parenting
+noses luckiest graveyard gifted footsteps dimeras cynical assassination wedded voyage volunteers
+verbal unpredictable tuned stoop slides sinking show's rio rigged regulations region promoted
+plumbing lingerie layer katie's hankey greed everwood essential elope dresser departure dat dances
+coup chauffeur bulletin bugged bouncing website tubes temptation supported strangest sorel's slammed
+selection sarcasm rib primitive platform pending partial packages orderly obsessive nevertheless nbc
+murderers motto meteor inconvenience glimpse froze fiber execute etc ensure drivers dispute damages
+crop courageous consulate closes bosses bees amends wuss wolfram wacky unemployed traces town's
+testifying tendency syringe symphony stew startled sorrow sleazy shaky screams rsquo remark poke
+phone's philip's nutty nobel mentioning mend mayor's iowa inspiring impulsive housekeeper germans
+formed foam fingernails economic divide conditioning baking whine thug starved sedative rose's
+reversed publishing programmed picket paged nowadays newman's mines margo's invasion homosexual homo
+hips forgets flipping flea flatter dwell dumpster consultant choo banking assignments apartments
+ants affecting advisor vile unreasonable tossing thanked steals souvenir screening scratched rep
+psychopath proportion outs operative obstruction obey neutral lump lily's insists ian's harass gloat
+flights filth extended electronic edgy diseases didn coroner confessing cologne cedar bruise
+betraying bailing attempting appealing adebisi wrath wandered waist vain traps transportation
+stepfather publicly presidents poking obligated marshal lexie's instructed heavenly halt employed
+diplomatic dilemma crazed contagious coaster cheering carved bundle approached appearances vomit
+thingy stadium speeches robbing reflect raft qualify pumped pillows peep pageant packs neo neglected
+m'kay loneliness liberal intrude indicates helluva gardener freely forresters err drooling
+continuing betcha alan's addressed acquired vase supermarket squat spitting spaces slaves rhyme
+relieve receipts racket purchased preserve pictured pause overdue officials nod motivation
+morgendorffer lucky's lacking kidnapper introduction insect hunters horns feminine eyeballs dumps
+disc disappointing difficulties crock convertible context claw clamp canned cambias bathtub avanya
+artery weep warmer vendetta tenth suspense summoned stuff's spiders sings reiber raving pushy
+produced poverty postponed ohhhh noooo mold mice laughter incompetent hugging groceries frequency
+fastest drip differ daphne's communicating body's beliefs bats bases auntie adios wraps willingly
+weirdest voila timmih thinner swelling swat steroids sensitivity scrape rehearse quarterback organic
+matched ledge justified insults increased heavily hateful handles feared doorway decorations colour
+chatting buyer buckaroo bedrooms batting askin ammo tutoring subpoena span scratching requests
+privileges pager mart kel intriguing idiotic hotels grape enlighten dum door's dixie's demonstrate
+dairy corrupt combined brunch bridesmaid barking architect applause alongside ale acquaintance yuh
+wretched superficial sufficient sued soak smoothly sensing restraint quo pow posing pleading
+pittsburgh peru payoff participate organize oprah nemo morals loans loaf lists laboratory jumpy
+intervention ignorant herbal hangin germs generosity flashing country's convent clumsy chocolates
+captive bianca's behaved apologise vanity trials stumbled republicans represented recognition
+preview poisonous perjury parental onboard mugged minding linen learns knots interviewing inmates
+ingredients humour grind greasy goons estimate elementary edmund's drastic database coop comparing
+cocky clearer bruised brag bind axe asset apparent ann's worthwhile whoop wedding's vanquishing
+tabloids survivors stenbeck's sprung spotlight shops sentencing sentences revealing reduce ram
+racist provoke piper's pining overly oui ops mop louisiana locket king's jab imply impatient
+hovering hotter fest endure dots doren dim diagnosed debts cultures crawled contained condemned
+chained brit breaths adds weirdo warmed wand utah troubling tok'ra stripped strapped soaked skipping
+sharon's scrambled rattle profound musta mocking mnh misunderstand merit loading linked limousine
+kacl investors interviewed hustle forensic foods enthusiastic duct drawers devastating democrats
+conquer concentration comeback clarify chores cheerleaders cheaper charlie's callin blushing barging
+abused yoga wrecking wits waffles virginity vibes uninvited unfaithful underwater tribute strangled
+state's scheming ropes responded residents rescuing rave priests postcard overseas orientation
+ongoing o'reily newly neil's morphine lotion limitations lesser lectures lads kidneys judgement jog
+itch intellectual installed infant indefinitely grenade glamorous genetically freud faculty
+engineering doh discretion delusions declaration crate competent commonwealth catalog bakery
+attempts asylum argh applying ahhhh yesterday's wedge wager unfit tripping treatments torment
+superhero stirring spinal sorority seminar scenery repairs rabble pneumonia perks owl override ooooh
+moo mija manslaughter mailed love's lime lettuce intimidate instructor guarded grieve grad globe
+frustration extensive exploring exercises eve's doorbell devices deal's dam cultural ctu credits
+commerce chinatown chemicals baltimore authentic arraignment annulled altered allergies wanta verify
+vegetarian tunes tourist tighter telegram suitable stalk specimen spared solving shoo satisfying
+saddam requesting publisher pens overprotective obstacles notified negro nasedo judged jill's
+identification grandchild genuinely founded flushed fluids floss escaping ditched demon's decorated
+criticism cramp corny contribute connecting bunk bombing bitten billions bankrupt yikes wrists
+ultrasound ultimatum thirst spelled sniff scope ross's room's retrieve releasing reassuring pumps
+properties predicted neurotic negotiating needn't multi monitors millionaire microphone mechanical
+lydecker limp incriminating hatchet gracias gordie fills feeds egypt doubting dedication decaf
+dawson's competing cellular biopsy whiz voluntarily visible ventilator unpack unload universal
+tomatoes targets suggests strawberry spooked snitch schillinger sap reassure providing prey
+pressure's persuasive mystical mysteries mri moment's mixing matrimony mary's mails lighthouse
+liability kgb jock headline frankie's factors explosive explanations dispatch detailed curly cupid
+condolences comrade cassadines bulb brittany's bragging awaits assaulted ambush adolescent adjusted
+abort yank whit verse vaguely undermine tying trim swamped stitch stan's stabbing slippers skye's
+sincerely sigh setback secondly rotting rev retail proceedings preparation precaution pox pcpd
+nonetheless melting materials mar liaison hots hooking headlines hag ganz fury felicity fangs
+expelled encouragement earring dreidel draws dory donut dog's dis dictate dependent decorating
+coordinates cocktails bumps blueberry believable backfired backfire apron anticipated adjusting
+activated vous vouch vitamins vista urn uncertain ummm tourists tattoos surrounding sponsor slimy
+singles sibling shhhh restored representative renting reign publish planets peculiar parasite
+paddington noo marries mailbox magically lovebirds listeners knocks kane's informant grain exits elf
+drazen distractions disconnected dinosaurs designing dashwood crooked conveniently contents argued
+wink warped underestimated testified tacky substantial steve's steering staged stability shoving
+seizure reset repeatedly radius pushes pitching pairs opener mornings mississippi matthew's mash
+investigations invent indulge horribly hallucinating festive eyebrows expand enjoys dictionary
+dialogue desperation dealers darkest daph critic consulting cartman's canal boragora belts bagel
+authorization auditions associated ape amy's agitated adventures withdraw wishful wimp vehicles
+vanish unbearable tonic tom's tackle suffice suction slaying singapore safest rosanna's rocking
+relive rates puttin prettiest oval noisy newlyweds nauseous moi misguided mildly midst maps liable
+kristina's judgmental introducing individuals hunted hen givin frequent fisherman fascinated
+elephants dislike diploma deluded decorate crummy contractions carve careers bottled bonded bahamas
+unavailable twenties trustworthy translation traditions surviving surgeons stupidity skies secured
+salvation remorse rafe's princeton preferably pies photography operational nuh northwest nausea
+napkins mule mourn melted mechanism mashed julia's inherit holdings hel greatness golly excused
+edges dumbo drifting delirious damaging cubicle compelled comm colleges cole's chooses checkup
+chad's certified candidates boredom bob's bandages baldwin's bah automobile athletic alarms absorbed
+absent windshield who're whaddya vitamin transparent surprisingly sunglasses starring slit sided
+schemes roar relatively reade quarry prosecutor prognosis probe potentially pitiful persistent
+perception percentage peas oww nosy neighbourhood nagging morons molecular meters masterpiece
+martinis limbo liars jax's irritating inclined hump hoynes haw gauge functions fiasco educational
+eatin donated destination dense cubans continent concentrating commanding colorful clam cider
+brochure behaviour barto bargaining awe artistic welcoming weighing villain vein vanquished striking
+stains sooo smear sire simone's secondary roughly rituals resentment psychologist preferred pint
+pension passive overhear origin orchestra negotiations mounted morality landingham labs kisser
+jackson's icy hoot holling handshake grilled functioning formality elevators edward's depths
+confirms civilians bypass briefly boathouse binding acres accidental westbridge wacko ulterior
+transferring tis thugs tangled stirred stefano's sought snag smallest sling sleaze seeds rumour ripe
+remarried reluctant regularly puddle promote precise popularity pins perceptive miraculous memorable
+maternal lucinda's longing lockup locals librarian job's inspection impressions immoral
+hypothetically guarding gourmet gabe fighters fees features faxed extortion expressed essentially
+downright digest der crosses cranberry city's chorus casualties bygones buzzing burying bikes
+attended allah all's weary viewing viewers transmitter taping takeout sweeping stepmother stating
+stale seating seaborn resigned rating prue's pros pepperoni ownership occurs nicole's newborn merger
+mandatory malcolm's ludicrous jan's injected holden's henry's heating geeks forged faults expressing
+eddie's drue dire dief desi deceiving centre celebrities caterer calmed businesses budge ashley's
+applications ankles vending typing tribbiani there're squared speculation snowing shades sexist
+scudder's scattered sanctuary rewrite regretted regain raises processing picky orphan mural
+misjudged miscarriage memorize marshall's mark's licensed lens leaking launched larry's languages
+judge's jitters invade interruption implied illegally handicapped glitch gittes finer fewer
+engineered distraught dispose dishonest digs dahlia's dads cruelty conducting clinical circling
+champions canceling butterflies belongings barbrady amusement allegations alias aging zombies
+where've unborn tri swearing stables squeezed spaulding's slavery sew sensational revolutionary
+resisting removing radioactive races questionable privileged portofino par owning overlook overhead
+orson oddly nazis musicians interrogate instruments imperative impeccable icu hurtful hors heap
+harley's graduating graders glance endangered disgust devious destruct demonstration creates crazier
+countdown coffee's chump cheeseburger cat's burglar brotherhood berries ballroom assumptions ark
+annoyed allies allergy advantages admirer admirable addresses activate accompany wed victoria's
+valve underpants twit triggered teacher's tack strokes stool starr's sham seasons sculpture scrap
+sailed retarded resourceful remarkably refresh ranks pressured precautions pointy obligations
+nightclub mustache month's minority mind's maui lace isabella's improving iii hunh hubby flare
+fierce farmers dont dokey divided demise demanded dangerously crushing considerable complained
+clinging choked chem cheerleading checkbook cashmere calmly blush believer aspect amazingly alas
+acute a's yak whores what've tuition trey's tolerance toilets tactical tacos stairwell spur spirited
+slower sewing separately rubbed restricted punches protects partially ole nuisance niagara
+motherfuckers mingle mia's kynaston knack kinkle impose hosting harry's gullible grid godmother
+funniest friggin folding financially filming fashions eater dysfunctional drool distinguished
+defence defeated cruising crude criticize corruption contractor conceive clone circulation cedars
+caliber brighter blinded birthdays bio bill's banquet artificial anticipate annoy achievement whim
+whichever volatile veto vested uncle's supports successfully shroud severely rests representation
+quarantine premiere pleases parent's painless pads orphans orphanage offence obliged nip niggers
+negotiation narcotics nag mistletoe meddling manifest lookit loo lilah investigated intrigued
+injustice homicidal hayward's gigantic exposing elves disturbance disastrous depended demented
+correction cooped colby's cheerful buyers brownies beverage basics attorney's atm arvin arcade
+weighs upsets unethical tidy swollen sweaters swap stupidest sensation scalpel rail prototype props
+prescribed pompous poetic ploy paws operates objections mushrooms mulwray monitoring manipulation
+lured lays lasting kung keg jell internship insignificant inmate incentive gandhi fulfilled flooded
+expedition evolution discharged disagreement dine dean's crypt coroner's cornered copied
+confrontation cds catalogue brightest beethoven banned attendant athlete amaze airlines yogurt
+wyndemere wool vocabulary vcr tulsa tags tactic stuffy slug sexuality seniors segment revelation
+respirator pulp prop producing processed pretends polygraph perp pennies ordinarily opposition
+olives necks morally martyr martial lisa's leftovers joints jimmy's irs invaded imported hopping
+homey hints helicopters heed heated heartbroken gulf greatly forge florist firsthand fiend expanding
+emma's defenses crippled cousin's corrected conniving conditioner clears chemo bubbly bladder beeper
+baptism apb answer's anna's angles ache womb wiring wench weaknesses volunteering violating unlocked
+unemployment tummy tibet threshold surrogate submarine subid stray stated startle specifics snob
+slowing sled scoot robbers rightful richest quid qfxmjrie puffs probable pitched pierced pencils
+paralysis nuke managing makeover luncheon lords linksynergy jury's jacuzzi ish interstate hitched
+historic hangover gasp fracture flock firemen drawings disgusted darned coal clams chez cables
+broadcasting brew borrowing banged achieved wildest weirder unauthorized stunts sleeves sixties
+shush shalt senora rises retro quits pupils politicians pegged painfully paging outlet omelet
+observed ned's memorized lawfully jackets interpretation intercept ingredient grownup glued gaining
+fulfilling flee enchanted dvd delusion daring conservative conducted compelling charitable carton
+bronx bridesmaids bribed boiling bathrooms bandage awareness awaiting assign arrogance antiques
+ainsley turkeys travelling trashing tic takeover sync supervision stockings stalked stabilized
+spacecraft slob skates sirs sedated robes reviews respecting rat's psyche prominent prizes
+presumptuous prejudice platoon permitted paragraph mush mum's movements mist missions mints mating
+mantan lorne lord's loads listener legendary itinerary hugs hepatitis heave guesses gender flags
+fading exams examining elizabeth's egyptian dumbest dishwasher dimera's describing deceive cunning
+cripple cove convictions congressional confided compulsive compromising burglary bun bumpy
+brainwashed benes arnie alvy affirmative adrenaline adamant watchin waitresses uncommon treaty
+transgenic toughest toby's surround stormed spree spilling spectacle soaking significance shreds
+sewers severed scarce scamming scalp sami's salem's rewind rehearsing pretentious potions
+possessions planner placing periods overrated obstacle notices nerds meems medieval mcmurphy
+maturity maternity masses maneuver lyin loathe lawyer's irv investigators hep grin gospel gals
+formation fertility facilities exterior epidemic eloping ecstatic ecstasy duly divorcing
+distribution dignan debut costing coaching clubhouse clot clocks classical candid bursting breather
+braces bennett's bending australian attendance arsonist applies adored accepts absorb vacant uuh
+uphold unarmed turd topolsky thrilling thigh terminate tempo sustain spaceship snore sneeze
+smuggling shrine sera scott's salty salon ramp quaint prostitution prof policies patronize patio
+nasa morbid marlo's mamma locations licence kettle joyous invincible interpret insecurities insects
+inquiry infamous impulses illusions holed glen's fragments forrester's exploit economics drivin des
+defy defenseless dedicate cradle cpr coupon countless conjure confined celebrated cardboard booking
+blur bleach ban backseat austin's alternatives afterward accomplishment wordsworth wisely wildlife
+valet vaccine urges unnatural unlucky truths traumatized tit tennessee tasting swears strawberries
+steaks stats skank seducing secretive screwdriver schedules rooting rightfully rattled qualifies
+puppets provides prospects pronto prevented powered posse poorly polling pedestal palms muddy morty
+miniature microscope merci margin lecturing inject incriminate hygiene hospital's grapefruit gazebo
+funnier freight flooding equivalent eliminated elaine's dios deacon's cuter continental container
+cons compensation clap cbs cavity caves capricorn canvas calculations bossy booby bacteria aides
+zende winthrop wider warrants valentines undressed underage truthfully tampered suffers stored
+statute speechless sparkling sod socially sidelines shrek sank roy's raul's railing puberty
+practices pesky parachute outrage outdoors operated openly nominated motions moods lunches litter
+kidnappers itching intuition index imitation icky humility hassling gallons firmly excessive evolved
+employ eligible elections elderly drugstore dosage disrupt directing dipping deranged debating
+cuckoo cremated craziness cooperating compatible circumstantial chimney bonnie's blinking biscuits
+belgium arise analyzed admiring acquire accounted willow's weeping volumes views triad trashy
+transaction tilt soothing slumber slayers skirts siren ship's shindig sentiment sally's rosco
+riddance rewarded quaid purity proceeding pretzels practiced politician polar panicking overall
+occupation naming minimal mckechnie massacre marah's lovin leaked layers isolation intruding
+impersonating ignorance hoop hamburgers gwen's fruits footprints fluke fleas festivities fences
+feisty evacuate emergencies diabetes detained democrat deceived creeping craziest corpses conned
+coincidences charleston bums brussels bounced bodyguards blasted bitterness baloney ashtray
+apocalypse advances zillion watergate wallpaper viable tory's tenants telesave sympathize sweeter
+swam sup startin stages spencer's sodas snowed sleepover signor seein reviewing reunited retainer
+restroom rested replacing repercussions reliving reef reconciliation reconcile recognise prevail
+preaching planting overreact oof omen o'neil numerous noose moustache morning's manicure maids mah
+lorelei's landlady hypothetical hopped homesick hives hesitation herbs hectic heartbreak haunting
+gangs frown fingerprint extract expired exhausting exchanged exceptional everytime encountered
+disregard daytime cooperative constitutional cling chevron chaperone buenos blinding bitty beads
+battling badgering anticipation advocate zander's waterfront upstanding unprofessional unity
+unhealthy undead turmoil truthful toothpaste tippin thoughtless tagataya stretching strategic spun
+shortage shooters sheriff's shady senseless sailors rewarding refuge rapid rah pun propane
+pronounced preposterous pottery portable pigeons pastry overhearing ogre obscene novels negotiable
+mtv morgan's monthly loner leisure leagues jogging jaws itchy insinuating insides induced
+immigration hospitality hormone hilda's hearst grandpa's frequently forthcoming fists fifties
+etiquette endings elevated editing dunk distinction disabled dibs destroys despises desired
+designers deprived dancers dah cuddy crust conductor communists cloak circumstance chewed casserole
+bora bidder bearer assessment artoo applaud appalling amounts admissions withdrawal weights vowed
+virgins vigilante vatican undone trench touchdown throttle thaw tha testosterone tailor symptom
+swoop suited suitcases stomp sticker stakeout spoiling snatched smoochy smitten shameless restraints
+researching renew relay regional refund reclaim rapids raoul rags puzzles purposely punks prosecuted
+plaid pineapple picturing pickin pbs parasites offspring nyah mysteriously multiply mineral
+masculine mascara laps kramer's jukebox interruptions hoax gunfire gays furnace exceptions engraved
+elbows duplicate drapes designated deliberate deli decoy cub cryptic crowds critics coupla convert
+conventional condemn complicate combine colossal clerks clarity cassadine's byes brushed bride's
+banished arrests argon andy's alarmed worships versa uncanny troop treasury transformation
+terminated telescope technicality sydney's sundae stumble stripping shuts separating schmuck saliva
+robber retain remained relentless reconnect recipes rearrange ray's rainy psychiatrists producers
+policemen plunge plugged patched overload ofc obtained obsolete o'malley numbered number's nay moth
+module mkay mindless menus lullaby lotte leavin layout knob killin karinsky irregular invalid hides
+grownups griff flaws flashy flaming fettes evicted epic encoded dread dil degrassi dealings dangers
+cushion console concluded casey's bowel beginnings barged apes announcing amanda's admits abroad
+abide abandoning workshop wonderfully woak warfare wait'll wad violate turkish tim's ter targeted
+susan's suicidal stayin sorted slamming sketchy shoplifting shapes selected sarah's retiring raiser
+quizmaster pursued pupkin profitable prefers politically phenomenon palmer's olympics needless
+nature's mutt motherhood momentarily migraine lizzie's lilo lifts leukemia leftover law's keepin
+idol hinks hellhole h'mm gowns goodies gallon futures friction finale farms extraction entertained
+electronics eighties earth's dmv darker daniel's cum conspiring consequence cheery caps calf cadet
+builds benign barney's aspects artillery apiece allison's aggression adjustments abusive abduction
+wiping whipping welles unspeakable unlimited unidentified trivial transcripts threatens textbook
+tenant supervise superstitious stricken stretched story's stimulating steep statistics spielberg
+sodium slices shelves scratches saudi sabotaged roxy's retrieval repressed relation rejecting
+quickie promoting ponies peeking paw paolo outraged observer o'connell moping moaning mausoleum
+males licked kovich klutz iraq interrogating interfered intensive insulin infested incompetence
+hyper horrified handedly hacked guiding glamour geoff gekko fraid fractured formerly flour firearms
+fend executives examiner evaluate eloped duke's disoriented delivers dashing crystals crossroads
+crashdown court's conclude coffees cockroach climate chipped camps brushing boulevard bombed bolts
+begs baths baptized astronaut assurance anemia allegiance aiming abuela abiding workplace
+withholding weave wearin weaker warnings usa tours thesis terrorism suffocating straws
+straightforward stench steamed starboard sideways shrinks shortcut sean's scram roasted roaming
+riviera respectfully repulsive recognizes receiver psychiatry provoked penitentiary peed pas
+painkillers oink norm ninotchka muslim montgomery's mitzvah milligrams mil midge marshmallows
+markets macy's looky lapse kubelik knit jeb investments intellect improvise implant hometown hanged
+handicap halo governor's goa'ulds giddy gia's geniuses fruitcake footing flop findings fightin fib
+editorial drinkin doork discovering detour danish cuddle crashes coordinate combo colonnade
+collector cheats cetera canadians bip bailiff auditioning assed amused alienate algebra alexi aiding
+aching woe wah unwanted typically tug topless tongues tiniest them's symbols superiors soy soften
+sheldrake sensors seller seas ruler rival rips renowned recruiting reasoning rawley raisins racial
+presses preservation portfolio oversight organizing obtain observing nessa narrowed minions midwest
+meth merciful manages magistrate lawsuits labour invention intimidating infirmary indicated
+inconvenient imposter hugged honoring holdin hades godforsaken fumes forgery foremost foolproof
+folder folded flattery fingertips financing fifteenth exterminator explodes eccentric drained
+dodging documented disguised developments currency crafts constructive concealed compartment chute
+chinpokomon captains capitol calculated buses bodily astronauts alimony accustomed accessories
+abdominal zen zach's wrinkle wallow viv vicinity venue valued valium valerie's upgrade upcoming
+untrue uncover twig twelfth trembling treasures torched toenails timed termites telly taunting
+taransky tar talker succubus statues smarts sliding sizes sighting semen seizures scarred savvy
+sauna saddest sacrificing rubbish riled ricky's rican revive recruit ratted rationally provenance
+professors prestigious pms phonse perky pedal overdose organism nasal nanites mushy movers moot
+missus midterm merits melodramatic manure magnetic knockout knitting jig invading interpol
+incapacitated idle hotline horse's highlight hauling hair's gunpoint greenwich grail ganza framing
+formally fleeing flap flannel fin fibers faded existing email eavesdrop dwelling dwarf donations
+detected desserts dar corporations constellation collision chic calories businessmen buchanan's
+breathtaking bleak blacked batter balanced ante aggravated agencies abu yanked wuh withdrawn wigand
+whoah wham vocal unwind undoubtedly unattractive twitch trimester torrance timetable taxpayers
+strained stationed stared slapping sincerity signatures siding siblings shit's shenanigans shacking
+seer satellites sappy samaritan rune regained rebellion proceeds privy power's poorer politely paste
+oysters overruled olaf nightcap networks necessity mosquito millimeter michelle's merrier
+massachusetts manuscript manufacture manhood lunar lug lucked loaned kilos ignition hurl hauled
+harmed goodwill freshmen forming fenmore fasten farce failures exploding erratic elm drunks ditching
+d'artagnan crops cramped contacting coalition closets clientele chimp cavalry casa cabs bled
+bargained arranging archives anesthesia amuse altering afternoons accountable abetting wrinkles
+wolek waved unite uneasy unaware ufo toot toddy tens tattooed tad's sway stained spauldings solely
+sliced sirens schibetta scatter rumours roger's robbie's rinse remo remedy redemption queen's
+progressive pleasures picture's philosopher pacey's optimism oblige natives muy measuring measured
+masked mascot malicious mailing luca lifelong kosher koji kiddies judas isolate intercepted
+insecurity initially inferior incidentally ifs hun heals headlights guided growl grilling glazed gem
+gel gaps fundamental flunk floats fiery fairness exercising excellency evenings ere enrolled
+disclosure det department's damp curling cupboard counterfeit cooling condescending conclusive
+clicked cleans cholesterol chap cashed brow broccoli brats blueprints blindfold biz billing barracks
+attach aquarium appalled altitude alrighty aimed yawn xander's wynant winslow's welcomed violations
+upright unsolved unreliable toots tighten symbolic sweatshirt steinbrenner steamy spouse sox
+sonogram slowed slots sleepless skeleton shines roles retaliate representatives rephrase repeated
+renaissance redeem rapidly rambling quilt quarrel prying proverbial priced presiding presidency
+prescribe prepped pranks possessive plaintiff philosophical pest persuaded perk pediatrics paige's
+overlooked outcast oop odor notorious nightgown mythology mumbo monitored mediocre master's
+mademoiselle lunchtime lifesaver legislation leaned lambs lag killings interns intensity increasing
+identities hounding hem hellmouth goon goner ghoul germ gardening frenzy foyer food's extras extinct
+exhibition exaggerate everlasting enlightened drilling doubles digits dialed devote defined
+deceitful d'oeuvres csi cosmetic contaminated conspired conning colonies cerebral cavern cathedral
+carving butting boiled blurry beams barf babysit assistants ascension architecture approaches albums
+albanian aaaaah wildly whoopee whiny weiskopf walkie vultures veteran vacations upfront unresolved
+tile tampering struggled stockholders specially snaps sleepwalking shrunk sermon seeks seduction
+scenarios scams ridden revolve repaired regulation reasonably reactor quotes preserved phenomenal
+patrolling paranormal ounces omigod offs nonstop nightfall nat militia meeting's logs lineup libby's
+lava lashing labels kilometers kate's invites investigative innocents infierno incision import
+implications humming highlights haunts greeks gloss gloating general's frannie flute fled fitted
+finishes fiji fetal feeny entrapment edit dyin download discomfort dimensions detonator dependable
+deke decree dax cot confiscated concludes concede complication commotion commence chulak caucasian
+casually canary brainer bolie ballpark arm's anwar anatomy analyzing accommodations yukon youse
+wring wharf wallowing uranium unclear treason transgenics thrive think's thermal territories tedious
+survives stylish strippers sterile squeezing squeaky sprained solemn snoring sic shifting shattering
+shabby seams scrawny rotation risen revoked residue reeks recite reap ranting quoting primal
+pressures predicament precision plugs pits pinpoint petrified petite persona pathological passports
+oughtta nods nighter navigate nashville namely museums morale milwaukee meditation mathematics
+martin's malta logan's latter kippie jackie's intrigue intentional insufferable incomplete inability
+imprisoned hup hunky how've horrifying hearty headmaster hath har hank's handbook hamptons grazie
+goof george's funerals fuck's fraction forks finances fetched excruciating enjoyable enhanced
+enhance endanger efficiency dumber drying diabolical destroyer desirable defendants debris darts
+cuisine cucumber cube crossword contestant considers comprehend club's clipped classmates choppers
+certificates carmen's canoe candlelight building's brutally brutality boarded bathrobe backward
+authorize audrey's atom assemble appeals airports aerobics ado abbott's wholesome whiff vessels
+vermin varsity trophies trait tragically toying titles tissues testy team's tasteful surge sun's
+studios strips stocked stephen's staircase squares spinach sow southwest southeast sookie's slayer's
+sipping singers sidetracked seldom scrubbing scraping sanctity russell's ruse robberies rink ridin
+retribution reinstated refrain rec realities readings radiant protesting projector posed plutonium
+plaque pilar's payin parting pans o'reilly nooooo motorcycles motherfucking mein measly marv manic
+line's lice liam lenses lama lalita juggling jerking jamie's intro inevitably imprisonment hypnosis
+huddle horrendous hobbies heavier heartfelt harlin hairdresser grub gramps gonorrhea gardens fussing
+fragment fleeting flawless flashed fetus exclusively eulogy equality enforce distinctly
+disrespectful denies crossbow crest cregg crabs cowardly countess contrast contraction contingency
+consulted connects confirming condone coffins cleansing cheesecake certainty captain's cages c'est
+briefed brewing bravest bosom boils binoculars bachelorette aunt's atta assess appetizer ambushed
+alerted woozy withhold weighed vulgar viral utmost unusually unleashed unholy unhappiness underway
+uncovered unconditional typewriter typed twists sweeps supervised supermodel suburbs subpoenaed
+stringing snyder's snot skeptical skateboard shifted secret's scottish schoolgirl romantically
+rocked revoir reviewed respiratory reopen regiment reflects refined puncture pta prone produces
+preach pools polished pods planetarium penicillin peacefully partner's nurturing nation's more'n
+monastery mmhmm midgets marklar machinery lodged lifeline joanna's jer jellyfish infiltrate implies
+illegitimate hutch horseback henri heist gents frickin freezes forfeit followers flakes flair
+fathered fascist eternally eta epiphany enlisted eleventh elect effectively dos disgruntled
+discrimination discouraged delinquent decipher danvers dab cubes credible coping concession cnn
+clash chills cherished catastrophe caretaker bulk bras branches bombshell birthright billionaire
+awol ample alumni affections admiration abbotts zelda's whatnot watering vinegar vietnamese
+unthinkable unseen unprepared unorthodox underhanded uncool transmitted traits timeless thump
+thermometer theoretically theoretical testament tapping tagged tac synthetic syndicate swung surplus
+supplier stares spiked soviets solves smuggle scheduling scarier saucer reinforcements recruited
+rant quitter prudent projection previously powdered poked pointers placement peril penetrate penance
+patriotic passions opium nudge nostrils nevermind neurological muslims mow momentum mockery mobster
+mining medically magnitude maggie's loudly listing killer's kar jim's insights indicted implicate
+hypocritical humanly holiness healthier hammered haldeman gunman graphic gloom geography gary's
+freshly francs formidable flunked flawed feminist faux ewww escorted escapes emptiness emerge
+drugging dozer doc's directorate diana's derevko deprive deodorant cryin crusade crocodile
+creativity controversial commands coloring colder cognac clocked clippings christine's chit charades
+chanting certifiable caterers brute brochures briefs bran botched blinders bitchin bauer's banter
+babu appearing adequate accompanied abrupt abdomen zones wooo woken winding vip venezuela unanimous
+ulcer tread thirteenth thankfully tame tabby's swine swimsuit swans suv stressing steaming stamped
+stabilize squirm spokesman snooze shuffle shredded seoul seized seafood scratchy savor sadistic
+roster rica rhetorical revlon realist reactions prosecuting prophecies prisons precedent polyester
+petals persuasion paddles o'leary nuthin neighbour negroes naval mute muster muck minnesota
+meningitis matron mastered markers maris's manufactured lot's lockers letterman legged launching
+lanes journals indictment indicating hypnotized housekeeping hopelessly hmph hallucinations grader
+goldilocks girly furthermore frames flask expansion envelopes engaging downside doves doorknob
+distinctive dissolve discourage disapprove diabetic departed deliveries decorator deaq crossfire
+criminally containment comrades complimentary commitments chum chatter chapters catchy cashier
+cartel caribou cardiologist bull's buffer brawl bowls booted boat's billboard biblical barbershop
+awakening aryan angst administer acquitted acquisition aces accommodate zellie yield wreak witch's
+william's whistles wart vandalism vamps uterus upstate unstoppable unrelated understudy tristin
+transporting transcript tranquilizer trails trafficking toxins tonsils timing's therapeutic tex
+subscription submitted stephanie's stempel spotting spectator spatula soho softer snotty slinging
+showered sexiest sensual scoring sadder roam rimbaud rim rewards restrain resilient remission
+reinstate rehash recollection rabies quinn's presenting preference prairie popsicle plausible
+plantation pharmaceutical pediatric patronizing patent participation outdoor ostrich ortolani oooooh
+omelette neighbor's neglect nachos movie's mixture mistrial mio mcginty's marseilles mare mandate
+malt luv loophole literary liberation laughin lacey's kevvy jah irritated intends initiation
+initiated initiate influenced infidelity indigenous inc idaho hypothermia horrific hive heroine
+groupie grinding graceful government's goodspeed gestures gah frantic extradition evil's engineers
+echelon earning disks discussions demolition definitive dawnie dave's date's dared dan's damsel
+curled courtyard constitutes combustion collective collateral collage col chant cassette carol's
+carl's calculating bumping britain bribes boardwalk blinds blindly bleeds blake's bickering beasts
+battlefield bankruptcy backside avenge apprehended annie's anguish afghanistan acknowledged abusing
+youthful yells yanking whomever when'd waterfall vomiting vine vengeful utility unpacking unfamiliar
+undying tumble trolls treacherous todo tipping tantrum tanked summons strategies straps stomped
+stinkin stings stance staked squirrels sprinkles speculate specialists sorting skinned sicko sicker
+shootin shep shatter seeya schnapps s'posed rows rounded ronee rite revolves respectful resource
+reply rendered regroup regretting reeling reckoned rebuilding randy's ramifications qualifications
+pulitzer puddy projections preschool pots potassium plissken platonic peter's permalash performer
+peasant outdone outburst ogh obscure mutants mugging molecules misfortune miserably miraculously
+medications medals margaritas manpower lovemaking long's logo logically leeches latrine lamps lacks
+kneel johnny's jenny's inflict impostor icon hypocrisy hype hosts hippies heterosexual heightened
+hecuba's hecuba healer habitat gunned grooming groo groin gras gory gooey gloomy frying friendships
+fredo foil fishermen firepower fess fathom exhaustion evils epi endeavor ehh eggnog dreaded drafted
+dimensional detached deficit d'arcy crotch coughing coronary cookin contributed consummate congrats
+concerts companionship caved caspar bulletproof bris brilliance breakin brash blasting beak arabia
+analyst aluminum aloud alligator airtight advising advertise adultery administered aches abstract
+aahh wronged wal voluntary ventilation upbeat uncertainty trot trillion tricia's trades tots tol
+tightly thingies tending technician tarts surreal summer's strengths specs specialize spat spade
+slogan sloane's shrew shaping seth's selves seemingly schoolwork roomie requirements redundant redo
+recuperating recommendations ratio rabid quart pseudo provocative proudly principal's pretenses
+prenatal pillar photographers photographed pharmaceuticals patron pacing overworked originals
+nicotine newsletter neighbours murderous miller's mileage mechanics mayonnaise massages maroon
+lucrative losin lil lending legislative kat juno iran interrogated instruction injunction impartial
+homing heartbreaker harm's hacks glands giver fraizh flows flips flaunt excellence estimated
+espionage englishman electrocuted eisenhower dusting ducking drifted donna's donating dom distribute
+diem daydream cylon curves crutches crates cowards covenant converted contributions composed
+comfortably cod cockpit chummy chitchat childbirth charities businesswoman brood brewery bp's
+blatant bethy barring bagged awakened assumes assembled asbestos arty artwork arc anthony's aka
+airplanes accelerated worshipped winnings why're whilst wesley's volleyball visualize unprotected
+unleash unexpectedly twentieth turnpike trays translated tones three's thicker therapists takeoff
+sums stub streisand storm's storeroom stethoscope stacked sponsors spiteful solutions sneaks
+snapping slaughtered slashed simplest silverware shits secluded scruples scrubs scraps scholar
+ruptured rubs roaring relying reflected refers receptionist recap reborn raisin rainforest rae's
+raditch radiator pushover pout plastered pharmacist petroleum perverse perpetrator passages ornament
+ointment occupy nineties napping nannies mousse mort morocco moors momentary modified mitch's
+misunderstandings marina's marcy's marched manipulator malfunction loot limbs latitude lapd laced
+kivar kickin interface infuriating impressionable imposing holdup hires hick hesitated hebrew
+hearings headphones hammering groundwork grotesque greenhouse gradually graces genetics gauze garter
+gangsters g's frivolous freelance freeing fours forwarding feud ferrars faulty fantasizing
+extracurricular exhaust empathy educate divorces detonate depraved demeaning declaring deadlines dea
+daria's dalai cursing cufflink crows coupons countryside coo consultation composer comply comforted
+clive claustrophobic chef's casinos caroline's capsule camped cairo busboy bred bravery bluth
+biography berserk bennetts baskets attacker aplastic angrier affectionate zit zapped yorker yarn
+wormhole weaken vat unrealistic unravel unimportant unforgettable twain tv's tush turnout trio towed
+tofu textbooks territorial suspend supplied superbowl sundays stutter stewardess stepson standin
+sshh specializes spandex souvenirs sociopath snails slope skeletons shivering sexier sequel sensory
+selfishness scrapbook romania riverside rites ritalin rift ribbons reunite remarry relaxation
+reduction realization rattling rapist quad pup psychosis promotions presumed prepping posture poses
+pleasing pisses piling photographic pfft persecuted pear part's pantyhose padded outline
+organizations operatives oohh obituary northeast nina's neural negotiator nba natty nathan's
+minimize merl menopause mennihan marty's martimmys makers loyalties literal lest laynie lando
+justifies josh's intimately interact integrated inning inexperienced impotent immortality imminent
+ich horrors hooky holders hinges heartbreaking handcuffed gypsies guacamole grovel graziella goggles
+gestapo fussy functional filmmaker ferragamo feeble eyesight explosions experimenting enzo's
+endorsement enchanting eee ed's duration doubtful dizziness dismantle disciplinary disability
+detectors deserving depot defective decor decline dangling dancin crumble criteria creamed cramping
+cooled conceal component competitors clockwork clark's circuits chrissakes chrissake chopping
+cabinets buttercup brooding bonfire blurt bluestar bloated blackmailer beforehand bathed bathe
+barcode banjo banish badges babble await attentive artifacts aroused antibodies animosity
+administrator accomplishments ya'll wrinkled wonderland willed whisk waltzing waitressing vis vin
+vila vigilant upbringing unselfish unpopular unmarried uncles trendy trajectory targeting
+surroundings stun striped starbucks stamina stalled staking stag spoils snuff snooty snide shrinking
+senorita securities secretaries scrutiny scoundrel saline salads sails rundown roz's roommate's
+riddles responses resistant requirement relapse refugees recommending raspberry raced prosperity
+programme presumably preparations posts pom plight pleaded pilot's peers pecan particles pantry
+overturned overslept ornaments opposing niner nfl negligent negligence nailing mutually mucho
+mouthed monstrous monarchy minsk matt's mateo's marking manufacturing manager's malpractice
+maintaining lowly loitering logged lingering light's lettin lattes kim's kamal justification juror
+junction julie's joys johnson's jillefsky jacked irritate intrusion inscription insatiable infect
+inadequate impromptu icing hmmmm hefty grammar generate gdc gasket frightens flapping firstborn
+fire's fig faucet exaggerated estranged envious eighteenth edible downward dopey doesn disposition
+disposable disasters disappointments dipped diminished dignified diaries deported deficiency deceit
+dealership deadbeat curses coven counselors convey consume concierge clutches christians cdc casbah
+carefree callous cahoots caf brotherly britches brides bop bona bethie beige barrels ballot ave
+autographed attendants attachment attaboy astonishing ashore appreciative antibiotic aneurysm
+afterlife affidavit zuko zoning work's whats whaddaya weakened watermelon vasectomy unsuspecting
+trial's trailing toula topanga tonio toasted tiring thereby terrorized tenderness tch tailing
+syllable sweats suffocated sucky subconsciously starvin staging sprouts spineless sorrows snowstorm
+smirk slicery sledding slander simmer signora sigmund siege siberia seventies sedate scented
+sampling sal's rowdy rollers rodent revenue retraction resurrection resigning relocate releases
+refusal referendum recuperate receptive ranking racketeering queasy proximity provoking promptly
+probability priors princes prerogative premed pornography porcelain poles podium pinched pig's
+pendant packet owner's outsiders outpost orbing opportunist olanov observations nurse's nobility
+neurologist nate's nanobot muscular mommies molested misread melon mediterranean mea mastermind
+mannered maintained mackenzie's liberated lesions lee's laundromat landscape lagoon labeled jolt
+intercom inspect insanely infrared infatuation indulgent indiscretion inconsiderate incidents
+impaired hurrah hungarian howling honorary herpes hasta harassed hanukkah guides groveling groosalug
+geographic gaze gander galactica futile fridays flier fixes fide fer feedback exploiting exorcism
+exile evasive ensemble endorse emptied dreary dreamy downloaded dodged doctored displayed disobeyed
+disneyland disable diego's dehydrated defect customary csc criticizing contracted contemplating
+consists concepts compensate commonly colours coins coconuts cockroaches clogged cincinnati churches
+chronicle chilling chaperon ceremonies catalina's cant cameraman bulbs bucklands bribing brava
+bracelets bowels bobby's bmw bluepoint baton barred balm audit astronomy aruba appetizers appendix
+antics anointed analogy almonds albuquerque abruptly yore yammering winch white's weston's weirdness
+wangler vibrations vendor unmarked unannounced twerp trespass tres travesty transported transfusion
+trainee towelie topics tock tiresome thru theatrical terrain suspect's straightening staggering
+spaced sonar socializing sitcom sinus sinners shambles serene scraped scones scepter sarris
+saberhagen rouge rigid ridiculously ridicule reveals rents reflecting reconciled rate's radios quota
+quixote publicist pubes prune prude provider propaganda prolonged projecting prestige precrime
+postponing pluck perpetual permits perish peppermint peeled particle parliament overdo oriented
+optional nutshell notre notions nostalgic nomination mulan mouthing monkey's mistook mis milhouse
+mel's meddle maybourne martimmy loon lobotomy livelihood litigation lippman likeness laurie's
+kindest kare kaffee jocks jerked jeopardizing jazzed investing insured inquisition inhale ingenious
+inflation incorrect igby ideals holier highways hereditary helmets heirloom heinous haste harmsway
+hardship hanky gutters gruesome groping governments goofing godson glare garment founding fortunes
+foe finesse figuratively ferrie fda external examples evacuation ethnic est endangerment enclosed
+emphasis dyed dud dreading dozed dorky dmitri divert dissertation discredit director's dialing
+describes decks cufflinks crutch creator craps corrupted coronation contemporary consumption
+considerably comprehensive cocoon cleavage chile carriers carcass cannery bystander brushes bruising
+bribery brainstorm bolted binge bart's barracuda baroness ballistics b's astute arroway arabian
+ambitions alexandra's afar adventurous adoptive addicts addictive accessible yadda wilson's wigs
+whitelighters wematanye weeds wedlock wallets walker's vulnerability vroom vibrant vertical vents
+uuuh urgh upped unsettling unofficial unharmed underlying trippin trifle tracing tox tormenting
+timothy's threads theaters thats tavern taiwan syphilis susceptible summary suites subtext stickin
+spices sores smacked slumming sixteenth sinks signore shitting shameful shacked sergei septic seedy
+security's searches righteousness removal relish relevance rectify recruits recipient ravishing
+quickest pupil productions precedence potent pooch pledged phoebs perverted peeing pedicure pastrami
+passionately ozone overlooking outnumbered outlook oregano offender nukes novelty nosed nighty nifty
+mugs mounties motivate moons misinterpreted miners mercenary mentality mas marsellus mapped malls
+lupus lumbar lovesick longitude lobsters likelihood leaky laundering latch japs jafar instinctively
+inspires inflicted inflammation indoors incarcerated imagery hundredth hula hemisphere handkerchief
+hand's gynecologist guittierez groundhog grinning graduates goodbyes georgetown geese fullest ftl
+floral flashback eyelashes eyelash excluded evening's evacuated enquirer endlessly encounters
+elusive disarm detest deluding dangle crabby cotillion corsage copenhagen conjugal confessional
+cones commandment coded coals chuckle christmastime christina's cheeseburgers chardonnay ceremonial
+cept cello celery carter's campfire calming burritos burp buggy brundle broflovski brighten bows
+borderline blinked bling beauties bauers battered athletes assisting articulate alot alienated
+aleksandr ahhhhh agreements agamemnon accountants zat y'see wrongful writer's wrapper workaholic wok
+winnebago whispered warts vikki's verified vacate updated unworthy unprecedented unanswered trend
+transformed transform trademark tote tonane tolerated throwin throbbing thriving thrills thorns
+thereof there've terminator tendencies tarot tailed swab sunscreen stretcher stereotype spike's
+soggy sobbing slopes skis skim sizable sightings shucks shrapnel sever senile sections seaboard
+scripts scorned saver roxanne's resemble red's rebellious rained putty proposals prenup positioned
+portuguese pores pinching pilgrims pertinent peeping pamphlet paints ovulating outbreak oppression
+opposites occult nutcracker nutcase nominee newt newsstand newfound nepal mocked midterms
+marshmallow manufacturer managers majesty's maclaren luscious lowered loops leans laurence's krudski
+knowingly keycard katherine's junkies juilliard judicial jolinar jase irritable invaluable inuit
+intoxicating instruct insolent inexcusable induce incubator illustrious hydrogen hunsecker hub
+houseguest honk homosexuals homeroom holly's hindu hernia harming handgun hallways hallucination
+gunshots gums guineas groupies groggy goiter gingerbread giggling geometry genre funded frontal
+frigging fledged fedex feat fairies eyeball extending exchanging exaggeration esteemed ergo enlist
+enlightenment encyclopedia drags disrupted dispense disloyal disconnect dimitri desks dentists delhi
+delacroix degenerate deemed decay daydreaming cushions cuddly corroborate contender congregation
+conflicts confessions complexion completion compensated cobbler closeness chilled checkmate channing
+carousel calms bylaws bud's benefactor belonging ballgame baiting backstabbing assassins artifact
+armies appoint anthropology anthropologist alzheimer's allegedly alex's airspace adversary adolf
+actin acre aced accuses accelerant abundantly abstinence abc zsa zissou zandt yom yapping wop witchy
+winter's willows whee whadaya want's walter's waah viruses vilandra veiled unwilling undress
+undivided underestimating ultimatums twirl truckload tremble traditionally touring touche toasting
+tingling tiles tents tempered sussex sulking stunk stretches sponges spills softly snipers slid
+sedan screens scourge rooftop rog rivalry rifles riana revolting revisit resisted rejects
+refreshments redecorating recurring recapture raysy randomly purchases prostitutes proportions
+proceeded prevents pretense prejudiced precogs pouting poppie poofs pimple piles pediatrician
+patrick's pathology padre packets paces orvelle oblivious objectivity nikki's nighttime nervosa
+navigation moist moan minors mic mexicans meurice melts mau mats matchmaker markings maeby lugosi
+lipnik leprechaun kissy kafka italians introductions intestines intervene inspirational insightful
+inseparable injections informal influential inadvertently illustrated hussy huckabees hmo hittin
+hiss hemorrhaging headin hazy haystack hallowed haiti haa grudges grenades granilith grandkids
+grading gracefully godsend gobbles fyi future's fun's fret frau fragrance fliers firms finchley
+fbi's farts eyewitnesses expendable existential endured embraced elk ekg dude's dragonfly dorms
+domination directory depart demonstrated delaying degrading deduction darlings dante's danes cylons
+counsellor cortex cop's coordinator contraire consensus consciously conjuring congratulating
+compares commentary commandant cokes centimeters cc's caucus casablanca buffay buddy's brooch bony
+boggle blood's bitching bistro bijou bewitched benevolent bends bearings barren arr aptitude antenna
+amish amazes alcatraz acquisitions abomination worldly woodstock withstand whispers whadda wayward
+wayne's wailing vinyl variables vanishing upscale untouchable unspoken uncontrollable unavoidable
+unattended tuning trite transvestite toupee timid timers themes terrorizing teamed taipei t's swana
+surrendered suppressed suppress stumped strolling stripe storybook storming stomachs stoked
+stationery springtime spontaneity sponsored spits spins soiree sociology soaps smarty shootout shar
+settings sentiments senator's scramble scouting scone runners rooftops retract restrictions
+residency replay remainder regime reflexes recycling rcmp rawdon ragged quirky quantico
+psychologically prodigal primo pounce potty portraits pleasantries plane's pints phd petting
+perceive patrons parameters outright outgoing onstage officer's o'connor notwithstanding noah's
+nibble newmans neutralize mutilated mortality monumental ministers millionaires mentions mcdonald's
+mayflower masquerade mangy macreedy lunatics luau lover's lovable louie's locating lizards limping
+lasagna largely kwang keepers juvie jaded ironing intuitive intensely insure installation increases
+incantation identifying hysteria hypnotize humping heavyweight happenin gung griet grasping
+glorified glib ganging g'night fueled focker flunking flimsy flaunting fixated fitzwallace fictional
+fearing fainting eyebrow exonerated ether ers electrician egotistical earthly dusted dues donors
+divisions distinguish displays dismissal dignify detonation deploy departments debrief dazzling
+dawn's dan'l damnedest daisies crushes crucify cordelia's controversy contraband contestants
+confronting communion collapsing cocked clock's clicks cliche circular circled chord characteristics
+chandelier casualty carburetor callers bup broads breathes boca bobbie's bloodshed blindsided
+blabbing binary bialystock bashing ballerina ball's aviva avalanche arteries appliances anthem
+anomaly anglo airstrip agonizing adjourn abandonment zack's you's yearning yams wrecker word's
+witnessing winged whence wept warsaw warp warhead wagons visibility usc unsure unions unheard
+unfreeze unfold unbalanced ugliest troublemaker tolerant toddler tiptoe threesome thirties
+thermostat tampa sycamore switches swipe surgically supervising subtlety stung stumbling stubs
+struggles stride strangling stamp's spruce sprayed socket snuggle smuggled skulls simplicity
+showering shhhhh sensor sci sac sabotaging rumson rounding risotto riots revival responds reserves
+reps reproduction repairman rematch rehearsed reelection redi recognizing ratty ragging radiology
+racquetball racking quieter quicksand pyramids pulmonary puh publication prowl provisions prompt
+premeditated prematurely prancing porcupine plated pinocchio perceived peeked peddle pasture panting
+overweight oversee overrun outing outgrown obsess o'donnell nyu nursed northwestern norma's nodding
+negativity negatives musketeers mugger mounting motorcade monument merrily matured massimo's
+masquerading marvellous marlena's margins maniacs mag lumpy lovey louse linger lilies libido lawful
+kudos knuckle kitchen's kennedy's juices judgments joshua's jars jams jamal's jag itches intolerable
+intermission interaction institutions infectious inept incentives incarceration improper implication
+imaginative ight hussein humanitarian huckleberry horatio holster heiress heartburn hayley's hap
+gunna guitarist groomed greta's granting graciously glee gentleman's fulfillment fugitives fronts
+founder forsaking forgives foreseeable flavors flares fixation figment fickle featuring featured
+fantasize famished faith's fades expiration exclamation evolve euro erasing emphasize elevator's
+eiffel eerie earful duped dulles distributor distorted dissing dissect dispenser dilated digit
+differential diagnostic detergent desdemona debriefing dazzle damper cylinder curing crowbar
+crispina crafty crackpot courting corrections cordial copying consuming conjunction conflicted
+comprehension commie collects cleanup chiropractor charmer chariot charcoal chaplain challenger
+census cd's cauldron catatonic capabilities calculate bullied buckets brilliantly breathed boss's
+booths bombings boardroom blowout blower blip blindness blazing birthday's biologically bibles
+biased beseech barbaric band's balraj auditorium audacity assisted appropriations applicants
+anticipating alcoholics airhead agendas aft admittedly adapt absolution abbot zing youre yippee
+wittlesey withheld willingness willful whammy webber's weakest washes virtuous violently videotapes
+vials vee unplugged unpacked unfairly und turbulence tumbling troopers tricking trenches
+tremendously travelled travelers traitors torches tommy's tinga thyroid texture temperatures teased
+tawdry tat taker sympathies swiped swallows sundaes suave strut structural stone's stewie stepdad
+spewing spasm socialize slither sky's simulator sighted shutters shrewd shocks sherry's sgc
+semantics scout's schizophrenic scans savages satisfactory rya'c runny ruckus royally roadblocks
+riff rewriting revoke reversal repent renovation relating rehearsals regal redecorate recovers
+recourse reconnaissance receives ratched ramali racquet quince quiche puppeteer puking puffed
+prospective projected problemo preventing praises pouch posting postcards pooped poised piled phoney
+phobia performances patty's patching participating parenthood pardner oppose oozing oils ohm ohhhhh
+nypd numbing novelist nostril nosey nominate noir neatly nato naps nappa nameless muzzle muh
+mortuary moronic modesty mitz missionary mimi's midwife mercenaries mcclane maxie's matuka mano mam
+maitre lush lumps lucid loosened loosely loins lawnmower lane's lamotta kroehner kristen's juggle
+jude's joins jinxy jessep jaya jamming jailhouse jacking ironically intruders inhuman infections
+infatuated indoor indigestion improvements implore implanted id's hormonal hoboken hillbilly
+heartwarming headway headless haute hatched hartmans harping hari grapevine graffiti gps gon gogh
+gnome ged forties foreigners fool's flyin flirted fingernail fdr exploration expectation
+exhilarating entrusted enjoyment embark earliest dumper duel dubious drell dormant docking
+disqualified disillusioned dishonor disbarred directive dicey denny's deleted del's declined
+custodial crunchy crises counterproductive correspondent corned cords cor coot contributing
+contemplate containers concur conceivable commissioned cobblepot cliffs clad chief's chickened
+chewbacca checkout carpe cap'n campers calcium buyin buttocks bullies brown's brigade brain's braid
+boxed bouncy blueberries blubbering bloodstream bigamy bel beeped bearable bank's awarded autographs
+attracts attracting asteroid arbor arab apprentice announces andie's ammonia alarming aidan's ahoy
+ahm zan wretch wimps widows widower whirlwind whirl weather's warms war's wack villagers vie
+vandelay unveiling uno undoing unbecoming ucla turnaround tribunal togetherness tickles ticker
+tended teensy taunt system's sweethearts superintendent subcommittee strengthen stomach's stitched
+standpoint staffers spotless splits soothe sonnet smothered sickening showdown shouted shepherds
+shelters shawl seriousness separates sen schooled schoolboy scat sats sacramento s'mores roped
+ritchie's resembles reminders regulars refinery raggedy profiles preemptive plucked pheromones
+particulars pardoned overpriced overbearing outrun outlets onward oho ohmigod nosing norwegian
+nightly nicked neanderthal mosquitoes mortified moisture moat mime milky messin mecha markinson
+marivellas mannequin manderley maid's madder macready maciver's lookie locusts lisbon lifetimes
+leg's lanna lakhi kholi joke's invasive impersonate impending immigrants ick i's hyperdrive horrid
+hopin hombre hogging hens hearsay haze harpy harboring hairdo hafta hacking gun's guardians
+grasshopper graded gobble gatehouse fourteenth foosball floozy fitzgerald's fished firewood finalize
+fever's fencing felons falsely fad exploited euphemism entourage enlarged ell elitist elegance
+eldest duo drought drokken drier dredge dramas dossier doses diseased dictator diarrhea diagnose
+despised defuse defendant's d'amour crowned cooper's continually contesting consistently conserve
+conscientious conjured completing commune commissioner's collars coaches clogs chenille chatty
+chartered chamomile casing calculus calculator brittle breached boycott blurted birthing bikinis
+bankers balancing astounding assaulting aroma arbitration appliance antsy amnio alienating aliases
+aires adolescence administrative addressing achieving xerox wrongs workload willona whistling
+werewolves wallaby veterans usin updates unwelcome unsuccessful unseemly unplug undermining ugliness
+tyranny tuesdays trumpets transference traction ticks tete tangible tagging swallowing superheroes
+sufficiently studs strep stowed stow stomping steffy stature stairway sssh sprain spouting
+sponsoring snug sneezing smeared slop slink slew skid simultaneously simulation sheltered shakin
+sewed sewage seatbelt scariest scammed scab sanctimonious samir rushes rugged routes romanov
+roasting rightly retinal rethinking resulted resented reruns replica renewed remover raiding raided
+racks quantity purest progressing primarily presidente prehistoric preeclampsia postponement portals
+poppa pop's pollution polka pliers playful pinning pharaoh perv pennant pelvic paved patented paso
+parted paramedic panels pampered painters padding overjoyed orthodox organizer one'll octavius
+occupational oakdale's nous nite nicknames neurosurgeon narrows mitt misled mislead mishap milltown
+milking microscopic meticulous mediocrity meatballs measurements mandy's malaria machete lydecker's
+lurch lorelai's linda's layin lavish lard knockin khruschev kelso's jurors jumpin jugular
+journalists jour jeweler jabba intersection intellectually integral installment inquiries indulging
+indestructible indebted implicated imitate ignores hyperventilating hyenas hurrying huron horizontal
+hermano hellish heheh header hazardous hart's harshly harper's handout handbag grunemann gots glum
+gland glances giveaway getup gerome furthest funhouse frosting franchise frail fowl forwarded
+forceful flavored flank flammable flaky fingered finalists fatherly famine fags facilitate exempt
+exceptionally ethic essays equity entrepreneur enduring empowered employers embezzlement eels dusk
+duffel downfall dotted doth doke distressed disobey disappearances disadvantage dinky diminish
+diaphragm deuces deployed delia's davidson's curriculum curator creme courteous correspondence
+conquered comforts coerced coached clots clarification cite chunks chickie chick's chases
+chaperoning ceramic ceased cartons capri caper cannons cameron's calves caged bustin bungee bulging
+bringin brie boomhauer blowin blindfolded blab biscotti bird's beneficial bastard's ballplayer
+bagging automated auster assurances aschen arraigned anonymity annex animation andi anchorage alters
+alistair's albatross agreeable advancement adoring accurately abduct wolfi width weirded watchers
+washroom warheads voltage vincennes villains victorian urgency upward understandably uncomplicated
+uhuh uhhhh twitching trig treadmill transactions topped tiffany's they's thermos termination
+tenorman tater tangle talkative swarm surrendering summoning substances strive stilts stickers
+stationary squish squashed spraying spew sparring sorrel's soaring snout snort sneezed slaps skanky
+singin sidle shreck shortness shorthand shepherd's sharper shamed sculptures scanning saga sadist
+rydell rusik roulette rodi's rockefeller revised resumes restoring respiration reiber's reek recycle
+recount reacts rabbit's purge purgatory purchasing providence prostate princesses presentable
+poultry ponytail plotted playwright pinot pigtails pianist phillippe philippines peddling paroled
+owww orchestrated orbed opted offends o'hara noticeable nominations nancy's myrtle's music's mope
+moonlit moines minefield metaphors memoirs mecca maureen's manning's malignant mainframe magicks
+maggots maclaine lobe loathing linking leper leaps leaping lashed larch larceny lapses ladyship
+juncture jiffy jane's jakov invoke interpreted internally intake infantile increasingly inadmissible
+implement immense howl horoscope hoof homage histories hinting hideaway hesitating hellbent heddy
+heckles hat's harmony's hairline gunpowder guidelines guatemala gripe gratifying grants governess
+gorge goebbels gigolo generated gears fuzz frigid freddo freddie's foresee filters filmed fertile
+fellowship feeling's fascination extinction exemplary executioner evident etcetera estimates escorts
+entity endearing encourages electoral eaters earplugs draped distributors disrupting disagrees dimes
+devastate detain deposits depositions delicacy delays darklighter dana's cynicism cyanide cutters
+cronus convoy continuous continuance conquering confiding concentrated compartments companions
+commodity combing cofell clingy cleanse christmases cheered cheekbones charismatic cabaret buttle
+burdened buddhist bruenell broomstick brin brained bozos bontecou bluntman blazes blameless bizarro
+benny's bellboy beaucoup barry's barkeep bali bala bacterial axis awaken astray assailant aslan
+arlington aria appease aphrodisiac announcements alleys albania aitoro's activation acme yesss
+wrecks woodpecker wondrous window's wimpy willpower widowed wheeling weepy waxing waive vulture
+videotaped veritable vascular variations untouched unlisted unfounded unforeseen two's twinge
+truffles triggers traipsing toxin tombstone titties tidal thumping thor's thirds therein testicles
+tenure tenor telephones technicians tarmac talby tackled systematically swirling suicides suckered
+subtitles sturdy strangler stockbroker stitching steered staple standup squeal sprinkler
+spontaneously splendor spiking spender sovereign snipe snip snagged slum skimming significantly
+siddown showroom showcase shovels shotguns shoelaces shitload shifty shellfish sharpest shadowy sewn
+seizing seekers scrounge scapegoat sayonara satan's saddled rung rummaging roomful romp retained
+residual requiring reproductive renounce reggie's reformed reconsidered recharge realistically
+radioed quirks quadrant punctual public's presently practising pours possesses poolhouse poltergeist
+pocketbook plural plots pleasure's plainly plagued pity's pillars picnics pesto pawing passageway
+partied para owing openings oneself oats numero nostalgia nocturnal nitwit nile nexus neuro
+negotiated muss moths mono molecule mixer medicines meanest mcbeal matinee margate marce
+manipulations manhunt manger magicians maddie's loafers litvack lightheaded lifeguard lawns
+laughingstock kodak kink jewellery jessie's jacko itty inhibitor ingested informing indignation
+incorporate inconceivable imposition impersonal imbecile ichabod huddled housewarming horizons
+homicides hobo historically hiccups helsinki hehe hearse harmful hardened gushing gushie greased
+goddamit gigs freelancer forging fonzie fondue flustered flung flinch flicker flak fixin finalized
+fibre festivus fertilizer fenmore's farted faggots expanded exonerate exceeded evict establishing
+enormously enforced encrypted emdash embracing embedded elliot's elimination dynamics duress dupres
+dowser doormat dominant districts dissatisfied disfigured disciplined discarded dibbs diagram
+detailing descend depository defining decorative decoration deathbed death's dazzled da's cuttin
+cures crowding crepe crater crammed costly cosmopolitan cortlandt's copycat coordinated conversion
+contradict containing constructed confidant condemning conceited computer's commute comatose
+coleman's coherent clinics clapping circumference chuppah chore choksondik chestnuts catastrophic
+capitalist campaigning cabins briault bottomless boop bonnet board's bloomingdale's blokes blob bids
+berluti beret behavioral beggars bar's bankroll bania athos assassinate arsenic apperantly ancestor
+akron ahhhhhh afloat adjacent actresses accordingly accents abe's zipped zeros zeroes zamir yuppie
+youngsters yorkers writ wisest wipes wield whyn't weirdos wednesdays villages vicksburg variable
+upchuck untraceable unsupervised unpleasantness unpaid unhook unconscionable uncalled turks tumors
+trappings translating tragedies townie timely tiki thurgood things'll thine tetanus terrorize
+temptations teamwork tanning tampons tact swarming surfaced supporter stuart's stranger's
+straitjacket stint stimulation steroid statistically startling starry squander speculating source's
+sollozzo sobriety soar sneaked smithsonian slugs slaw skit skedaddle sinker similarities silky
+shortcomings shipments sheila's severity sellin selective seattle's seasoned scrubbed scrooge
+screwup scrapes schooling scarves saturdays satchel sandburg's sandbox salesmen rooming romances
+revolving revere resulting reptiles reproach reprieve recreational rearranging realtor ravine
+rationalize raffle quoted punchy psychobabble provocation profoundly problematic prescriptions
+preferable praised polishing poached plow pledges planetary plan's pirelli perverts peaked pastures
+pant oversized overdressed outdid outdated oriental ordinance orbs opponents occurrence nuptials
+nominees nineteenth nefarious mutiny mouthpiece motels mopping moon's mongrel monetary mommie missin
+metaphorically merv mertin memos memento melodrama melancholy measles meaner marches mantel
+maneuvers maneuvering mailroom machine's luring listenin lion's lifeless liege licks libraries
+liberties levon legwork lanka lacked kneecaps kippur kiddie kaput justifiable jigsaw issuing islamic
+insistent insidious innuendo innit inhabitants individually indicator indecent imaginable illicit
+hymn hurling humane hospitalized horseshit hops hondo hemorrhoid hella healthiest haywire hamsters
+halibut hairbrush hackers guam grouchy grisly griffin's gratuitous glutton glimmer gibberish ghastly
+geologist gentler generously generators geeky gaga furs fuhrer fronting forklift foolin fluorescent
+flats flan financed filmmaking fight's faxes faceless extinguisher expressions expel etched
+entertainer engagements endangering empress egos educator ducked dual dramatically dodgeball dives
+diverted dissolved dislocated discrepancy discovers dink devour destroyers derail deputies dementia
+decisive daycare daft cynic crumbling cowardice cow's covet cornwallis corkscrew cookbook
+conditioned commendation commandments columns coincidental cobwebs clouded clogging clicking clasp
+citizenship chopsticks chefs chaps catherine's castles cashing carat calmer burgundy bulldog's
+brightly brazen brainwashing bradys bowing booties bookcase boned bloodsucking blending bleachers
+bleached belgian bedpan bearded barrenger bachelors awwww atop assures assigning asparagus arabs
+apprehend anecdote amoral alterations alli aladdin aggravation afoot acquaintances accommodating
+accelerate yakking wreckage worshipping wladek willya willies wigged whoosh whisked wavelength
+watered warpath warehouses volts vitro violates viewed vicar valuables users urging uphill unwise
+untimely unsavory unresponsive unpunished unexplained unconventional tubby trolling treasurer
+transfers toxicology totaled tortoise tormented toothache tingly tina's timmiihh tibetan thursdays
+thoreau terrifies temperature's temperamental telegrams ted's technologies teaming teal'c's talkie
+takers table's symbiote swirl suffocate subsequently stupider strapping store's steckler
+standardized stampede stainless springing spreads spokesperson speeds someway snowflake sleepyhead
+sledgehammer slant slams situation's showgirl shoveling shmoopy sharkbait shan't seminars scrambling
+schizophrenia schematics schedule's scenic sanitary sandeman saloon sabbatical rural runt rummy
+rotate reykjavik revert retrieved responsive rescheduled requisition renovations remake relinquish
+rejoice rehabilitation recreation reckoning recant rebuilt rebadow reassurance reassigned
+rattlesnake ramble racism quor prowess prob primed pricey predictions prance pothole pocus plains
+pitches pistols persist perpetrated penal pekar peeling patter pastime parmesan paper's papa's panty
+pail pacemaker overdrive optic operas ominous offa observant nothings noooooo nonexistent nodded
+nieces neia neglecting nauseating mutton mutated musket munson's mumbling mowing mouthful mooseport
+monologue momma's moly mistrust meetin maximize masseuse martha's marigold mantini mailer madre
+lowlifes locksmith livid liven limos licenses liberating lhasa lenin leniency leering learnt
+laughable lashes lasagne laceration korben katan kalen jordan's jittery jesse's jammies
+irreplaceable intubate intolerant inhaler inhaled indifferent indifference impound imposed impolite
+humbly holocaust heroics heigh gunk guillotine guesthouse grounding groundbreaking groom's grips
+grant's gossiping goatee gnomes gellar fusion's fumble frutt frobisher freudian frenchman
+foolishness flagged fixture femme feeder favored favorable fatso fatigue fatherhood farmer's
+fantasized fairest faintest factories eyelids extravagant extraterrestrial extraordinarily explicit
+escalator eros endurance encryption enchantment's eliminating elevate editors dysfunction drivel
+dribble dominican dissed dispatched dismal disarray dinnertime devastation dermatologist delicately
+defrost debutante debacle damone dainty cuvee culpa crucified creeped crayons courtship counsel's
+convene continents conspicuous congresswoman confinement conferences confederate concocted
+compromises comprende composition communism comma collectors coleslaw clothed clinically chug
+chickenshit checkin chaotic cesspool caskets cancellation calzone brothel boomerang bodega bloods
+blasphemy black's bitsy bink biff bicentennial berlini beatin beards barbas barbarians backpacking
+audiences artist's arrhythmia array arousing arbitrator aqui appropriately antagonize angling
+anesthetic altercation alice's aggressor adversity adopting acne accordance acathla aaahhh wreaking
+workup workings wonderin wolf's wither wielding whopper what'm what'cha waxed vibrating veterinarian
+versions venting vasey valor validate urged upholstery upgraded untied unscathed unsafe unlawful
+uninterrupted unforgiving undies uncut twinkies tucking tuba truffle truck's triplets treatable
+treasured transmit tranquility townspeople torso tomei tipsy tinsel timeline tidings thirtieth
+tensions teapot tasks tantrums tamper talky swayed swapping sven sulk suitor subjected stylist
+stroller storing stirs statistical standoff staffed squadron sprinklers springsteen specimens
+sparkly song's snowy snobby snatcher smoother smith's sleepin shrug shortest shoebox shel sheesh
+shee shackles setbacks sedatives screeching scorched scanned satyr sammy's sahib rosemary's rooted
+rods roadblock riverbank rivals ridiculed resentful repellent relates registry regarded refugee
+recreate reconvene recalled rebuttal realmedia quizzes questionnaire quartet pusher punctured pucker
+propulsion promo prolong professionalism prized premise predators portions pleasantly planet's
+pigsty physicist phil's penniless pedestrian paychecks patiently paternal parading pa's overactive
+ovaries orderlies oracles omaha oiled offending nudie neonatal neighborly nectar nautical naught
+moops moonlighting mobilize mite misleading milkshake mickey's metropolitan menial meats mayan maxed
+marketplace mangled magua lunacy luckier llanview's livestock liters liter licorice libyan
+legislature lasers lansbury kremlin koreans kooky knowin kilt junkyard jiggle jest jeopardized jags
+intending inkling inhalation influences inflated inflammatory infecting incense inbound impractical
+impenetrable iffy idealistic i'mma hypocrites hurtin humbled hosted homosexuality hologram hokey
+hocus hitchhiking hemorrhoids headhunter hassled harts hardworking haircuts hacksaw guerrilla
+genitals gazillion gatherings ganza's gammy gamesphere fugue fuels forests footwear folly folds
+flexibility flattened flashlights fives filet field's famously extenuating explored exceed estrogen
+envisioned entails emerged embezzled eloquent egomaniac dummies duds ducts drowsy drones dragon's
+drafts doree donovon donny's docked dixon's distributed disorders disguises disclose diggin dickie's
+detachment deserting depriving demographic delegation defying deductible decorum decked daylights
+daybreak dashboard darien damnation d'angelo's cuddling crunching crickets crazies crayon councilman
+coughed coordination conundrum contractors contend considerations compose complimented compliance
+cohaagen clutching cluster clued climbs clader chuck's chromosome cheques checkpoint chats
+channeling ceases catholics cassius carver's carasco capped capisce cantaloupe cancelling campsite
+camouflage cambodia burglars bureaucracy breakfasts branding bra'tac book's blueprint bleedin
+blaze's blabbed bisexual bile big's beverages beneficiary battery's basing avert avail autobiography
+atone army's arlyn ares architectural approves apothecary anus antiseptic analytical amnesty
+alphabetical alignment aligned aleikuum advisory advisors advisement adulthood acquiring accessed
+zombie's zadir wrestled wobbly withnail wheeled whattaya whacking wedged wanders walkman visionary
+virtues vincent's vega's vaginal usage unnamed uniquely unimaginable undeniable unconditionally
+uncharted unbridled tweezers tvmegasite trumped triumphant trimming tribes treading translates
+tranquilizers towing tout toontown thunk taps taboo suture suppressing succeeding submission strays
+stonewall stogie stepdaughter stalls stace squint spouses splashed speakin sounder sorrier sorrel
+sorcerer sombrero solemnly softened socialist snobs snippy snare smoothing slump slimeball slaving
+sips singular silently sicily shiller shayne's shareholders shakedown sensations seagulls scrying
+scrumptious screamin saucy santoses santos's sanctions roundup roughed rosary robechaux roadside
+riley's retrospect resurrected restoration reside researched rescind reproduce reprehensible repel
+rendering remodeling religions reconsidering reciprocate ratchet rambaldi's railroaded raccoon quasi
+psychics psat promos proclamation problem's prob'ly pristine printout priestess prenuptial
+prediction precedes pouty potter's phoning petersburg peppy pariah parched parcel panes overloaded
+overdoing operators oldies obesity nymphs nother notebooks nook nikolai nearing nearer mutation
+municipal monstrosity minister's milady mieke mephesto memory's melissa's medicated marshals manilow
+mammogram mainstream madhouse m'lady luxurious luck's lucas's lotsa loopy logging liquids lifeboat
+lesion lenient learner lateral laszlo larva kross kinks jinxed involuntary inventor interim
+insubordination inherent ingrate inflatable independently incarnate inane imaging hypoglycemia
+huntin humorous humongous hoodlum honoured honking hitler's hemorrhage helpin hearing's hathor
+hatching hangar halftime guise guggenheim grrr grotto grandson's grandmama gorillas godless girlish
+ghouls gershwin frosted friday's forwards flutter flourish flagpole finely finder's fetching fatter
+fated faithfully faction fabrics exposition expo exploits exert exclude eviction everwood's evasion
+espn escorting escalate enticing enroll enhancement endowed enchantress emerging elopement drills
+drat downtime downloading dorks doorways doctorate divulge dissociative diss disgraceful
+disconcerting dirtbag deteriorating deteriorate destinies depressive dented denim defeating decruz
+decidedly deactivate daydreams czar curls culprit cues crybaby cruelest critique crippling cretin
+cranberries cous coupled corvis copped convicts converts contingent contests complement commend
+commemorate combinations coastguard cloning cirque churning chock chivalry chemotherapy charlotte's
+chancellor's catalogues cartwheels carpets carols canister camera's buttered bureaucratic bundt
+buljanoff bubbling brokers broaden brimstone brainless borneo bores boing bodied billie's biceps
+beijing bead badmouthing bad's avec autopilot attractions attire atoms atheist ascertain
+artificially archbishop aorta amps ampata amok alloy allied allenby align albeit aired aint
+adjoining accosted abyss absolve aborted aaagh aaaaaah your's yonder yellin yearly wyndham
+wrongdoing woodsboro wigging whup wasteland warranty waltzed walnuts wallace's vividly vibration
+verses veggie variation validation unnecessarily unloaded unicorns understated undefeated unclean
+umbrellas tyke twirling turpentine turnover tupperware tugger triangles triage treehouse tract toil
+tidbit tickled thud threes thousandth thingie terminally temporal teething tassel talkies
+syndication syllables swoon switchboard swerved suspiciously superiority successor subsequentlyne
+subsequent subscribe strudel stroking strictest steven's stensland stefan's starsky starin stannart
+squirming squealing sorely solidarity softie snookums sniveling snail smidge smallpox sloth slab
+skulking singled simian silo sightseeing siamese shudder shoppers shax sharpen shannen semtex
+sellout secondhand season's seance screenplay scowl scorn scandals santiago's safekeeping sacked
+russe rummage rosie's roshman roomies roaches rinds retrace retires resuscitate restrained
+residential reservoir rerun reputations rekall rejoin refreshment reenactment recluse ravioli raves
+ranked rampant rama rallies raking purses punishable punchline puked provincial prosky prompted
+processor previews prepares poughkeepsie poppins polluted placenta pissy petulant peterson's
+perseverance persecution pent peasants pears pawns patrols pastries partake paramount panky palate
+overzealous overthrow overs oswald's oskar originated orchids optical onset offenses obstructing
+objectively obituaries obedient obedience novice nothingness nitrate newer nets mwah musty mung
+motherly mooning monique's momentous moby mistaking mistakenly minutemen milos microchip meself
+merciless menelaus mazel mauser masturbate marsh's manufacturers mahogany lysistrata lillienfield
+likable lightweight liberate leveled letdown leer leeloo larynx lardass lainey lagged lab's klorel
+klan kidnappings keyed karmic jive jiggy jeebies isabel's irate iraqi iota iodine invulnerable
+investor intrusive intricate intimidation interestingly inserted insemination inquire innate
+injecting inhabited informative informants incorporation inclination impure impasse imbalance
+illiterate i'ma i'ii hurled hunts hispanic hematoma help's helen's headstrong harmonica hark
+handmade handiwork gymnasium growling governors govern gorky gook girdle getcha gesundheit gazing
+gazette garde galley funnel fred's fossils foolishly fondness flushing floris firearm ferocious
+feathered fateful fancies fakes faker expressway expire exec ever'body estates essentials eskimos
+equations eons enlightening energetic enchilada emmi emissary embolism elsinore ecklie drenched
+drazi doped dogging documentation doable diverse disposed dislikes dishonesty disengage discouraging
+diplomat diplomacy deviant descended derailed depleted demi deformed deflect defines defer defcon
+deactivated crips creditors counters corridors cordy's conversation's constellations congressmen
+congo complimenting colombian clubbing clog clint's clawing chromium chimes chicken's chews cheatin
+chaste ceremony's cellblock ceilings cece caving catered catacombs calamari cabbie bursts bullying
+bucking brulee brits brisk breezes brandon's bounces boudoir blockbuster binks better'n beluga
+bellied behrani behaves bedding battalion barriers banderas balmy bakersfield badmouth backers
+avenging atat aspiring aromatherapy armpit armoire anythin another's anonymously anniversaries
+alonzo's aftershave affordable affliction adrift admissible adieu activist acquittal yucky yearn
+wrongly wino whitter whirlpool wendigo watchdog wannabes walkers wakey vomited voicemail verb vans
+valedictorian vacancy uttered up's unwed unrequited unnoticed unnerving unkind unjust uniformed
+unconfirmed unadulterated unaccounted uglier tyler's twix turnoff trough trolley trampled tramell
+traci's tort toads titled timbuktu thwarted throwback thon thinker thimble tasteless tarantula
+tammy's tamale takeovers symposium symmetry swish supposing supporters suns sully streaking strands
+statutory starlight stargher starch stanzi stabs squeamish spokane splattered spiritually spilt sped
+speciality spacious soundtrack smacking slain slag slacking skywire skips skeet skaara simpatico
+shredding showin shortcuts shite shielding sheep's shamelessly serafine sentimentality sect
+secretary's seasick scientifically scholars schemer scandalous saturday's salts saks sainted rustic
+rugs riedenschneider ric's rhyming rhetoric revolt reversing revel retractor retards retaliation
+resurrect remiss reminiscing remanded reluctance relocating relied reiben regions regains refuel
+refresher redoing redheaded redeemed recycled reassured rearranged rapport qumar prowling
+promotional promoter preserving prejudices precarious powwow pondering plunger plunged pleasantville
+playpen playback pioneers physicians phlegm perfected pancreas pakistani oxide ovary output
+outbursts oppressed opal's ooohhh omoroca offed o'toole nurture nursemaid nosebleed nixon's necktie
+muttering munchies mucking mogul mitosis misdemeanor miscarried minx millionth migraines midler
+methane metabolism merchants medicinal margaret's manifestation manicurist mandelbaum manageable
+mambo malfunctioned mais magnesium magnanimous loudmouth longed lifestyles liddy lickety leprechauns
+lengthy komako koji's klute kennel kathy's justifying jerusalem israelis isle irreversible inventing
+invariably intervals intergalactic instrumental instability insinuate inquiring ingenuity
+inconclusive incessant improv impersonation impeachment immigrant id'd hyena humperdinck humm hubba
+housework homeland holistic hoffa hither hissy hippy hijacked hero's heparin hellooo heat's hearth
+hassles handcuff hairstyle hadda gymnastics guys'll gutted gulp gulls guard's gritty grievous
+gravitational graft gossamer gooder glory's gere gash gaming gambled galaxies gadgets fundamentals
+frustrations frolicking frock frilly fraser's francais foreseen footloose fondly fluent flirtation
+flinched flight's flatten fiscal fiercely felicia's fashionable farting farthest farming facade
+extends exposer exercised evading escrow errr enzymes energies empathize embryos embodiment ellsberg
+electromagnetic ebola earnings dulcinea dreamin drawbacks drains doyle's doubling doting doose's
+doose doofy dominated dividing diversity disturbs disorderly disliked disgusts devoid detox
+descriptions denominator demonstrating demeanor deliriously decode debauchery dartmouth d'oh
+croissant cravings cranked coworkers councilor council's convergence conventions consistency consist
+conquests conglomerate confuses confiscate confines confesses conduit compress committee's commanded
+combed colonel's coated clouding clamps circulating circa cinch chinnery celebratory catalogs
+carpenters carnal carla's captures capitan capability canin canes caitlin's cadets cadaver cable's
+bundys bulldozer buggers bueller bruno's breakers brazilian branded brainy booming bookstores
+bloodbath blister bittersweet biologist billed betty's bellhop beeping beaut beanstalk beady
+baudelaire bartenders bargains ballad backgrounds averted avatar's atmospheric assert assassinated
+armadillo archive appreciating appraised antlers anterior alps aloof allowances alleyway agriculture
+agent's affleck acknowledging achievements accordion accelerator abracadabra abject zinc zilch yule
+yemen xanax wrenching wreath wouldn witted widely wicca whorehouse whooo whips westchester websites
+weaponry wasn walsh's vouchers vigorous viet victimized vicodin untested unsolicited unofficially
+unfocused unfettered unfeeling unexplainable uneven understaffed underbelly tutorial tuberculosis
+tryst trois trix transmitting trampoline towering topeka tirade thieving thang tentacles teflon
+teachings tablets swimmin swiftly swayzak suspecting supplying suppliers superstitions superhuman
+subs stubbornness structures streamers strattman stonewalling stimulate stiffs station's stacking
+squishy spout splice spec sonrisa smarmy slows slicing sisterly sierra's sicilian shrill shined
+shift's seniority seine seeming sedley seatbelts scour scold schoolyard scarring sash sark's salieri
+rustling roxbury richly rexy rex's rewire revved retriever respective reputable repulsed repeats
+rendition remodel relocated reins reincarnation regression reconstruction readiness rationale rance
+rafters radiohead radio's rackets quarterly quadruple pumbaa prosperous propeller proclaim probing
+privates pried prewedding premeditation posturing posterity posh pleasurable pizzeria pish piranha
+pimps penmanship penchant penalties pelvis patriotism pasa papaya packaging overturn overture
+overstepped overcoat ovens outsmart outed orient ordained ooohh oncologist omission olly offhand
+odour occurring nyazian notarized nobody'll nightie nightclubs newsweek nesting navel nationwide
+nabbed naah mystique musk mover mortician morose moratorium monster's moderate mockingbird mobsters
+misconduct mingling mikey's methinks metaphysical messengered merge merde medallion mathematical
+mater mason's masochist martouf martians marinara manray manned mammal majorly magnifying mackerel
+mabel's lyme lurid lugging lonnegan loathsome llantano liszt listings limiting liberace leprosy
+latinos lanterns lamest laferette ladybird kraut kook kits kipling joyride inward intestine
+innocencia inhibitions ineffectual indisposed incurable incumbent incorporated inconvenienced
+inanimate improbable implode idea's hypothesis hydrant hustling hustled huevos how'm horseshoe hooey
+hoods honcho hinge hijack heroism hermit heimlich harvesting hamunaptra haladki haiku haggle haaa
+gutsy grunting grueling grit grifter grievances gribbs greevy greeted green's grandstanding
+godparents glows glistening glider gimmick genocide gaping fraiser formalities foreigner forecast
+footprint folders foggy flaps fitty fiends femmes fearful fe'nos favours fabio eyeing extort
+experimentation expedite escalating erect epinephrine entitles entice enriched enable emissions
+eminence eights ehhh educating eden's earthquakes earthlings eagerly dunville dugout draining
+doublemeat doling disperse dispensing dispatches dispatcher discoloration disapproval diners dieu
+diddly dictates diazepam descendants derogatory deposited delights defies decoder debates dealio
+danson cutthroat crumbles crud croissants crematorium craftsmanship crafted could'a correctional
+cordless cools contradiction constitute conked confine concealing composite complicates communique
+columbian cockamamie coasters clusters clobbered clipping clipboard clergy clemenza cleanser
+circumcision cindy's chisel character's chanukah certainaly centerpiece cellmate cartoonist cancels
+cadmium buzzed busiest bumstead bucko browsing broth broader break's braver boundary boggling
+bobbing blurred birkhead bethesda benet belvedere bellies begrudge beckworth bebe's banky baldness
+bagpipes baggy babysitters aversion auxiliary attributes attain astonished asta assorted aspirations
+arnold's area's appetites apparel apocalyptic apartment's announcer angina amiss ambulances allo
+alleviate alibis algeria alaskan airway affiliated aerial advocating adrenalin admires adhesive
+actively accompanying zeta yoyou yoke yachts wreaked wracking woooo wooing wised winnie's wind's
+wilshire wedgie watson's warden's waging violets vincey victorious victories velcro vastly valves
+valley's uplifting untrustworthy unmitigated universities uneventful undressing underprivileged
+unburden umbilical twigs tweet tweaking turquoise trustees truckers trimmed triggering treachery
+trapping tourism tosses torching toothpick toga toasty toasts tiamat thickens ther tereza tenacious
+temperament televised teldar taxis taint swill sweatin sustaining surgery's surgeries succeeds
+subtly subterranean subject's subdural streep stopwatch stockholder stillwater steamer stang's
+stalkers squished squeegee splinters spliced splat spied specialized spaz spackle sophistication
+snapshots smoky smite sluggish slithered skin's skeeters sidewalks sickly shrugs shrubbery shrieking
+shitless shithole settin servers serge sentinels selfishly segments scarcely sawdust sanitation
+sangria sanctum samantha's sahjhan sacrament saber rustle rupture rump roving rousing rosomorf
+rosario's rodents robust rigs riddled rhythms revelations restart responsibly repression reporter's
+replied repairing renoir remoray remedial relocation relies reinforcement refundable redirect
+recheck ravenwood rationalizing ramus ramsey's ramelle rails radish quivering pyjamas puny psychos
+prussian provocations prouder protestors protesters prohibited prohibit progression prodded
+proctologist proclaimed primordial pricks prickly predatory precedents praising pragmatic powerhouse
+posterior postage porthos populated poly pointe pivotal pinata persistence performers pentangeli
+pele pecs pathetically parka parakeet panicky pandora's pamphlets paired overthruster outsmarted
+ottoman orthopedic oncoming oily offing nutritious nuthouse nourishment nietzsche nibbling newlywed
+newcomers need's nautilus narcissist myths mythical mutilation mundane mummy's mummies mumble mowed
+morvern mortem mortal's mopes mongolian molasses modification misplace miscommunication miney
+militant midlife mens menacing memorizing memorabilia membrane massaging masking maritime mapping
+manually magnets ma's luxuries lows lowering lowdown lounging lothario longtime liposuction
+lieutenant's lidocaine libbets lewd levitate leslie's leeway lectured lauren's launcher launcelot
+latent larek lagos lackeys kumbaya kryptonite knapsack keyhole kensington katarangura kann junior's
+juiced jugs joyful jihad janitor's jakey ironclad invoice intertwined interlude interferes
+insurrection injure initiating infernal india's indeedy incur incorrigible incantations imprint
+impediment immersion immensely illustrate ike's igloo idly ideally hysterectomy hyah house's hour's
+hounded hooch honeymoon's hollering hogs hindsight highs high's hiatus helix heirs heebie havesham
+hassan's hasenfuss hankering hangers hakuna gutless gusto grubbing grrrr greg's grazed gratification
+grandeur gorak godammit gnawing glanced gladiators generating galahad gaius furnished funeral's
+fundamentally frostbite frees frazzled fraulein fraternizing fortuneteller formaldehyde followup
+foggiest flunky flickering flashbacks fixtures firecrackers fines filly figger fetuses fella's
+feasible fates eyeliner extremities extradited expires experimented exiting exhibits exhibited exes
+excursion exceedingly evaporate erupt equilibrium epileptic ephram's entrails entities emporium
+egregious eggshells easing duwayne drone droll dreyfuss drastically dovey doubly doozy donkeys donde
+dominate distrust distributing distressing disintegrate discreetly disagreements diff dick's devised
+determines descending deprivation delegate dela degradation decision's decapitated dealin deader
+dashed darkroom dares daddies dabble cycles cushy currents cupcakes cuffed croupier croak criticized
+crapped coursing cornerstone copyright coolers continuum contaminate cont consummated construed
+construct condos concoction compulsion committees commish columnist collapses coercion coed coastal
+clemency clairvoyant circulate chords chesterton checkered charlatan chaperones categorically
+cataracts carano capsules capitalize cache butcher's burdon bullshitting bulge buck's brewed
+brethren bren breathless breasted brainstorming bossing borealis bonsoir bobka boast blimp bleu
+bleep bleeder blackouts bisque binford's billboards bernie's beecher's beatings bayberry bashed
+bartlet's bapu bamboozled ballon balding baklava baffled backfires babak awkwardness attributed
+attest attachments assembling assaults asphalt arthur's arthritis armenian arbitrary apologizes
+anyhoo antiquated alcante agency's advisable advertisement adventurer abundance aahhh aaahh zatarc
+yous york's yeti yellowstone yearbooks yakuza wuddya wringing woogie womanhood witless winging
+whatsa wetting wessex wendy's way's waterproof wastin washington's wary voom volition volcanic
+vogelman vocation visually violinist vindicated vigilance viewpoint vicariously venza vasily
+validity vacuuming utensils uplink unveil unloved unloading uninhibited unattached ukraine typo
+tweaked twas turnips tunisia tsch trinkets tribune transmitters translator train's toured toughen
+toting topside topical toothed tippy tides theology terrors terrify tentative technologically
+tarnish target's tallest tailored tagliati szpilman swimmers swanky susie's surly supple sunken
+summation suds suckin substantially structured stockholm stepmom squeaking springfield's spooks
+splashmore spanked souffle solitaire solicitation solarium smooch smokers smog slugged slobbering
+skylight skimpy situated sinuses simplify silenced sideburns sid's shutdown shrinkage shoddy shhhhhh
+shelling shelled shareef shangri shakey's seuss servicing serenade securing scuffle scrolls scoff
+scholarships scanners sauerkraut satisfies satanic sars sardines sarcophagus santino sandi's salvy
+rusted russells ruby's rowboat routines routed rotating rolfsky ringside rigging revered retreated
+respectability resonance resembling reparations reopened renewal renegotiate reminisce reluctantly
+reimburse regimen regaining rectum recommends recognizable realism reactive rawhide rappaport's
+raincoat quibble puzzled pursuits purposefully puns pubic psychotherapy prosecution's proofs
+proofing professor's prevention prescribing prelim positioning pore poisons poaching pizza's
+pertaining personalized personable peroxide performs pentonville penetrated peggy's payphone payoffs
+participated park's parisian palp paleontology overhaul overflowing organised oompa ojai offenders
+oddest objecting o'hare o'daniel notches noggin nobody'd nitrogen nightstand niece's nicky's
+neutralized nervousness nerdy needlessly navigational narrative narc naquadah nappy nantucket nambla
+myriad mussolini mulberry mountaineer mound motherfuckin morrie monopolizing mohel mistreated
+misreading misbehave miramax minstrel minivan milligram milkshakes milestone middleweight
+michelangelo metamorphosis mesh medics mckinnon's mattresses mathesar matchbook matata marys marco's
+malucci majored magilla magic's lymphoma lowers lordy logistics linens lineage lindenmeyer limelight
+libel leery's leased leapt laxative lather lapel lamppost laguardia labyrinth kindling key's kegs
+kegger kawalsky juries judo jokin jesminder janine's izzy israeli interning insulation
+institutionalized inspected innings innermost injun infallible industrious indulgence indonesia
+incinerator impossibility imports impart illuminate iguanas hypnotic hyped huns housed hostilities
+hospitable hoses horton's homemaker history's historian hirschmuller highlighted hideout helpers
+headset guardianship guapo guantanamo grubby greyhound grazing granola granddaddy gotham's goren
+goblet gluttony glucose globes giorno gillian's getter geritol gassed gang's gaggle freighter
+freebie frederick's fractures foxhole foundations fouled foretold forcibly folklore floorboards
+floods floated flippers flavour flaked firstly fireflies feedings fashionably fascism farragut
+fallback factions facials exterminate exited existent exiled exhibiting excites everything'll evenin
+evaluated ethically entree entirety ensue enema empath embryo eluded eloquently elle eliminates
+eject edited edema echoes earns dumpling drumming droppings drazen's drab dolled doll's doctrine
+distasteful disputing disputes displeasure disdain disciples diamond's develops deterrent detection
+dehydration defied defiance decomposing debated dawned darken daredevil dailies cyst custodian
+crusts crucifix crowning crier crept credited craze crawls coveted couple's couldn corresponding
+correcting corkmaster copperfield cooties coopers cooperated controller contraption consumes
+constituents conspire consenting consented conquers congeniality computerized compute completes
+complains communicator communal commits commendable colonels collide coladas colada clout clooney
+classmate classifieds clammy claire's civility cirrhosis chink chemically characterize censor
+catskills cath caterpillar catalyst carvers carts carpool carelessness career's cardio carbs
+captivity capeside's capades butabi busmalis bushel burping buren burdens bunks buncha bulldozers
+browse brockovich bria breezy breeds breakthroughs bravado brandy's bracket boogety bolshevik
+blossoms bloomington blooming bloodsucker blockade blight blacksmith betterton betrayer bestseller
+bennigan's belittle beeps bawling barts bartending barbed bankbooks back's babs babish authors
+authenticity atropine astronomical assertive arterial armbrust armageddon aristotle arches anyanka
+annoyance anemic anck anago ali's algiers airways airwaves air's aimlessly ails ahab afflicted
+adverse adhere accuracy aaargh aaand zest yoghurt yeast wyndham's writings writhing woven workable
+winking winded widen whooping whiter whip's whatya whacko we's wazoo wasp waived vlad virile vino
+vic's veterinary vests vestibule versed venetian vaughn's vanishes vacancies urkel upwards uproot
+unwarranted unscheduled unparalleled undertaking undergrad tweedle turtleneck turban trickery
+travolta transylvania transponder toyed townhouse tonto toed tion tier thyself thunderstorm thnk
+thinning thinkers theatres thawed tether tempus telegraph technicalities tau'ri tarp tarnished
+tara's taggert's taffeta tada tacked systolic symbolize swerve sweepstakes swami swabs suspenders
+surfers superwoman sunsets sumo summertime succulent successes subpoenas stumper stosh stomachache
+stewed steppin stepatech stateside starvation staff's squads spicoli spic sparing soulless soul's
+sonnets sockets snit sneaker snatching smothering slush sloman slashing sitters simpson's simpleton
+signify signal's sighs sidra sideshow sickens shunned shrunken showbiz shopped shootings shimmering
+shakespeare's shagging seventeenth semblance segue sedation scuzzlebutt scumbags scribble screwin
+scoundrels scarsdale scamp scabs saucers sanctioned saintly saddened runaways runaround rumored
+rudimentary rubies rsvp rots roman's ripley's rheya revived residing resenting researcher repertoire
+rehashing rehabilitated regrettable regimental refreshed reese's redial reconnecting rebirth
+ravenous raping ralph's railroads rafting rache quandary pylea putrid punitive puffing psychopathic
+prunes protests protestant prosecutors proportional progressed prod probate prince's primate
+predicting prayin practitioner possessing pomegranate polgara plummeting planners planing plaintiffs
+plagues pitt's pithy photographer's philharmonic petrol perversion personals perpetrators perm
+peripheral periodic perfecto perched pees peeps pedigree peckish pavarotti partnered palette pajama
+packin pacifier oyez overstepping outpatient optimum okama obstetrician nutso nuance noun noting
+normalcy normal's nonnegotiable nomak nobleman ninny nines nicey newsflash nevermore neutered nether
+nephew's negligee necrosis nebula navigating narcissistic namesake mylie muses munitions
+motivational momento moisturizer moderation mmph misinformed misconception minnifield mikkos
+methodical mechanisms mebbe meager maybes matchmaking masry markovic manifesto malakai madagascar
+m'am luzhin lusting lumberjack louvre loopholes loaning lightening liberals lesbo leotard leafs
+leader's layman's launder lamaze kubla kneeling kilo kibosh kelp keith's jumpsuit joy's jovi joliet
+jogger janover jakovasaurs irreparable intervened inspectors innovation innocently inigo infomercial
+inexplicable indispensable indicative incognito impregnated impossibly imperfect immaculate
+imitating illnesses icarus hunches hummus humidity housewives houmfort hothead hostiles hooves
+hoopla hooligans homos homie hisself himalayas hidy hickory heyyy hesitant hangout handsomest
+handouts haitian hairless gwennie guzzling guinevere grungy grunge grenada gout gordon's goading
+gliders glaring geology gems gavel garments gardino gannon's gangrene gaff gabrielle's fundraising
+fruitful friendlier frequencies freckle freakish forthright forearm footnote footer foot's flops
+flamenco fixer firm's firecracker finito figgered fezzik favourites fastened farfetched fanciful
+familiarize faire failsafe fahrenheit fabrication extravaganza extracted expulsion exploratory
+exploitation explanatory exclusion evolutionary everglades evenly eunuch estas escapade erasers
+entries enforcing endorsements enabling emptying emperor's emblem embarassing ecosystem ebby ebay
+dweeb dutiful dumplings drilled drafty doug's dolt dollhouse displaced dismissing disgraced
+discrepancies disbelief disagreeing disagreed digestion didnt deviled deviated deterioration
+departmental departing demoted demerol delectable deco decaying decadent dears daze dateless
+d'algout cultured cultivating cryto crusades crumpled crumbled cronies critters crew's crease craves
+cozying cortland corduroy cook's consumers congratulated conflicting confidante condensed
+concessions compressor compressions compression complicating complexity compadre communicated coerce
+coding coating coarse clown's clockwise clerk's classier clandestine chums chumash christopher's
+choreography choirs chivalrous chinpoko chilean chihuahua cheerio charred chafing celibacy casts
+caste cashier's carted carryin carpeting carp carotid cannibals candor caen cab's butterscotch busts
+busier bullcrap buggin budding brookside brodski bristow's brig bridesmaid's brassiere brainwash
+brainiac botrelle boatload blimey blaring blackness bipolar bipartisan bins bimbos bigamist biebe
+biding betrayals bestow bellerophon beefy bedpans battleship bathroom's bassinet basking basin
+barzini barnyard barfed barbarian bandit balances baker's backups avid augh audited attribute
+attitudes at's astor asteroids assortment associations asinine asalaam arouse architects aqua
+applejack apparatus antiquities annoys angela's anew anchovies anchors analysts ampule
+alphabetically aloe allure alameida aisles airfield ahah aggressively aggravate aftermath
+affiliation aesthetic advertised advancing adept adage accomplices accessing academics aagh zoned
+zoey's zeal yokel y'ever wynant's wringer witwer withdrew withdrawing withdrawals windward wimbledon
+wily willfully whorfin whimsical whimpering welding weddin weathered wealthiest weakening warmest
+wanton waif volant vivo vive visceral vindication vikram vigorously verification veggies urinate
+uproar upload unwritten unwrap unsung unsubstantiated unspeakably unscrupulous unraveling unquote
+unqualified unfulfilled undetectable underlined unconstitutional unattainable unappreciated ummmm
+ulcers tylenol tweak tutu turnin turk's tucker's tuatha tropez trends trellis traffic's torque
+toppings tootin toodles toodle tivo tinkering thursday's thrives thorne's thespis thereafter
+theatrics thatherton texts testicle terr tempers teammates taxpayer tavington tampon tackling
+systematic syndicated synagogue swelled sweeney's sutures sustenance surfaces superstars sunflowers
+sumatra sublet subjective stubbins strutting strewn streams stowaway stoic sternin stereotypes
+steadily star's stalker's stabilizing sprang spotter spiraling spinster spell's speedometer
+specified speakeasy sparked soooo songwriter soiled sneakin smithereens smelt smacks sloan's
+slaughterhouse slang slacks skids sketching skateboards sizzling sixes sirree simplistic sift side's
+shouts shorted shoelace sheeit shaw's shards shackled sequestered selmak seduces seclusion seasonal
+seamstress seabeas scry scripted scotia scoops scooped schillinger's scavenger saturation satch
+salaries safety's s'more s'il rudeness rostov romanian romancing robo robert's rioja rifkin rieper
+revise reunions repugnant replicating replacements repaid renewing remembrance relic relaxes
+rekindle regulate regrettably registering regenerate referenced reels reducing reconstruct reciting
+reared reappear readin ratting rapes rancho rancher rammed rainstorm railroading queers punxsutawney
+punishes pssst prudy proudest protectors prohibits profiling productivity procrastinating procession
+proactive priss primaries potomac postmortem pompoms polio poise piping pickups pickings physiology
+philanthropist phenomena pheasant perfectionist peretti people'll peninsula pecking peaks pave
+patrolman participant paralegal paragraphs paparazzi pankot pampering pain's overstep overpower
+ovation outweigh outlawed orion's openness omnipotent oleg okra okie odious nuwanda nurtured niles's
+newsroom netherlands nephews neeson needlepoint necklaces neato nationals muggers muffler mousy
+mourned mosey morn mormon mopey mongolians moldy moderately modelling misinterpret minneapolis
+minion minibar millenium microfilm metals mendola mended melissande me's mathematician masturbating
+massacred masbath marler's manipulates manifold malp maimed mailboxes magnetism magna m'lord m'honey
+lymph lunge lull luka lt's lovelier loser's lonigan's lode locally literacy liners linear lefferts
+leezak ledgers larraby lamborghini laloosh kundun kozinski knockoff kissin kiosk khasinau's kennedys
+kellman karlo kaleidoscope jumble juggernaut joseph's jiminy jesuits jeffy jaywalking jailbird itsy
+irregularities inventive introduces interpreter instructing installing inquest inhabit infraction
+informer infarction incidence impulsively impressing importing impersonated impeach idiocy hyperbole
+hydra hurray hungary humped huhuh hsing hotspot horsepower hordes hoodlums honky hitchhiker hind
+hideously henchmen heaving heathrow heather's heathcliff healthcare headgear headboard hazing
+hawking harem handprint halves hairspray gutiurrez greener grandstand goosebumps good's gondola gnaw
+gnat glitches glide gees gasping gases garrison's frolic fresca freeways frayed fortnight fortitude
+forgetful forefathers foley's foiled focuses foaming flossing flailing fitzgeralds firehouse finders
+filmmakers fiftieth fiddler fellah feats fawning farquaad faraway fancied extremists extremes
+expresses exorcist exhale excel evaluations ethros escalated epilepsy entrust enraged ennui
+energized endowment encephalitis empties embezzling elster ellie's ellen's elixir electrolytes
+elective elastic edged econ eclectic eagle's duplex dryers drexl dredging drawback drafting don'ts
+docs dobisch divorcee ditches distinguishing distances disrespected disprove disobeying disobedience
+disinfectant discs discoveries dips diplomas dingy digress dignitaries digestive dieting
+dictatorship dictating devoured devise devane's detonators detecting desist deserter derriere deron
+derive derivative delegates defects defeats deceptive debilitating deathwok dat's darryl's dago
+daffodils curtsy cursory cuppa cumin cultivate cujo cubic cronkite cremation credence cranking
+coverup courted countin counselling cornball converting contentment contention contamination
+consortium consequently consensual consecutive compressed compounds compost components comparative
+comparable commenting color's collections coleridge coincidentally cluett cleverly cleansed
+cleanliness clea clare's citizen's chopec chomp cholera chins chime cheswick chessler cheapest
+chatted cauliflower catharsis categories catchin caress cardigan capitalism canopy cana camcorder
+calorie cackling cabot's bystanders buttoned buttering butted buries burgel bullpen buffoon brogna
+brah bragged boutros boosted bohemian bogeyman boar blurting blurb blowup bloodhound blissful
+birthmark biotech bigot bestest benefited belted belligerent bell's beggin befall beeswax beer's
+becky's beatnik beaming bazaar bashful barricade banners bangers baja baggoli badness awry awoke
+autonomy automobiles attica astoria assessing ashram artsy artful aroun armpits arming arithmetic
+annihilate anise angiogram andre's anaesthetic amorous ambiguous ambiance alligators afforded
+adoration admittance administering adama aclu abydos absorption zonked zhivago zealand zazu
+youngster yorkin wrongfully writin wrappers worrywart woops wonderfalls womanly wickedness wichita
+whoopie wholesale wholeheartedly whimper which'll wherein wheelchairs what'ya west's wellness
+welcomes wavy warren's warranted wankers waltham wallop wading wade's wacked vogue virginal vill
+vets vermouth vermeil verger verbs verbally ventriss veneer vecchio's vampira utero ushers urgently
+untoward unshakable unsettled unruly unrest unmanned unlocks unified ungodly undue undermined
+undergoing undergo uncooperative uncontrollably unbeatable twitchy tunh tumbler tubs truest
+troublesome triumphs triplicate tribbey trent's transmissions tortures torpedoes torah tongaree
+tommi tightening thunderbolt thunderbird thorazine thinly theta theres testifies terre teenaged
+technological tearful taxing taldor takashi tach symbolizes symbolism syllabus swoops swingin swede
+sutra suspending supplement sunday's sunburn succumbed subtitled substituting subsidiary subdued
+stuttering stupor stumps strummer strides strategize strangulation stooped stipulation stingy stigma
+stewart's statistic startup starlet stapled squeaks squawking spoilsport splicing spiel spencers
+specifications spawned spasms spaniard sous softener sodding soapbox snow's smoldering smithbauer
+slogans slicker slasher skittish skepticism simulated similarity silvio signifies signaling sifting
+sickest sicilians shuffling shrivel shortstop sensibility sender seminary selecting segretti seeping
+securely scurrying scrunch scrote screwups schoolteacher schibetta's schenkman sawing savin satine
+saps sapiens salvaging salmonella safeguard sacrilege rumpus ruffle rube routing roughing rotted
+roshman's rondall road's ridding rickshaw rialto rhinestone reversible revenues retina restrooms
+resides reroute requisite repress replicate repetition removes relationship's regent regatta
+reflective rednecks redeeming rectory recordings reasoned rayed ravell raked rainstorm's raincheck
+raids raffi racked query quantities pushin prototypes proprietor promotes prometheus promenade
+projectile progeny profess prodding procure primetime presuming preppy prednisone predecessor potted
+posttraumatic poppies poorhouse pool's polaroid podiatrist plucky plowed pledging playroom playhouse
+play's plait placate pitchfork pissant pinback picketing photographing pharoah petrak petal
+persecuting perchance penny's pellets peeved peerless payable pauses pathways pathologist pat's
+parchment papi pagliacci owls overwrought overwhelmingly overreaction overqualified overheated
+outward outlines outcasts otherworldly originality organisms opinionated oodles oftentimes octane
+occured obstinate observatory o'er nutritionist nutrition numbness nubile notification notary
+nooooooo nodes nobodies nepotism neighborhoods neanderthals musicals mushu murphy's multimedia mucus
+mothering mothballs monogrammed monk's molesting misspoke misspelled misconstrued miscellaneous
+miscalculated minimums mince mildew mighta middleman metabolic messengers mementos mellowed meditate
+medicare mayol maximilian mauled massaged marmalade mardi mannie mandates mammals malaysia makings
+major's maim lundegaard lovingly lout louisville loudest lotto loosing loompa looming longs lodging
+loathes littlest littering linebacker lifelike li'l legalities lavery's laundered lapdog lacerations
+kopalski knobs knitted kittridge kidnaps kerosene katya karras jungles juke joes jockeys jeremy's
+jefe janeiro jacqueline's ithaca irrigation iranoff invoices invigorating intestinal interactive
+integration insolence insincere insectopia inhumane inhaling ingrates infrastructure infestation
+infants individuality indianapolis indeterminate indefinite inconsistent incomprehensible inaugural
+inadequacy impropriety importer imaginations illuminating ignited ignite iggy i'da hysterics
+hypodermic hyperventilate hypertension hyperactive humoring hotdogs honeymooning honed hoist
+hoarding hitching hinted hill's hiker hijo hightail highlands hemoglobin helo hell'd heinie hanoi
+hags gush guerrillas growin grog grissom's gregory's grasped grandparent granddaughters gouged
+goblins gleam glades gigantor get'em geriatric geared gawk gawd gatekeeper gargoyles gardenias
+garcon garbo gallows gabe's gabby's gabbing futon fulla frightful freshener freedoms fountains
+fortuitous formulas forceps fogged fodder foamy flogging flaun flared fireplaces firefighters fins
+filtered feverish favell fattest fattening fate's fallow faculties fabricated extraordinaire
+expressly expressive explorers evade evacuating euclid ethanol errant envied enchant enamored enact
+embarking election's egocentric eeny dussander dunwitty dullest dru's dropout dredged dorsia
+dormitory doot doornail dongs dogged dodgy do's ditty dishonorable discriminating discontinue dings
+dilly diffuse diets dictation dialysis deteriorated delly delightfully definitions decreased
+declining deadliest daryll dandruff cynthia's cush cruddy croquet crocodiles cringe crimp credo
+cranial crackling coyotes courtside coupling counteroffer counterfeiting corrupting corrective
+copter copping conway's conveyor contusions contusion conspirator consoling connoisseur conjecture
+confetti composure competitor compel commanders coloured collector's colic coldest coincide coddle
+cocksuckers coax coattails cloned cliff's clerical claustrophobia classrooms clamoring civics churn
+chugga chromosomes christened chopper's chirping chasin characterized chapped chalkboard centimeter
+caymans catheter caspian casings cartilage carlton's card's caprica capelli cannolis cannoli canals
+campaigns camogli camembert butchers butchered busboys bureaucrats bungalow buildup budweiser
+buckled bubbe brownstone bravely brackley bouquets botox boozing boosters bodhi blunders blunder
+blockage blended blackberry bitch's birthplace biocyte biking bike's betrays bestowed bested
+beryllium beheading beginner's beggar begbie beamed bayou bastille bask barstool barricades baron's
+barbecues barbecued barb's bandwagon bandits ballots ballads backfiring bacarra avoidance avenged
+autopsies
+`)
+
+// commonNames are the first names and the 10000 most frequent surnames of the 1990 US census,
+// ranked by frequency. The male names, female names and surnames of the same rank are interleaved.
+var commonNames = strings.Fields(`
+james mary smith john patricia johnson robert linda williams michael barbara jones william elizabeth
+brown david jennifer davis richard maria miller charles susan wilson joseph margaret moore thomas
+dorothy taylor christopher lisa anderson daniel nancy jackson paul karen white mark betty harris
+donald helen martin george sandra thompson kenneth donna garcia steven carol martinez edward ruth
+robinson brian sharon clark ronald michelle rodriguez anthony laura lewis kevin sarah lee jason
+kimberly walker matthew deborah hall gary jessica allen timothy shirley young jose cynthia hernandez
+larry angela king jeffrey melissa wright frank brenda lopez scott amy hill eric anna green stephen
+rebecca adams andrew virginia baker raymond kathleen gonzalez gregory pamela nelson joshua martha
+carter jerry debra mitchell dennis amanda perez walter stephanie roberts patrick carolyn turner
+peter christine phillips harold marie campbell douglas janet parker henry catherine evans carl
+frances edwards arthur ann collins ryan joyce stewart roger diane sanchez joe alice morris juan
+julie rogers jack heather reed albert teresa cook jonathan doris morgan justin gloria bell terry
+evelyn murphy gerald jean bailey keith cheryl rivera samuel mildred cooper willie katherine
+richardson ralph joan cox lawrence ashley howard nicholas judith ward roy rose torres benjamin
+janice peterson bruce kelly gray brandon nicole ramirez adam judy watson harry christina brooks fred
+kathy sanders wayne theresa price billy beverly bennett steve denise wood louis tammy barnes jeremy
+irene ross aaron jane henderson randy lori coleman eugene rachel jenkins carlos marilyn perry
+russell andrea powell bobby kathryn long victor louise patterson ernest sara hughes phillip anne
+flores todd jacqueline washington jesse wanda butler craig bonnie simmons alan julia foster shawn
+ruby gonzales clarence lois bryant sean tina alexander philip phyllis griffin chris norma diaz
+johnny paula hayes earl diana myers jimmy annie ford antonio lillian hamilton danny emily graham
+bryan robin sullivan tony peggy wallace luis crystal woods mike gladys cole stanley rita west
+leonard dawn owens nathan connie reynolds dale florence fisher manuel tracy ellis rodney edna
+harrison curtis tiffany gibson norman carmen mcdonald marvin rosa cruz vincent cindy marshall glenn
+grace ortiz jeffery wendy gomez travis victoria murray jeff edith freeman chad kim wells jacob
+sherry webb melvin sylvia simpson alfred josephine stevens kyle thelma tucker francis shannon porter
+bradley sheila hicks jesus ethel crawford herbert ellen boyd frederick elaine mason ray marjorie
+morales joel carrie kennedy edwin charlotte warren don monica dixon eddie esther ramos ricky pauline
+reyes troy emma burns randall juanita gordon barry anita shaw bernard rhonda holmes mario hazel rice
+leroy amber robertson francisco eva hunt marcus debbie daniels micheal april palmer theodore leslie
+mills clifford clara nichols miguel lucille grant oscar jamie ferguson jay joanne stone jim eleanor
+hawkins tom valerie dunn calvin danielle perkins alex megan hudson jon alicia spencer ronnie suzanne
+gardner bill michele stephens lloyd gail payne tommy bertha pierce leon darlene berry derek veronica
+matthews darrell jill arnold jerome erin wagner floyd geraldine willis leo lauren watkins alvin
+cathy olson tim joann carroll wesley lorraine duncan dean lynn snyder greg sally hart jorge regina
+cunningham dustin erica lane pedro beatrice andrews derrick dolores ruiz dan bernice harper zachary
+audrey fox corey yvonne riley herman annette armstrong maurice june carpenter vernon marion weaver
+roberto dana greene clyde stacy elliott glen ana chavez hector renee sims shane ida peters ricardo
+vivian kelley sam roberta franklin rick holly lawson lester brittany fields brent melanie gutierrez
+ramon loretta schmidt tyler yolanda carr gilbert jeanette vasquez gene laurie castillo marc katie
+wheeler reginald kristen chapman ruben vanessa oliver brett alma montgomery angel sue richards
+nathaniel elsie williamson rafael beth johnston edgar jeanne banks milton vicki meyer raul carla
+bishop ben tara mccoy cecil rosemary howell duane eileen alvarez andre terri morrison elmer gertrude
+hansen brad lucy fernandez gabriel tonya garza ron ella burton roland stacey nguyen jared wilma
+jacobs adrian gina reid karl kristin fuller cory jessie lynch claude natalie garrett erik agnes
+romero darryl vera welch neil charlene larson christian bessie frazier javier delores burke fernando
+melinda hanson clinton pearl mendoza ted arlene moreno mathew maureen bowman tyrone colleen medina
+darren allison fowler lonnie tamara brewer lance joy hoffman cody georgia carlson julio constance
+silva kurt lillie pearson allan claudia holland clayton jackie fleming hugh marcia jensen max tanya
+vargas dwayne nellie byrd dwight minnie davidson armando marlene hopkins felix heidi may jimmie
+glenda herrera everett lydia wade ian viola soto ken courtney walters bob marian neal jaime stella
+caldwell casey caroline lowe alfredo dora jennings alberto jo barnett dave vickie graves ivan mattie
+jimenez johnnie maxine horton sidney irma shelton byron mabel barrett julian marsha obrien isaac
+myrtle castro clifton lena sutton willard christy mckinney daryl deanna lucas virgil patsy miles
+andy hilda rodriquez salvador gwendolyn chambers kirk jennie holt sergio nora lambert seth margie
+fletcher kent nina watts terrance cassandra bates rene leah hale eduardo penny rhodes terrence kay
+pena enrique priscilla beck freddie naomi newman stuart carole haynes fredrick olga mcdaniel arturo
+billie mendez alejandro dianne bush joey tracey vaughn nick leona parks luther jenny dawson wendell
+felicia santiago jeremiah sonia norris evan miriam hardy julius velma steele donnie becky curry otis
+bobbie powers trevor violet schultz luke kristina barker homer toni guzman gerard misty page doug
+mae munoz kenny shelly ball hubert daisy keller angelo ramona chandler shaun sherri weber lyle erika
+walsh matt katrina lyons alfonso claire ramsey orlando lindsey wolfe rex lindsay schneider carlton
+geneva mullins ernesto guadalupe benson pablo belinda sharp lorenzo margarita bowen omar sheryl
+barber wilbur cora cummings blake faye hines horace ada baldwin roderick natasha griffith kerry
+sabrina valdez abraham isabel hubbard rickey marguerite salazar ira hattie reeves andres harriet
+warner cesar molly stevenson johnathan cecilia burgess malcolm kristi santos rudolph brandi tate
+damon blanche cross kelvin sandy garner rudy rosie mann preston joanna mack alton iris moss archie
+eunice thornton marco angie mcgee wm inez farmer pete lynda delgado randolph madeline aguilar garry
+amelia vega geoffrey alberta glover jonathon genevieve manning felipe monique cohen bennie jodi
+harmon gerardo janie rodgers ed kayla robbins dominic sonya newton loren jan blair delbert kristine
+higgins colin candace ingram guillermo fannie reese earnest maryann cannon benny opal strickland
+noel alison townsend rodolfo yvette potter myron melody goodwin edmund luz walton salvatore susie
+rowe cedric olivia hampton lowell flora ortega gregg shelley patton sherman kristy swanson devin
+mamie goodman sylvester lula maldonado roosevelt lola yates israel verna becker jermaine beulah
+erickson forrest antoinette hodges wilbert candice rios leland juana conner simon jeannette adkins
+irving pam webster owen kelli malone rufus whitney hammond woodrow bridget flowers kristopher karla
+cobb levi celia moody marcos latoya quinn gustavo patty pope lionel shelia osborne marty gayle
+mccarthy gilberto della guerrero clint vicky estrada nicolas lynne sandoval laurence sheri gibbs
+ismael marianne gross orville kara fitzgerald drew jacquelyn stokes ervin erma doyle dewey blanca
+saunders al myra wise wilfred leticia colon josh pat gill hugo krista alvarado ignacio roxanne greer
+caleb angelica padilla tomas robyn waters sheldon adrienne nunez erick rosalie ballard frankie
+alexandra schwartz darrel brooke mcbride rogelio bethany houston terence sadie christensen alonzo
+bernadette klein elias traci pratt bert jody briggs elbert kendra parsons ramiro nichole mclaughlin
+conrad rachael zimmerman noah mable french grady ernestine buchanan phil muriel moran cornelius
+marcella copeland lamar elena pittman rolando krystal brady clay angelina mccormick percy nadine
+holloway dexter kari brock bradford estelle poole merle dianna logan darin paulette bass amos lora
+marsh terrell mona drake moses doreen wong irvin rosemarie jefferson saul desiree park roman antonia
+morton darnell janis abbott randal betsy sparks tommie christie norton timmy freda huff darrin
+meredith massey brendan lynette figueroa toby teri carson van cristina bowers abel eula roberson
+dominick leigh barton emilio meghan tran elijah sophia lamb cary eloise harrington domingo rochelle
+boone aubrey gretchen cortez emmett cecelia clarke marlon raquel mathis emanuel henrietta singleton
+jerald alyssa wilkins edmond jana cain emil gwen underwood dewayne jenna hogan otto tricia mckenzie
+teddy laverne collier reynaldo olive luna bret tasha phelps jess silvia mcguire trent elvira bridges
+humberto delia wilkerson emmanuel kate nash stephan patti summers louie lorena atkins vicente kellie
+wilcox lamont sonja pitts garland lila conley micah lana marquez efrain darla burnett heath mindy
+cochran rodger essie chase demetrius mandy davenport ethan lorene hood eldon elsa gates rocky
+josefina ayala pierre jeannie sawyer eli miranda vazquez bryce dixie dickerson antoine lucia hodge
+robbie marta acosta kendall faith flynn royce lela espinoza sterling johanna nicholson grover shari
+monroe elton camille morrow cleveland tami whitaker dylan shawna oconnor chuck elisa skinner damian
+ebony ware reuben melba molina stan ora kirby leonardo nettie huffman russel tabitha gilmore erwin
+ollie dominguez benito winifred oneal hans kristie lang monte marina combs blaine alisha kramer
+ernie aimee hancock curt rena gallagher quentin myrna gaines agustin marla shaffer jamal tammie
+short devon latasha wiggins adolfo bonita mathews tyson patrice mcclain wilfredo ronda fischer bart
+sherrie wall jarrod addie small vance francine melton denis deloris hensley damien stacie bond
+joaquin adriana dyer harlan cheri grimes desmond abigail contreras elliot celeste wyatt darwin jewel
+baxter gregorio cara snow kermit adele mosley roscoe rebekah shepherd esteban lucinda larsen anton
+dorthy hoover solomon effie beasley norbert trina petersen elvin reba whitehead nolan sallie meyers
+carey aurora garrison rod lenora shields quinton etta horn hal lottie savage brain kerri olsen rob
+trisha schroeder elwood nikki hartman kendrick estella woodard darius francisca mueller moises josie
+kemp marlin tracie deleon fidel marissa booth thaddeus karin patel cliff brittney calhoun marcel
+janelle wiley ali lourdes eaton raphael laurel cline bryon helene navarro armand fern harrell alvaro
+elva humphrey jeffry corinne parrish dane kelsey duran joesph ina hutchinson thurman bettie hess ned
+elisabeth dorsey sammie aida bullock rusty caitlin robles michel ingrid beard monty iva dalton rory
+eugenia avila fabian christa rich reggie goldie blackwell kris maude york isaiah jenifer johns gus
+therese blankenship avery dena trevino loyd lorna salinas diego janette campos adolph latonya pruitt
+millard candy callahan rocco consuelo montoya gonzalo tamika hardin derick rosetta guerra rodrigo
+debora mcdowell gerry cherie stafford rigoberto polly gallegos alphonso dina henson ty jewell
+wilkinson rickie fay booker noe jillian merritt vern dorothea atkinson elvis nell orr bernardo trudy
+decker mauricio esperanza hobbs hiram patrica tanner donovan kimberley knox basil shanna pacheco
+nickolas helena stephenson scot cleo glass vince stefanie rojas quincy rosario serrano eddy ola
+marks sebastian janine hickman federico mollie english ulysses lupe sweeney heriberto alisa strong
+donnell lou mcclure denny maribel conway gavin susanne roth emery bette maynard romeo susana farrell
+jayson elise lowery dion cecile hurst dante isabelle nixon clement lesley weiss coy jocelyn trujillo
+odell paige ellison jarvis joni sloan bruno rachelle juarez issac leola winters dudley daphne mclean
+sanford alta boyer colby ester villarreal carmelo petra mccall nestor graciela gentry hollis imogene
+carrillo stefan jolene ayers donny keisha lara art lacey sexton linwood glenna pace beau gabriela
+hull weldon keri leblanc galen ursula browning isidro lizzie velasquez truman kirsten leach delmar
+shana chang johnathon adeline sellers silas mayra herring frederic jayne noble irwin jaclyn foley
+merrill gracie bartlett charley sondra mercado marcelino carmela landry carlo marisa durham trenton
+rosalind walls kurtis charity barr aurelio tonia mckee winfred beatriz bauer vito marisol rivers
+collin clarice bradshaw denver jeanine pugh leonel sheena velez emory angeline rush pasquale frieda
+estes mohammad lily dodson mariano shauna morse danial millie sheppard landon claudette weeks dirk
+cathleen camacho branden angelia bean adan gabrielle barron numbers autumn livingston clair
+katharine middleton buford jodie spears german staci branch bernie lea blevins wilmer christi chen
+emerson justine kerr zachery elma mcconnell jacques luella hatfield errol margret harding josue
+dominique solis edwardo socorro frost wilford martina giles theron margo blackburn raymundo mavis
+pennington daren callie woodward tristan bobbi finley robby maritza mcintosh lincoln lucile koch
+jame leanne mccullough genaro jeannine blanchard octavio deana rivas cornell aileen brennan hung
+lorie mejia arron ladonna kane antony willa benton herschel manuela buckley alva gale valentine
+giovanni selma maddox garth dolly russo cyrus sybil mcknight cyril abby buck ronny ivy moon stevie
+dee mcmillan lon winnie crosby kennith marcy berg carmine luisa dotson augustine jeri mays erich
+magdalena roach chadwick ofelia church wilburn meagan chan russ audra richmond myles matilda meadows
+jonas leila faulkner mitchel cornelia oneill mervin bianca knapp zane simone kline jamel bettye
+ochoa lazaro randi jacobson alphonse virgie gay randell latisha hendricks major barbra horne johnie
+georgina shepard jarrett eliza hebert ariel leann cardenas abdul bridgette mcintyre dusty rhoda
+waller luciano haley holman seymour adela donaldson scottie nola cantu eugenio bernadine morin
+mohammed flossie gillespie valentin ila fuentes arnulfo greta tillman lucien ruthie bentley
+ferdinand nelda peck thad minerva key ezra lilly salas aldo terrie rollins rubin letha gamble royal
+hilary dickson mitch estela battle earle valarie santana abe brianna cabrera marquis rosalyn
+cervantes lanny earline howe kareem catalina hinton jamar ava hurley boris mia spence isiah clarissa
+zamora emile lidia yang elmo corrine mcneil aron alexandria suarez leopoldo concepcion petty
+everette tia gould josef sharron mcfarland eloy rae sampson dorian dona carver rodrick ericka bray
+reinaldo jami macdonald lucio elnora stout jerrod chandra hester weston lenore melendez hershel neva
+dillon lemuel marylou farley lavern melisa hopper burt tabatha galloway jules serena potts gil avis
+joyner eliseo allie stein ahmad sofia aguirre nigel jeanie osborn efren odessa mercer antwan nannie
+bender alden harriett franco margarito loraine rowland refugio penelope sykes dino milagros pickett
+osvaldo emilia sears les benita mayo deandre allyson dunlap normand ashlee hayden kieth tania wilder
+ivory esmeralda mckay trey karina coffey norberto eve mccarty napoleon pearlie ewing jerold zelma
+cooley fritz malinda vaughan rosendo noreen bonner milford tameka cotton sang saundra holder deon
+hillary stark christoper amie ferrell alfonzo althea cantrell lyman rosalinda fulton josiah lilia
+lott brant alana calderon wilton clare pollard rico alejandra hooper jamaal elinor burch dewitt
+lorrie mullen brenton jerri fry yong darcy riddle olin earnestine levy faustino carmella odonnell
+claudio noemi britt judson marcie daugherty gino liza berger edgardo annabelle dillard alec louisa
+alston jarred earlene frye donn mallory riggs trinidad carlene chaney tad nita odom porfirio selena
+duffy odis tanisha fitzpatrick lenard katy valenzuela chauncey julianne mayer tod lakisha alford mel
+edwina mcpherson marcelo maricela acevedo kory margery barrera augustus kenya cote keven dollie
+reilly hilario roxie compton bud roslyn mooney sal kathrine mcgowan orval nanette craft mauro
+charmaine clemons dannie lavonne wynn zachariah ilene nielsen olen tammi baird anibal suzette
+stanton milo corine snider jed kaye rosales thanh chrystal bright amado lina witt lenny deanne hays
+tory lilian holden richie juliana rutledge horacio aline kinney brice luann clements mohamed kasey
+castaneda delmer maryanne slater dario evangeline hahn mac colette burks jonah melva delaney jerrold
+lawanda pate robt yesenia lancaster hank nadia sharpe sung madge whitfield rupert kathie talley
+rolland ophelia macias kenton valeria burris damion nona ratliff chi mitzi mccray antone mari madden
+waldo georgette kaufman fredric claudine goff bradly fran cash kip alissa bolton burl roseann
+mcfadden tyree lakeisha levine jefferey susanna byers ahmed reva kirkland willy deidre kidd stanford
+chasity workman oren sheree carney moshe elvia mcleod mikel alyce holcomb enoch deirdre england
+brendon gena finch quintin briana sosa jamison araceli haney florencio katelyn franks darrick
+rosanne sargent tobias wendi nieves minh tessa downs hassan berta rasmussen giuseppe marva bird
+demarcus imelda hewitt cletus marietta foreman tyrell marci valencia lyndon leonor oneil keenan
+arline delacruz werner sasha vinson theo madelyn dejesus geraldo janna hyde columbus juliette forbes
+chet deena gilliam bertram aurelia guthrie markus josefa wooten huey augusta huber hilton liliana
+barlow dwain lessie boyle donte amalia mcmahon tyron savannah buckner omer anastasia rocha isaias
+vilma puckett hipolito natalia langley fermin rosella knowles chung lynnette cooke adalberto corina
+velazquez jamey alfreda whitley teodoro leanna vang mckinley amparo shea maximo coleen rouse sol
+tamra hartley raleigh aisha mayfield lawerence wilda elder abram karyn rankin rashad queen hanna
+emmitt maura cowan daron mai lucero chong evangelina arroyo samual rosanna slaughter otha hallie
+haas miquel erna oconnell eusebio enid minor dong mariana boucher domenic lacy archer darron juliet
+boggs wilber jacklyn dougherty renato freida andersen hoyt madeleine newell haywood mara crowe
+ezekiel cathryn wang chas lelia friedman florentino casandra bland elroy bridgett swain clemente
+angelita holley arden jannie pearce neville dionne childs edison annmarie yarbrough deshawn katina
+galvan carrol beryl proctor shayne millicent meeks nathanial katheryn lozano jordon diann mora
+danilo carissa rangel claud maryellen bacon val liz villanueva sherwood lauri schaefer raymon helga
+rosado rayford gilda helms cristobal rhea boyce ambrose marquita goss titus hollie stinson hyman
+tisha lake felton tamera ibarra ezequiel angelique hutchins erasmo francesca covington lonny kaitlin
+crowley len lolita hatcher ike florine mackey milan rowena bunch lino reyna womack jarod twila polk
+herb fanny dodd andreas janell childress rhett ines childers jude concetta camp douglass bertie
+villa cordell alba dye oswaldo brigitte springer ellsworth alyson mahoney virgilio vonda dailey
+toney pansy belcher nathanael elba lockhart del noelle griggs benedict letitia costa mose deann
+brandt hong brandie walden isreal louella moser garret leta tatum fausto felecia mccann asa sharlene
+akers arlen lesa lutz zack beverley pryor modesto isabella orozco francesco herminia mcallister
+manual terra lugo jae celina davies gaylord tori shoemaker gaston octavia rutherford filiberto jade
+newsome deangelo denice magee michale germaine chamberlain granville michell blanton wes cortney
+simms malik nelly godfrey zackary doretha flanagan tuan deidra crum nicky monika cordova cristopher
+lashonda escobar antione judi downing malcom chelsey sinclair korey antionette donahue jospeh margot
+krueger colton adelaide mcginnis waylon nan gore von leeann farris hosea elisha webber shad dessie
+corbett santo libby andrade rudolf kathi starr rolf gayla lyon rey latanya yoder renaldo mina
+hastings marcellus mellisa mcgrath lucius kimberlee spivey kristofer jasmin krause harland renae
+harden arnoldo zelda crabtree rueben elda kirkpatrick leandro justina arrington kraig gussie ritter
+jerrell emilie mcghee jeromy camilla bolden hobert abbie maloney cedrick rocio gagnon arlie kaitlyn
+dunbar winford edythe ponce wally ashleigh pike luigi selina mayes keneth lakesha beatty jacinto
+geri mobley graig allene kimball franklyn pamala butts edmundo michaela montes sid dayna eldridge
+leif caryn braun jeramy rosalia hamm willian sun gibbons vincenzo jacquline moyer shon rebeca manley
+michal marybeth herron lynwood krystle plummer jere iola elmore hai dottie cramer elden belle rucker
+darell griselda pierson broderick ernestina fontenot alonso elida field adrianne rubio demetria
+goldstein delma elkins jaqueline wills arleen novak virgina hickey retha worley fatima gorman tillie
+katz eleanore dickinson cari broussard treva woodruff wilhelmina crow rosalee britton maurine nance
+latrice lehman jena bingham taryn zuniga elia whaley debby shafer maudie coffman jeanna steward
+delilah delarosa catrina nix shonda neely hortencia mata theodora davila teresita mccabe robbin
+kessler danette hinkle delphine welsh brianne pagan nilda goldberg danna goins cindi crouch bess
+cuevas iona quinones winona mcdermott vida hendrickson rosita samuels marianna denton racheal
+bergeron guillermina lam eloisa ivey celestine locke caren haines malissa snell lona hoskins chantel
+byrne shellie arias marisela roe leora corbin agatha beltran soledad chappell migdalia downey ivette
+dooley christen tuttle janel couch veda payton pattie mcelroy tessie crockett tera groves marilynn
+cartwright lucretia dickey karrie mcgill dinah dubois daniela muniz alecia tolbert adelina dempsey
+vernice cisneros shiela sewell portia latham merry vigil lashawn tapia dara rainey tawana norwood
+oma stroud verda meade alene tipton zella kuhn sandi hilliard rafaela bonilla maya teague kira gunn
+candida greenwood alvina correa suzan reece shayla poe lyn pineda lettie phipps samatha frey oralia
+kaiser matilde ames larissa gunter vesta schmitt renita milligan india espinosa delois bowden shanda
+vickers phillis lowry lorri pritchard erlinda costello cathrine piper barb mcclellan zoe lovell
+isabell sheehan ione hatch gisela dobson roxanna singh mayme jeffries kisha hollingsworth ellie
+sorensen mellissa meza dorris fink dalia donnelly bella burrell annetta tomlinson zoila colbert reta
+billings reina ritchie lauretta helton kylie sutherland christal peoples pilar mcqueen charla
+thomason elissa givens tiffani crocker tana vogel paulina robison leota dunham breanna coker jayme
+swartz carmel keys vernell ladner tomasa richter mandi hargrove dominga edmonds santa brantley
+melodie albright lura murdock alexa boswell tamela muller mirna quintero kerrie padgett venus kenney
+felicita daly cristy connolly carmelita inman berniece quintana annemarie lund tiara barnard
+roseanne villegas missy simons cori land roxana huggins pricilla tidwell kristal sanderson jung
+bullard elyse mcclendon haydee duarte aletha draper bettina marrero marge dwyer gillian abrams
+filomena stover zenaida goode harriette fraser caridad crews vada bernal una godwin aretha conklin
+pearline mcneal marjory baca marcela esparza flor crowder evette bower elouise brewster alina
+mcneill damaris rodrigues catharine leal belva coates nakia raines marlena mccain luanne mccord
+lorine miner karon holbrook dorene swift danita dukes brenna carlisle tatiana aldridge louann
+ackerman julianna starks andria ricks philomena holliday lucila ferris leonora hairston dovie
+sheffield romona lange mimi fountain jacquelin doss gaye betts tonja kaplan misti carmichael
+chastity bloom stacia ruffin roxann penn micaela kern nikita bowles mei sizemore velda larkin marlys
+dupree johnna seals aura metcalf ivonne hutchison hayley henley nicki farr majorie mccauley herlinda
+hankins yadira gustafson perla curran gregoria ash antonette waddell shelli ramey mozelle cates
+mariah pollock joelle cummins cordelia messer josette heller chiquita lin trista funk laquita
+cornett georgiana palacios candi galindo shanon cano hildegard hathaway valentina singer stephany
+pham magda enriquez karol salgado gabriella pelletier tiana painter roma wiseman richelle blount
+oleta feliciano jacque temple idella houser alaina doherty suzanna mead jovita mcgraw tosha swan
+nereida capps marlyn blanco kyla blackmon delfina thomson tena mcmanus stephenie burkett sabina post
+nathalie gleason marcelle ott gertie dickens darleen cormier thea voss sharonda rushing shantel
+rosenberg belen hurd venessa dumas rosalina benitez ona arellano genoveva marin clementine caudill
+rosalba bragg renate jaramillo renata huerta georgianna gipson floy colvin dorcas biggs ariana vela
+tyra platt theda cassidy mariam tompkins juli mccollum jesica dolan vikki daley verla crump roselyn
+sneed melvina kilgore jannette grove ginny grimm debrah davison corrie brunson asia prater violeta
+marcum myrtis devine latricia stratton collette rosas charleen choi anissa tripp viviana ledbetter
+twyla hightower nedra feldman latonia epps lan yeager hellen posey fabiola scruggs annamarie cope
+adell stubbs sharyn richey chantal overton niki trotter maud sprague lizette cordero lindy butcher
+kia stiles kesha burgos jeana woodson danelle horner charline bassett chanel purcell valorie haskins
+lia akins dortha ziegler cristal spaulding leone hadley leilani grubbs gerri sumner debi murillo
+andra zavala keshia shook ima lockwood eulalia driscoll easter dahl dulce thorpe natividad redmond
+linnie putnam kami mcwilliams georgie mcrae catina romano brook joiner alda sadler winnifred hedrick
+sharla hager ruthann hagen meaghan fitch magdalene coulter lissette thacker adelaida mansfield
+venita langston trena guidry shirlene ferreira shameka corley elizebeth conn dian rossi shanta
+lackey latosha baez carlotta saenz windy mcnamara rosina mcmullen mariann mckenna leisa mcdonough
+jonnie link dawna engel cathie browne astrid roper laureen peacock janeen eubanks holli drummond
+fawn stringer vickey pritchett teressa parham shante mims rubye landers marcelina ham chanda grayson
+terese schafer scarlett egan marnie timmons lulu ohara lisette keen jeniffer hamlin elenor finn
+dorinda cortes donita mcnair carman nadeau bernita moseley altagracia michaud aleta rosen adrianna
+oakes zoraida kurtz nicola jeffers lyndsey calloway janina beal ami bautista starla winn phylis
+suggs phuong stern kyra stapleton charisse lyles blanch laird sanjuanita montano rona dawkins nanci
+hagan marilee goldman maranda bryson brigette barajas sanjuana lovett marita segura kassandra metz
+joycelyn lockett felipa langford chelsie hinson bonny eastman mireya hooks lorenza smallwood kyong
+shapiro ileana crowell candelaria whalen sherie triplett lucie chatman leatrice aldrich lakeshia
+cahill gerda youngblood edie ybarra bambi stallings marylin sheets lavon reeder hortense connelly
+garnet bateman evie abernathy tressa winkler shayna wilkes lavina masters kyung hackett jeanetta
+granger sherrill gillis shara schmitz phyliss sapp mittie napier anabel souza alesia lanier thuy
+gomes tawanda weir joanie otero tiffanie ledford lashanda burroughs karissa babcock enriqueta
+ventura daria siegel daniella dugan corinna bledsoe alanna atwood abbey wray roxane varner roseanna
+spangler magnolia anaya lida staley joellen kraft era fournier coral belanger carleen wolff tresa
+thorne peggie bynum novella burnette nila boykin maybelle swenson jenelle purvis carina pina nova
+khan melina duvall marquerite darby margarette xiong josephina kauffman evonne healy cinthia engle
+albina benoit toya valle tawnya steiner sherita spicer myriam shaver lizabeth randle lise lundy
+keely dow jenni chin giselle calvert cheryle staton ardith neff ardis kearney alesha darden adriane
+oakley shaina medeiros linnea mccracken karolyn crenshaw felisha block dori perdue darci dill artie
+whittaker armida tobin zola washburn xiomara hogue vergie goodrich shamika easley nena bravo
+nannette dennison maxie shipley lovie kerns jeane jorgensen jaimie crain inge villalobos farrah
+maurer elaina longoria caitlyn keene felicitas coon cherly witherspoon caryl staples yolonda pettit
+yasmin kincaid teena eason prudence madrid pennie echols nydia lusk mackenzie stahl orpha currie
+marvel thayer lizbeth shultz laurette mcnally jerrie seay hermelinda north carolee maher tierra
+gagne mirian barrow meta nava melony moreland kori honeycutt jennette hearn jamila diggs ena caron
+anh whitten yoshiko westbrook susannah stovall salina ragland rhiannon munson joleen meier cristine
+looney ashton kimble aracely jolly tomeka hobson shalonda goddard marti culver lacie burr kala
+presley jada negron ilse connell hailey tovar brittani huddleston zona ashby syble salter sherryl
+root nidia pendleton marlo oleary kandice nickerson kandi myrick deb judd alycia jacobsen ronna bain
+norene adair mercy starnes ingeborg matos giovanna busby gemma herndon christel hanley audry bellamy
+zora doty vita bartley trish yazzie stephaine rowell shirlee parson shanika gifford melonie cullen
+mazie christiansen jazmin benavides inga barnhart hoa talbot hettie mock geralyn crandall fonda
+connors estrella bonds adella whitt sarita gage rina bergman milissa arredondo maribeth addison
+golda lujan evon dowdy ethelyn jernigan enedina huynh cherise bouchard chana dutton velva rhoades
+tawanna ouellette sade kiser mirta herrington karie hare jacinta blackman elna babb davina allred
+cierra rudd ashlie paulson albertha ogden tanesha koenig nelle geiger mindi begay lorinda parra
+larue lassiter florene hawk demetra esposito dedra cho ciara waldron chantelle ransom ashly prather
+suzy chacon rosalva vick noelia sands lyda roark leatha parr krystyna mayberry kristan greenberg
+karri coley darline bruner darcie whitman cinda skaggs cherrie shipman awilda leary almeda hutton
+rolanda romo lanette medrano jerilyn ladd gisele kruse evalyn askew cyndi schulz cleta alfaro carin
+tabor zina mohr zena gallo velia bermudez tanika pereira charissa bliss talia reaves margarete flint
+lavonda comer kaylee woodall kathlene naquin jonna guevara irena delong ilona carrier idalia pickens
+candis brand candance tilley brandee schaffer anitra lim alida knutson sigrid fenton nicolette doran
+maryjo chu linette vogt hedwig vann christiana prescott alexia mclain tressie landis modesta
+corcoran lupita zapata lita hyatt gladis hemphill evelia faulk davida dove cherri boudreaux cecily
+aragon ashely whitlock annabel trejo agustina tackett wanita shearer shirly saldana rosaura hanks
+hulda mckinnon eun koehler yetta bourgeois verona keyes thomasina goodson sibyl foote shannan
+lunsford mechelle goldsmith lue flood leandra winslow lani sams kylee reagan kandy mccloud jolynn
+hough ferne esquivel eboni naylor corene loomis alysia coronado zula ludwig nada braswell moira
+bearden lyndsay fagan lorretta ezell jammie edmondson hortensia cyr gaynell cronin adria nunn vina
+lemon vicenta guillory tangela grier stephine dubose norine traylor nella ryder liana dobbins leslee
+coyle kimberely aponte iliana whitmore glory smalls felica rowan emogene malloy elfriede cardona
+eden braxton eartha borden carma humphries bea carrasco ocie ruff lennie metzger kiara huntley
+jacalyn hinojosa carlota finney arielle madsen otilia hills kirstin ernst kacey dozier johnetta
+burkhart joetta bowser jeraldine peralta jaunita daigle elana whittington dorthea sorenson cami
+saucedo amada roche adelia redding vernita fugate tamar avalos siobhan waite renea lind rashida
+huston ouida hay nilsa hawthorne meryl hamby kristyn boyles julieta boles danica regan breanne faust
+aurea crook anglea beam sherron barger odette hinds malia gallardo lorelei willoughby leesa
+willingham kenna eckert kathlyn busch fiona zepeda charlette worthington suzie tinsley shantell hoff
+sabra hawley racquel carmona myong varela mira rector martine newcomb lucienne kinsey lavada dube
+juliann whatley elvera ragsdale delphia bernstein christiane becerra charolette yost carri mattson
+asha felder angella cheek paola handy ninfa grossman leda gauthier lai escobedo eda braden stefani
+beckman shanell mott palma hillman machelle flaherty lissa dykes kecia doe kathryne stockton karlene
+stearns julissa lofton jettie coats jenniffer cavazos hui beavers corrina barrios carolann parish
+alena mosher rosaria cardwell myrtice coles marylee burnham liane weller kenyatta lemons judie beebe
+janey aguilera elmira parnell eldora harman denna couture cristi alley cathi schumacher zaida redd
+vonnie dobbs viva blum vernie blalock rosaline merchant mariela ennis luciana denson lesli cottrell
+karan brannon felice bagley deneen aviles adina watt wynona sousa tarsha rosenthal sheron rooney
+shanita dietz shani blank shandra paquette randa mcclelland pinkie duff nelida velasco marilou lentz
+lyla grubb laurene burrows laci barbour joi ulrich janene shockley dorotha rader daniele beyer dani
+mixon carolynn layton carlyn altman berenice weathers ayesha stoner anneliese squires alethea shipp
+thersa priest tamiko lipscomb rufina cutler oliva caballero mozell zimmer marylyn willett kristian
+thurston kathyrn storey kasandra medley kandace epperson janae shah domenica mcmillian debbra
+baggett dannielle torrez arcelia laws aja hirsch zenobia dent sharen poirier sharee peachey lavinia
+farrar kum creech kacie barth jackeline trimble huong dupre felisa albrecht emelia sample eleanora
+lawler cythia crisp cristin conroy claribel wetzel anastacia nesbitt zulma murry zandra jameson yoko
+wilhelm tenisha patten susann minton sherilyn matson shay kimbrough shawanda iverson romana guinn
+mathilda croft linsey toth keiko pulliam joana nugent isela newby gretta littlejohn georgetta dias
+eugenie canales desirae bernier delora baron corazon singletary antonina renteria anika pruett
+willene mchugh tracee mabry tamatha landrum nichelle brower mickie stoddard maegan cagle luana
+stjohn lanita scales kelsie kohler edelmira kellogg bree hopson afton gant teodora tharp tamie gann
+shena zeigler meg pringle linh hammons keli fairchild kaci deaton danyelle chavis arlette carnes
+albertine rowley adelle matlock tiffiny kearns simona irizarry nicolasa carrington nichol starkey
+nia lopes nakisha jarrell mee craven maira baum loreen spain kizzy littlefield fallon linn christene
+humphreys bobbye etheridge vincenza cuellar tanja chastain rubie bundy roni speer queenie skelton
+margarett quiroz kimberli pyle irmgard portillo idell ponder hilma moulton evelina machado esta liu
+emilee killian dennise hutson dania hitchcock carie dowling wai cloud risa burdick rikki spann
+particia pedersen mui levin masako leggett luvenia hayward loree hacker loni dietrich lien beaulieu
+gigi barksdale florencia wakefield denita snowden billye briscoe tomika bowie sharita berman rana
+ogle nikole mcgregor neoma laughlin margarite helm madalyn burden lucina wheatley laila schreiber
+kali pressley jenette parris gabriele alaniz evelyne agee elenora urban clementina swann alejandrina
+snodgrass zulema schuster violette radford vannessa monk thresa mattingly retta harp pia girard
+patience cheney noella yancey nickie wagoner jonell ridley chaya lombardo camelia lau bethel hudgins
+anya gaskins suzann duckworth shu coe mila coburn lilla willey laverna prado keesha newberry kattie
+magana georgene hammonds eveline elam estell whipple elizbeth slade vivienne serna vallie ojeda
+trudie liles stephane dorman magaly diehl madie upton kenyetta reardon karren michaels janetta goetz
+hermine eller drucilla bauman debbi baer celestina layne candie hummel britni brenner beckie amaya
+amina adamson zita ornelas yun dowell yolande cloutier vivien castellanos vernetta wing trudi
+wellman sommer saylor pearle orourke patrina moya ossie montalvo nicolle kilpatrick loyce durbin
+letty shell larisa oldham katharina garvin joselyn foss jonelle branham jenell bartholomew iesha
+templeton heide maguire florinda holton florentina rider flo monahan elodia mccormack dorine beaty
+brunilda anders brigid streeter ashli nieto ardella nielson twana moffett thu lankford tarah keating
+shavon heck serina gatlin rayna delatorre ramonita callaway nga adcock margurite worrell lucrecia
+unger kourtney robinette kati nowak jesenia jeter crista brunner ayana steen alica parrott alia
+overstreet vinnie nobles suellen montanez romelia clevenger rachell brinkley olympia trahan michiko
+quarles kathaleen pickering jolie pederson jessi jansen janessa grantham hana gilchrist elease
+crespo carletta aiken britany schell shona schaeffer salome lorenz rosamond leyva regena harms raina
+dyson ngoc wallis nelia pease louvenia leavitt lesia cavanaugh latrina batts laticia warden larhonda
+seaman jina rockwell jacki quezada emmy paxton deeann linder coretta houck arnetta fontaine thalia
+durant shanice caruso neta adler mikki pimentel micki mize lonna lytle leana cleary lashunda cason
+kiley acker joye switzer jacqulyn isaacs ignacia higginbotham hyun han hiroko waterman henriette
+vandyke elayne stamper delinda sisk dahlia shuler coreen riddick consuela mcmahan conchita levesque
+celine hatton babette bronson ayanna bollinger anette arnett albertina okeefe shawnee gerber shaneka
+gannon quiana farnsworth pamelia baughman min silverman merri satterfield merlene mccrary margit
+kowalski kiesha grigsby kiera greco kaylene cabral jodee trout jenise rinehart erlene mahon emmie
+linton dalila gooden daisey curley casie baugh belia wyman babara weiner versie schwab vanesa
+schuler shelba morrissey shawnda mahan nikia bunn naoma thrasher marna spear margeret waggoner
+madaline qualls lawana purdy kindra mcwhorter jutta mauldin jazmine gilman janett perryman hannelore
+newsom glendora menard gertrud martino garnett graf freeda billingsley frederica artis florance
+simpkins flavia salisbury carline quintanilla beverlee gilliland anjanette fraley valda foust tamala
+crouse shonna scarborough sha ngo sarina grissom oneida fultz merilyn marlow marleen markham lurline
+madrigal lenna lawton katherin barfield jin whiting jeni varney hae schwarz gracia gooch glady arce
+farah wheat enola truong ema poulin dominque hurtado devona selby delana gaither cecila fortner
+caprice culpepper alysha coughlin alethia brinson vena boudreau theresia barkley tawny bales shakira
+stepp samara holm sachiko tan rachele schilling pamella morrell marni kahn mariel heaton maren gamez
+malisa causey ligia turpin lera shanks latoria schrader larae meek kimber isom kathern hardison
+karey carranza jennefer yanez janeth scroggins halina schofield fredia runyon delisa ratcliff
+debroah murrell ciera moeller angelika irby andree currier altha butterfield yen yee vivan ralston
+terresa pullen tanna pinson suk estep sudie carbone soo hawks signe ellington salena casillas ronni
+spurlock rebbecca sikes myrtie motley malika mccartney maida kruger loan isbell leonarda houle
+kayleigh burk ethyl tomlin ellyn quigley dayle neumann cammie lovelace brittni fennell birgit
+cheatham avelina bustamante asuncion skidmore arianna hidalgo akiko forman venice culp tyesha bowens
+tonie betancourt tiesha aquino takisha robb steffanie rea sindy milner meghann martel manda gresham
+macie wiles kellye ricketts kellee dowd joslyn collazo inger bostic indira blakely glinda sherrod
+glennis kenyon fernanda gandy faustina ebert eneida deloach elicia allard dot sauer digna robins
+dell olivares arletta gillette willia chestnut tammara bourque tabetha paine sherrell hite sari
+hauser rebbeca devore pauletta crawley natosha chapa nakita talbert mammie poindexter kenisha meador
+kazuko mcduffie kassie mattox earlean kraus daphine harkins corliss choate clotilde wren carolyne
+sledge bernetta sanborn augustina kinder audrea geary annis cornwell annabell barclay yan abney
+tennille seward tamica rhoads selene howland rosana fortier regenia benner qiana vines markita tubbs
+macy troutman leeanne rapp laurine mccurdy kym deluca jessenia westmoreland janita havens georgine
+guajardo genie ely emiko clary elvie seal deandra meehan dagmar herzog corie guillen collen ashcraft
+cherish waugh romaine renner porsha milam pearlene elrod micheline churchill merna breaux margorie
+bolin margaretta asher lore windham jenine tirado hermina pemberton fredericka nolen elke noland
+drusilla knott dorathy emmons dione cornish celena christenson brigida brownlee angeles barbee
+allegra waldrop tamekia pitt synthia olvera sook lombardi slyvia gruber rosann gaffney reatha
+eggleston raye banda marquetta archuleta margart slone layla prewitt kymberly pfeiffer kiana nettles
+kayleen mena katlyn mcadams karmen henning joella gardiner irina cromwell emelda chisholm eleni
+burleson detra vest clemmie oglesby cheryll mccarter chantell lumpkin cathey grey arnita wofford
+arla vanhorn angle thorn angelic teel alyse swafford zofia stclair thomasine stanfield tennie ocampo
+sherly herrmann sherley hannon sharyl arsenault remedios roush petrina mcalister nickole hiatt myung
+gunderson myrle forsythe mozella duggan louanne delvalle lisha cintron latia wilks krysta weinstein
+julienne uribe jeanene rizzo jacqualine noyes isaura mclendon gwenda gurley earleen bethea cleopatra
+winstead carlie maples audie guyton antonietta giordano alise alderman verdell valdes tomoko polanco
+thao pappas talisha lively shemika grogan savanna griffiths santina arevalo rosia whitson raeann
+sowell odilia rendon nana fernandes minna farrow magan benavidez lynelle ayres karma alicea joeann
+stump ivana smalley inell seitz ilana schulte hye gilley hee gallant gudrun canfield dreama wolford
+crissy omalley chante mcnutt carmelina mcnulty arvilla mcgovern annamae hardman alvera harbin aleida
+cowart yanira chavarria vanda brink tianna beckett tam bagwell stefania armstead shira anglin nicol
+abreu nancie reynoso monserrate krebs melynda jett melany hoffmann lovella greenfield laure forte
+kacy burney jacquelynn broome hyon sisson gertha trammell eliana partridge christena mace christeen
+lomax charise lemieux caterina gossett carley frantz candyce fogle arlena cooney ammie broughton
+willette pence vanita paulsen tuyet muncy syreeta mcarthur penney hollins nyla beauchamp maryam
+withers marya osorio magen mulligan ludie hoyle loma foy livia dockery lanell cockrell kimberlie
+begley julee amador donetta roby diedra rains denisha lindquist deane gentile dawne everhart clarine
+bohannon cherryl wylie bronwyn sommers alla purnell valery fortin tonda dunning sueann breeden
+soraya vail shoshana phelan shela phan sharleen marx shanelle cosby nerissa colburn meridith boling
+mellie biddle maye ledesma maple gaddis magaret denney lili chow leonila bueno leonie berrios
+leeanna wicker lavonia tolliver lavera thibodeaux kristel nagle kathey lavoie kathe fisk jann crist
+ilda barbosa hildred reedy hildegarde march genia locklear fumiko kolb evelin himes ermelinda
+behrens elly beckwith dung weems doloris wahl dionna shorter danae shackelford berneice rees annice
+muse alix cerda verena valadez verdie thibodeau shawnna saavedra shawana ridgeway shaunna reiter
+rozella mchenry randee majors ranae lachance milagro keaton lynell ferrara luise clemens loida
+blocker lisbeth applegate karleen paz junita needham jona mojica isis kuykendall hyacinth hamel hedy
+escamilla gwenn doughty ethelene burchett erline ainsworth donya vidal domonique upchurch delicia
+thigpen dannette strauss cicely spruill branda sowers blythe riggins bethann ricker ashlyn mccombs
+annalee harlow alline buffington yuko sotelo vella olivas trang negrete towanda morey tesha macon
+sherlyn logsdon narcisa lapointe miguelina bigelow meri bello maybell westfall marlana stubblefield
+marguerita peak madlyn lindley lory hein loriann hawes leonore farrington leighann breen laurice
+birch latesha wilde laronda steed katrice sepulveda kasie reinhardt kaley proffitt jadwiga minter
+glennie messina gearldine mcnabb francina maier epifania keeler dyan gamboa dorie donohue diedre
+basham denese shinn demetrice crooks delena cota cristie borders cleora bills catarina bachman
+carisa tisdale barbera tavares almeta schmid trula pickard tereasa gulley solange fonseca sheilah
+delossantos shavonne condon sanora batista rochell wicks mathilde wadsworth margareta martell maia
+littleton lynsey ison lawanna haag launa folsom kena brumfield keena broyles katia brito glynda
+mireles gaylene mcdonnell elvina leclair elanor hamblin danuta gough danika fanning cristen binder
+cordie winfield coletta whitworth clarita soriano carmon palumbo brynn newkirk azucena mangum
+aundrea hutcherson angele comstock verlie carlin verlene beall tamesha bair silvana wendt sebrina
+watters samira walling reda putman raylene otoole penni morley norah mares noma lemus mireille
+keener melissia hundley maryalice dial laraine damico kimbery billups karyl strother karine
+mcfarlane kam lamm jolanda eaves johana crutcher jesusa caraballo jaleesa canty jacquelyne atwell
+iluminada taft hilaria siler hanh rust gennie rawls francie rawlings floretta prieto exie mcneely
+edda mcafee drema hulsey delpha hackney bev galvez barbar escalante assunta delagarza ardell crider
+annalisa charlton alisia bandy yukiko wilbanks yolando stowe wonda steinberg wei renfro waltraud
+masterson veta massie temeka lanham tameika haskell shirleen hamrick shenita fort piedad dehart
+ozella burdette mirtha branson marilu bourne kimiko babin juliane aleman jenice worthy janay tibbs
+jacquiline smoot hilde slack fae paradis elois mull echo luce devorah houghton chau gantt brinda
+furman betsey danner arminda christianson aracelis burge apryl ashford annett arndt alishia almeida
+veola stallworth usha shade toshiko searcy theola sager tashia noonan talitha mclemore shery
+mcintire renetta maxey reiko lavigne rasheeda jobe obdulia ferrer mika falk melaine coffin meggan
+byrnes marlen aranda marget apodaca marceline stamps mana rounds magdalen peek librada olmstead
+lezlie lewandowski latashia kaminski lasandra dunaway kelle bruns isidra brackett isa amato
+inocencia reich gwyn mcclung francoise lacroix erminia koontz erinn herrick dimple hardesty devora
+flanders criselda cousins armanda cato arie cade ariane vickery angelena shank aliza nagel adriene
+dupuis adaline croteau xochitl cotter twanna cable tomiko stuckey tamisha stine taisha porterfield
+susy pauley siu nye rutha moffitt rhona knudsen noriko hardwick natashia goforth merrie dupont
+marinda blunt mariko barrows margert barnhill loris shull lizzette rash leisha loftis kaila lemay
+joannie kitchens jerrica horvath jene grenier jannet fuchs janee fairbanks jacinda culbertson herta
+calkins elenore burnside doretta beattie delaine ashworth daniell albertson claudie wertz britta
+vaught apolonia vallejo amberly turk alease tuck yuri tijerina yuk sage wen peterman waneta
+marroquin ute marr tomi lantz sharri hoang sandie demarco roselle daily reynalda cone raguel berube
+phylicia barnette patria wharton olimpia stinnett odelia slocum mitzie scanlon minda sander mignon
+pinto mica mancuso mendy lima marivel headley maile epstein lynetta counts lavette clarkson lauryn
+carnahan latrisha boren lakiesha arteaga kiersten adame kary zook josphine whittle jolyn whitehurst
+jetta wenzel janise saxton jacquie reddick ivelisse puente glynis handley gianna haggerty gaynelle
+earley danyell devlin danille chaffin dacia cady coralee acuna cher solano ceola sigler arianne
+pollack aleshia pendergrass yung ostrander williemae janes trinh francois thora crutchfield tai
+chamberlin svetlana brubaker sherika baptiste shemeka willson shaunda reis roseline neeley ricki
+mullin melda mercier mallie lira lavonna layman latina keeling laquanda higdon lala espinal lachelle
+chapin klara warfield kandis toledo johna pulido jeanmarie peebles jaye nagy grayce montague gertude
+mello emerita lear ebonie jaeger clorinda hogg ching graff chery furr carola soliz breann poore
+blossom mendenhall bernardine mclaurin becki maestas arletha gable argelia barraza ara tillery alita
+snead yulanda pond yon neill yessenia mcculloch tobi mccorkle tasia lightfoot sylvie hutchings shirl
+holloman shirely harness shella dorn shantelle council sacha bock rebecka zielinski providencia
+turley paulene treadwell misha stpierre miki starling marline somers marica oswald lorita merrick
+latoyia easterling lasonya bivens kerstin truitt kenda poston keitha parry kathrin ontiveros jaymie
+olivarez gricelda moreau ginette medlin eryn lenz elina knowlton elfrieda fairley danyel cobbs
+cheree chisolm chanelle bannister barrie woodworth aurore toler annamaria ocasio alleen noriega
+ailene neuman aide moye yasmine milburn vashti mcclanahan treasa lilley tiffaney hanes sheryll
+flannery sharie dellinger shanae danielson sau conti raisa blodgett neda beers mitsuko weatherford
+mirella strain milda karr maryanna hitt maragret denham mabelle custer luetta coble lorina clough
+letisha casteel latarsha bolduc lanelle batchelor lajuana ammons krissy whitlow karly tierney karena
+staten jessika sibley jerica seifert jeanelle schubert jalisa salcedo jacelyn mattison izola laney
+euna haggard etha grooms domitila dix dominica dees daina cromer creola cooks carli colson camie
+caswell brittny zarate ashanti swisher anisha shin aleen ragan adah pridgen yasuko mcvey valrie
+matheny tona lafleur tinisha franz thi ferraro terisa dugger taneka whiteside simonne rigsby
+shalanda mcmurray serita lehmann ressie jacoby refugia hildebrand olene hendrick margherita headrick
+mandie goad maire fincher lyndia drury luci borges lorriane archibald loreta albers leonia woodcock
+lavona trapp lashawnda soares lakia seaton kyoko monson krystina luckett krysten lindberg kenia kopp
+kelsi keeton jeanice hsu isobel healey georgiann garvey genny gaddy felicidad fain eilene burchfield
+deloise wentworth conception strand clora stack cherilyn spooner calandra saucier armandina sales
+anisa ricci ula plunkett tiera pannell theressa ness stephania leger sima hoy shyla freitas shonta
+fong shera elizondo shaquita duval shala beaudoin rossana urbina nohemi rickard nery partin moriah
+moe melita mcgrew melida mcclintock melani ledoux marylynn forsyth marisha faison mariette devries
+malorie bertrand madelene wasson ludivina tilton loria scarbrough lorette leung loralee irvine
+lianne garber lavenia denning laurinda corral lashon colley kit castleberry kimi bowlin keila bogan
+katelynn beale kai baines jone trice joane rayburn jayna parkinson janella pak hue nunes hertha
+mcmillen francene leahy elinore kimmel despina higgs delsie fulmer deedra carden clemencia bedford
+carolin taggart bulah spearman brittanie register bok prichard blondell morrill bibi koonce beaulah
+heinz beata hedges annita guenther agripina grice virgen findley valene dover twanda creighton
+tommye boothe toi bayer tarra arreola tari vitale tammera valles shakia raney sadye osgood ruthanne
+hanlon rochel burley rivka bounds pura worden nenita weatherly natisha vetter merrilee tanaka
+melodee stiltner marvis nevarez lucilla mosby leena montero laveta melancon larita harter lanie
+hamer keren goble ileen gladden georgeann gist genna ginn frida akin ewa zaragoza eufemia towns
+emely tarver ela sammons edyth royster deonna oreilly deadra muir darlena morehead chanell luster
+cathern kingsley cassondra kelso cassaundra grisham bernarda glynn berna baumann arlinda alves
+anamaria yount vertie tamayo valeri paterson torri oates tatyana menendez stasia longo sherise
+hargis sherill gillen sanda desantis ruthe breedlove rosy sumpter robbi scherer ranee rupp quyen
+reichert pearly heredia palmira creel onita cohn nisha clemmons niesha casas nida bickford nam
+belton merlyn bach mayola williford marylouise whitcomb marth tennant margene sutter madelaine stull
+londa sessions leontine mccallum leoma langlois leia keel lauralee keegan lanora dangelo lakita
+dancy kiyoko damron keturah clapp katelin clanton kareen bankston jonie oliveira johnette mintz
+jenee mcinnis jeanett martens izetta mabe hiedi laster heike jolley hassie hildreth giuseppina
+hefner georgann glaser fidela duckett fernande demers elwanda brockman ellamae blais eliz alcorn
+dusti agnew dotty toliver cyndy tice coralie seeley celesta najera argentina musser alverta mcfall
+xenia laplante wava galvin vanetta fajardo torrie doan tashina coyne tandy copley tambra clawson
+tama cheung stepanie barone shila wynne shaunta woodley sharan tremblay shaniqua stoll shae sparrow
+setsuko sparkman serafina schweitzer sandee sasser rosamaria samples priscila roney olinda legg
+nadene heim muoi farias michelina colwell mercedez christman maryrose bratcher marcene winchester
+mao upshaw magali southerland mafalda sorrell lannie sells kayce mount karoline mccloskey kamilah
+martindale kamala luttrell justa loveless joline lovejoy jennine linares jacquetta latimer iraida
+embry georgeanna coombs franchesca bratton emeline bostick elane venable ehtel tuggle earlie toro
+dulcie staggs dalene sandlin classie jefferies chere heckman charis griffis caroyln crayton carmina
+clem carita browder bethanie thorton ayako sturgill arica sprouse alysa royer alessandra rousseau
+akilah ridenour adrien pogue zetta perales youlanda peeples yelena metzler yahaira mesa wendolyn
+mccutcheon tijuana mcbee terina hornsby teresia heffner suzi corrigan sherell armijo shavonda vue
+shaunte plante sharda peyton shakita paredes sena macklin ryann hussey rubi hodgson riva granados
+reginia frias rachal becnel parthenia batten pamula almanza monnie turney monet teal michaele
+sturgeon melia meeker malka mcdaniels maisha limon lisandra keeney lekisha kee lean hutto lakendra
+holguin krystin gorham kortney fishman kizzie fierro kittie blanchette kera rodrigue kendal reddy
+kemberly osburn kanisha oden julene lerma jule kirkwood johanne keefer jamee haugen halley hammett
+gidget chalmers galina brinkman fredricka baumgartner fleta valerio fatimah tellez eusebia steffen
+elza shumate eleonore sauls dorthey ripley doria kemper donella jacks dinorah guffey delorse evers
+claretha craddock christinia carvalho charlyn blaylock bong banuelos belkis balderas azzie wooden
+andera wheaton aiko turnbull adena shuman yer pointer yajaira mosier wan mccue vania ligon ulrike
+kozlowski toshia johansen tifany ingle stefany herr shizue briones shenika snipes shawanna rickman
+sharolyn pipkin sharilyn pantoja shaquana orosco shantay moniz rozanne lawless roselee kunkel remona
+hibbard reanna galarza raelene enos phung bussey petronila schott natacha salcido nancey perreault
+myrl mcdougal miyoko mccool miesha haight merideth garris marvella ferry marquitta easton marhta
+conyers marchelle atherton lizeth wimberly libbie utley lahoma spellman ladawn smithson kina slagle
+katheleen ritchey katharyn rand karisa petit kaleigh osullivan junie oaks julieann nutt johnsie
+mcvay janean mccreary jaimee mayhew jackqueline knoll hisako jewett herma harwood helaine cardoza
+gwyneth ashe gita arriaga eustolia zeller emelina wirth elin whitmire edris stauffer donnette
+rountree donnetta redden dierdre mccaffrey denae martz darcel larose clarisa langdon cinderella
+humes chia gaskin charlesetta faber charita devito celsa cass cassy almond cassi wingfield carlee
+wingate bruna villareal brittaney tyner brande smothers billi severson bao reno antonetta pennell
+angla maupin angelyn leighton analisa janssen alane hassell wenona hallman wendie halcomb veronique
+folse vannesa fitzsimmons tobie fahey tempie cranford sumiko bolen sulema battles sparkle battaglia
+somer wooldridge sheba trask sharice rosser shanel regalado shalon mcewen rosio keefe roselia fuqua
+renay echevarria rema caro reena boynton ozie andrus oretha viera oralee vanmeter oda taber ngan
+spradlin nakesha seibert milly provost marybelle prentice margrett oliphant maragaret laporte manie
+hwang lurlene hatchett lillia hass lieselotte greiner lavelle freedman lashaunda covert lakeesha
+chilton kaycee byars kalyn wiese joya venegas joette swank jenae shrader janiece roberge illa mullis
+grisel mortensen glayds mccune genevie marlowe gala kirchner fredda keck eleonor isaacson debera
+hostetler deandrea halverson corrinne gunther cordia griswold contessa fenner colene durden
+cleotilde blackwood chantay ahrens cecille sawyers beatris savoy azalee nabors arlean mcswain ardath
+mackay anjelica loy anja lavender alfredia lash aleisha labbe zada jessup yuonne fullerton willodean
+cruse vennie crittenden vanna correia tyisha centeno tova caudle torie canady tonisha callender
+tilda alarcon tien ahern sirena winfrey sherril tribble shanti styles senaida salley samella roden
+robbyn musgrove renda minnick reita fortenberry phebe carrion paulita bunting nobuko batiste nguyet
+whited neomi underhill mikaela stillwell melania rauch maximina pippin marg perrin maisie messenger
+lynna mancini lilli lister lashaun kinard lakenya hartmann lael fleck kirstie broadway kathline wilt
+kasha treadway karlyn thornhill karima spalding jovan rafferty josefine pitre jennell patino jacqui
+ordonez jackelyn linkous hyo kelleher hien homan grazyna galbraith florrie feeney floria curtin
+eleonora coward dwana camarillo dorla buss delmy bunnell deja bolt dede beeler dann autry crysta
+alcala clelia witte claris wentz chieko stidham cherlyn shively cherelle nunley charmain meacham
+chara martins cammy lemke bee lefebvre arnette hynes ardelle horowitz annika hoppe amiee holcombe
+amee dunne allena derr yvone cochrane yuki brittain yoshie bedard yevette beauregard yael torrence
+willetta strunk voncile soria venetta simonson tula shumaker tonette scoggins timika oconner temika
+moriarty telma kuntz teisha ives taren hutcheson stacee horan shawnta hales saturnina garmon ricarda
+fitts pok bohn pasty atchison onie wisniewski nubia vanwinkle marielle sturm mariella sallee
+marianela prosser mardell moen luanna lundberg loise kunz lisabeth kohl lindsy keane lilliana
+jorgenson lilliam jaynes lelah funderburk leigha freed leanora durr kristeen creamer khalilah
+cosgrove keeley batson kandra vanhoose junko thomsen joaquina teeter jerlene smyth jani redmon
+jamika orellana hsiu maness hermila heflin genevive goulet evia frick eugena forney emmaline bunker
+elfreda asbury elene aguiar donette talbott delcie southard deeanna mowery darcey mears cuc lemmon
+clarinda krieger cira hickson chae elston celinda duong catheryn delgadillo casimira dayton carmelia
+dasilva camellia conaway breana catron bobette bruton bernardina bradbury bebe bordelon basilia
+bivins arlyne bittner amal bergstrom alayna beals zonia abell zenia whelan yuriko tejada yaeko
+pulley wynell pino willena norfleet vernia nealy tora maes terrilyn loper terica gatewood tenesha
+frierson tawna freund tajuana finnegan taina cupp stephnie covey sona catalano sina boehm shondra
+bader shizuko yoon sherlene walston sherice tenney sharika sipes rossie rawlins rosena medlock rima
+mccaskill ria mccallister rheba marcotte renna maclean natalya hughey nancee henke melodi harwell
+meda gladney matha gilson marketta dew maricruz chism marcelene caskey malvina brandenburg luba
+baylor louetta villasenor leida veal lecia thatcher lauran stegall lashawna shore laine petrie
+khadijah nowlin katerine navarrete kasi muhammad kallie lombard julietta loftin jesusita lemaster
+jestine kroll jessia kovach jeffie kimbrell janyce kidwell isadora hershberger georgianne fulcher
+fidelia eng evita cantwell eura bustos eulah boland estefana bobbitt elsy binkley eladia wester
+dodie weis dia verdin denisse tiller deloras sisco delila sharkey daysi seymore crystle rosenbaum
+concha rohr claretta quinonez charlsie pinkston charlena nation carylon malley bettyann logue asley
+lessard ashlea lerner amira lebron agueda krauss agnus klinger yuette halstead vinita haller
+victorina getz tynisha burrow treena alger toccara shores tish pfeifer thomasena perron tegan nelms
+soila munn shenna mcmaster sharmaine mckenney shantae manns shandi knudson september hutchens saran
+huskey sarai goebel sana flagg rosette cushman rolande click regine castellano otelia carder olevia
+bumgarner nicholle wampler necole spinks naida robson myrta neel myesha mcreynolds mitsue mathias
+minta maas mertie loera margy kasper mahalia jenson madalene florez loura coons lorean buckingham
+lesha brogan leonida berryman lenita wilmoth lavone wilhite lashell thrash lashandra shephard
+lamonica seidel kimbra schulze katherina roldan karry pettis kanesha obryan jong maki jeneva mackie
+jaquelyn hatley hwa frazer gilma fiore ghislaine chesser gertrudis bui fransisca bottoms fermina
+bisson ettie benefield etsuko allman ellan wilke elidia trudeau edra timm dorethea shifflett
+doreatha rau denyse mundy deetta milliken daine mayers cyrstal leake corrin kohn cayla huntington
+carlita horsley camila hermann burma guerin bula fryer buena frizzell barabara foret avril flemming
+alaine fife zana criswell wilhemina carbajal wanetta bozeman veronika boisvert verline angulo
+vasiliki wallen tonita tapp tisa silvers teofila ramsay tayna oshea taunya orta tandra moll takako
+mckeever sunni mcgehee suanne linville sixta kiefer sharell ketchum seema howerton rosenda groce
+robena gass raymonde fusco pei corbitt pamila betz ozell bartels neida amaral mistie aiello micha
+yoo merissa weddle maurita sperry maryln seiler maryetta runyan marcell raley malena overby makeda
+osteen lovetta olds lourie mckeown lorrine matney lorilee lauer laurena lattimore lashay hindman
+larraine hartwell laree fredrickson lacresha fredericks kristle espino krishna clegg keva carswell
+keira cambell karole burkholder joie woodbury jinny welker jeannetta totten jama thornburg heidy
+theriault gilberte stitt gema stamm faviola stackhouse evelynn scholl enda saxon elli rife ellena
+razo divina quinlan dagny pinkerton collene olivo codi nesmith cindie nall chassidy mattos chasidy
+lafferty catrice justus catherina giron cassey geer caroll fielder carlena drayton candra dortch
+calista conners bryanna conger britteny boatwright beula billiot bari barden audrie armenta audria
+tibbetts ardelia steadman annelle slattery angila rinaldi alona raynor allyn pinckney pettigrew
+milne matteson halsey gonsalves fellows durand desimone cowley cowles brill barham barela barba
+ashmore withrow valenti tejeda spriggs sayre salerno peltier peel merriman matheson lowman lindstrom
+hyland giroux earls dugas dabney collado briseno baxley whyte wenger vanover vanburen thiel
+schindler schiller rigby pomeroy passmore marble manzo mahaffey lindgren laflamme greathouse fite
+calabrese bayne yamamoto wick townes thames reinhart peeler naranjo montez mcdade mast markley
+marchand leeper kellum hudgens hennessey hadden gainey coppola borrego bolling beane ault slaton
+poland pape null mulkey lightner langer hillard glasgow ethridge enright derosa baskin weinberg
+turman somerville pardo noll lashley ingraham hiller hendon glaze cothran cooksey conte carrico
+abner wooley swope summerlin sturgis sturdivant stott spurgeon spillman speight roussel popp nutter
+mckeon mazza magnuson lanning kozak jankowski heyward forster corwin callaghan bays wortham usher
+theriot sayers sabo poling loya lieberman laroche labelle howes harr garay fogarty everson durkin
+dominquez chaves chambliss witcher vieira vandiver terrill stoker schreiner moorman liddell lew
+lawhorn krug irons hylton hollenbeck herrin hembree goolsby goodin gilmer foltz dinkins daughtry
+caban brim briley bilodeau wyant vergara tallent swearingen stroup scribner quillen pitman monaco
+mccants maxfield martinson holtz flournoy brookins brody baumgardner straub sills roybal roundtree
+oswalt mcgriff mcdougall mccleary maggard gragg gooding godinez doolittle donato cowell cassell
+bracken appel zambrano reuter perea nakamura monaghan mickens mcclinton mcclary marler kish judkins
+gilbreath freese flanigan felts erdmann dodds chew brownell boatright barreto slayton sandberg
+saldivar pettway odum narvaez moultrie montemayor merrell lees keyser hoke hardaway hannan
+gilbertson fogg dumont deberry coggins buxton bucher broadnax beeson araujo appleton amundson aguayo
+ackley yocum worsham shivers sanches sacco robey rhoden pender ochs mccurry madera luong knotts
+jackman heinrich hargrave gault comeaux chitwood caraway boettcher bernhardt barrientos zink wickham
+whiteman thorp stillman settles schoonover roque riddell pilcher phifer novotny macleod hardee haase
+grider doucette clausen bevins beamon badillo tolley tindall soule snook seale pitcher pinkney
+pellegrino nowell nemeth mondragon mclane lundgren ingalls hudspeth hixson gearhart furlong downes
+dibble deyoung cornejo camara brookshire boyette wolcott surratt sellars segal salyer reeve rausch
+labonte haro gower freeland fawcett eads driggers donley collett bromley boatman ballinger baldridge
+volz trombley stonge shanahan rivard rhyne pedroza matias jamieson hedgepeth hartnett estevez
+eskridge denman chiu chinn catlett carmack buie bechtel beardsley bard ballou ulmer skeen robledo
+rincon reitz piazza munger moten mcmichael loftus ledet kersey groff fowlkes folk crumpton clouse
+bettis villagomez timmerman strom santoro roddy penrod musselman macpherson leboeuf harless haddad
+guido golding fulkerson fannin dulaney dowdell cottle ceja cate bosley benge albritton voigt
+trowbridge soileau seely rohde pearsall paulk orth nason mota mcmullin marquardt madigan hoag gillum
+gabbard fenwick eck danforth cushing cress creed cazares casanova bey bettencourt barringer baber
+stansberry schramm rutter rivero oquendo necaise mouton montenegro miley mcgough marra macmillan
+lamontagne jasso horst hetrick heilman gaytan gall fortney dingle desjardins dabbs burbank brigham
+breland beaman arriola yarborough wallin toscano stowers reiss pichardo orton michels mcnamee
+mccrory leatherman kell keister horning hargett guay ferro deboer dagostino carper blanks beaudry
+towle tafoya stricklin strader soper sonnier sigmon schenk saddler pedigo mendes lunn lohr lahr
+kingsbury jarman hume holliman hofmann haworth harrelson hambrick flick edmunds dacosta crossman
+colston chaplin carrell budd weiler waits valentino trantham tarr solorio roebuck powe plank pettus
+palm pagano mink luker leathers joslin hartzell gambrell deutsch cepeda carty caputo brewington
+bedell ballew applewhite warnock walz urena tudor reel pigg parton mickelson meagher mclellan
+mcculley mandel leech lavallee kraemer kling kipp kehoe hochstetler harriman gregoire grabowski
+gosselin gammon fancher edens desai brannan armendariz woolsey whitehouse whetstone ussery towne
+testa tallman studer strait steinmetz sorrells sauceda rolfe paddock mitchem mcginn mccrea lovato
+hazen gilpin gaynor fike devoe delrio curiel burkhardt bode backus zinn watanabe wachter vanpelt
+turnage shaner schroder sato riordan quimby portis natale mckoy mccown kilmer hotchkiss hesse
+halbert gwinn godsey delisle chrisman canter arbogast angell acree yancy woolley wesson weatherspoon
+trainor stockman spiller sipe rooks reavis propst porras neilson mullens loucks llewellyn kumar
+koester klingensmith kirsch kester honaker hodson hennessy helmick garrity garibay fee drain casarez
+callis botello aycock avant wingard wayman tully theisen szymanski stansbury segovia rainwater
+preece pirtle padron mincey mckelvey mathes larrabee kornegay klug ingersoll hecht germain eggers
+dykstra deering decoteau deason dearing cofield carrigan bonham bahr aucoin appleby almonte yager
+womble wimmer weimer vanderpool stancil sprinkle romine remington pfaff peckham olivera meraz maze
+lathrop koehn hazelton halvorson hallock haddock ducharme dehaven caruthers brehm bosworth bost bias
+beeman basile bane aikens wold walther tabb suber strawn stocker shirey schlosser riedel rembert
+reimer pyles peele merriweather letourneau latta kidder hixon hillis hight herbst henriquez haygood
+hamill gabel fritts eubank dawes correll cha bushey buchholz brotherton botts barnwell auger atchley
+westphal veilleux ulloa stutzman shriver ryals prior pilkington moyers marrs mangrum maddux lockard
+laing kuhl harney hammock hamlett felker doerr depriest carrasquillo carothers bogle bischoff bergen
+albanese wyckoff vermillion vansickle thibault tetreault stickney shoemake ruggiero rawson racine
+philpot paschal mcelhaney mathison legrand lapierre kwan kremer jiles hilbert geyer faircloth ehlers
+egbert desrosiers dalrymple cotten cashman cadena breeding boardman alcaraz ahn wyrick therrien
+tankersley strickler puryear plourde pattison pardue mcginty mcevoy landreth kuhns koon hewett
+giddens emerick eades deangelis cosme ceballos birdsong benham bemis armour anguiano welborn tsosie
+storms shoup sessoms samaniego rood rojo rhinehart raby northcutt myer munguia morehouse mcdevitt
+mallett lozada lemoine kuehn hallett grim gillard gaylor garman gallaher feaster faris darrow dardar
+coney carreon braithwaite boylan boyett bixler bigham benford barragan barnum zuber wyche westcott
+vining stoltzfus simonds shupe sabin ruble rittenhouse richman perrone mulholland millan lomeli kite
+jemison hulett holler hickerson herold hazelwood griffen gause forde eisenberg dilworth charron
+chaisson brodie bristow breunig brace boutwell bentz belk bayless batchelder baran baeza zimmermann
+weathersby volk toole theis tedesco searle schenck satterwhite ruelas rankins partida nesbit morel
+menchaca levasseur kaylor johnstone hulse hollar hersey harrigan harbison guyer gish giese gerlach
+geller geisler falcone elwell doucet deese darr corder chafin byler bussell burdett brasher bowe
+bellinger bastian barner alleyne wilborn weil wegner wales tatro spitzer smithers schoen resendez
+parisi overman obrian mudd moy mclaren maggio lindner lalonde lacasse laboy killion kahl jessen
+jamerson houk henshaw gustin graber durst duenas davey cundiff conlon colunga coakley chiles capers
+buell bricker bissonnette birmingham bartz bagby zayas volpe treece toombs thom terrazas swinney
+skiles silveira shouse senn ramage nez moua langham kyles holston hoagland herd feller denison
+carraway burford bickel ambriz abercrombie yamada weidner waddle verduzco thurmond swindle schrock
+sanabria rosenberger probst peabody olinger nazario mccafferty mcbroom mcabee mazur matherne mapes
+leverett killingsworth heisler griego gosnell frankel franke ferrante fenn ehrlich christopherso
+chasse chancellor caton brunelle bly bloomfield babbitt azevedo abramson ables abeyta youmans
+wozniak wainwright stowell smitherman samuelson runge rothman rosenfeld peake owings olmos munro
+moreira leatherwood larkins krantz kovacs kizer kindred karnes jaffe hubbell hosey hauck goodell
+erdman dvorak doane cureton cofer buehler bierman berndt banta abdullah warwick waltz turcotte
+torrey stith seger sachs quesada pinder peppers pascual paschall parkhurst ozuna oster nicholls
+lheureux lavalley kimura jablonski haun gourley gilligan derby croy cotto cargill burwell burgett
+buckman booher adorno wrenn whittemore urias szabo sayles saiz rutland rael pharr pelkey ogrady
+nickell musick moats mather massa kirschner kieffer kellar hendershot gott godoy gadson furtado
+fiedler erskine dutcher dever daggett chevalier brake ballesteros amerson wingo waldon trott silvey
+showers schlegel rue ritz pepin pelayo parsley palermo moorehead mchale lett kocher kilburn iglesias
+humble hulbert huckaby hix haven hartford hardiman gurney grigg grasso goings fillmore farber depew
+dandrea dame cowen covarrubias burrus bracy ardoin thompkins standley radcliffe pohl persaud
+parenteau pabon newson newhouse napolitano mulcahy malave keim hooten hernandes heffernan hearne
+greenleaf glick fuhrman fetter faria dishman dickenson crites criss clapper chenault castor casto
+bugg bove bonney ard anderton allgood alderson woodman warrick toomey tooley tarrant summerville
+stebbins sokol searles schutz schumann scheer remillard raper proulx palmore monroy messier melo
+melanson mashburn manzano lussier jenks huneycutt hartwig grimsley fulk fielding fidler engstrom
+eldred dantzler crandell calder brumley breton brann bramlett boykins bianco bancroft almaraz
+alcantar whitmer whitener welton vineyard rahn paquin mizell mcmillin mckean marston maciel
+lundquist liggins lampkin kranz koski kirkham jiminez hazzard harrod graziano grammer gendron
+garrido fordham englert dryden demoss deluna crabb comeau brummett blume benally wessel vanbuskirk
+thorson stumpf stockwell reams radtke rackley pelton niemi newland nelsen morrissette miramontes
+mcginley mccluskey marchant luevano lampe lail jeffcoat infante hinman gaona erb eady desmarais
+decosta dansby choe breckenridge bostwick borg bianchi alberts wilkie whorton vargo tait soucy
+schuman ousley mumford lum lippert leath lavergne laliberte kirksey kenner johnsen izzo hiles
+gullett greenwell gaspar galbreath gaitan ericson delapaz croom cottingham clift bushnell bice
+beason arrowood waring voorhees truax shreve shockey schatz sandifer rubino rozier roseberry pieper
+peden nester nave murphey malinowski macgregor lafrance kunkle kirkman hipp hasty haddix gervais
+gerdes gamache fouts fitzwater dillingham deming deanda cedeno cannady burson bouldin arceneaux
+woodhouse whitford wescott welty weigel torgerson toms surber sunderland sterner setzer riojas
+pumphrey puga metts mcgarry mccandless magill lupo loveland llamas leclerc koons kahler huss holbert
+heintz haupt grimmett gaskill ellingson dorr dingess deweese desilva crossley cordeiro converse
+conde caldera cairns burmeister burkhalter brawner bott youngs vierra valladares shrum shropshire
+sevilla rusk rodarte pedraza nino merino mcminn markle mapp lajoie koerner kittrell kato hyder
+hollifield heiser hazlett greenwald fant eldredge dreher delafuente cravens claypool beecher aronson
+alanis worthen wojcik winger whitacre wellington valverde valdivia troupe thrower swindell suttles
+suh stroman spires slate shealy sarver sartin sadowski rondeau rolon rascon priddy paulino nolte
+munroe molloy mciver lykins loggins lenoir klotz kempf hupp hollowell hollander haynie harkness
+harker gottlieb frith eddins driskell doggett densmore charette cassady byrum burcham buggs benn
+whitted warrington vandusen vaillancourt steger siebert scofield quirk purser plumb orcutt nordstrom
+mosely michalski mcphail mcdavid mccraw marchese mannino lefevre largent lanza kress isham hunsaker
+hoch hildebrandt guarino grijalva graybill ewell ewald cusick crumley coston cathcart carruthers
+bullington bowes blain blackford barboza yingling weiland varga silverstein sievers shuster shumway
+runnels rumsey renfroe provencher polley mohler middlebrooks kutz koster groth glidden fazio deen
+chipman chenoweth champlin cedillo carrero carmody buckles brien boutin bosch berkowitz altamirano
+wilfong wiegand waites truesdale toussaint tobey tedder steelman sirois schnell robichaud richburg
+plumley pizarro piercy ortego oberg neace mertz mcnew matta lapp lair kibler howlett hollister hofer
+hatten hagler falgoust engelhardt eberle dombrowski dinsmore daye casares braud balch autrey wendel
+tyndall strobel stoltz spinelli serrato rochester reber rathbone palomino nickels mayle mathers mach
+loeffler littrell levinson leong lemire lejeune lazo lasley koller kennard hoelscher hintz hagerman
+greaves fore eudy engler corrales cordes brunet bidwell bennet tyrrell tharpe swinton stribling
+southworth sisneros savoie samons ruvalcaba ries ramer omara mosqueda millar mcpeak macomber luckey
+litton lehr lavin hubbs hoard hibbs hagans futrell exum evenson culler carbaugh callen brashear
+bloomer blakeney bigler addington woodford unruh tolentino sumrall stgermain smock sherer rayner
+pooler oquinn nero mcglothlin linden kowal kerrigan ibrahim harvell hanrahan goodall geist fussell
+fung ferebee eley eggert dorsett dingman destefano colucci clemmer burnell brumbaugh boddie
+berryhill avelar alcantara winder winchell vandenberg trotman thurber thibeault stlouis stilwell
+sperling shattuck sarmiento ruppert rumph renaud randazzo rademacher quiles pearman palomo mercurio
+lowrey lindeman lawlor larosa lander labrecque hovis holifield henninger hawkes hartfield hann hague
+genovese garrick fudge frink eddings dinh cribbs calvillo bunton brodeur bolding blanding agosto
+zahn wiener trussell tew tello teixeira speck sharma shanklin sealy scanlan santamaria roundy
+robichaux ringer rigney prevost polson nord moxley medford mccaslin mcardle macarthur lewin lasher
+ketcham keiser heine hackworth grose grizzle gillman gartner frazee fleury edson edmonson derry
+cronk conant burress burgin broom brockington bolick boger birchfield billington baily bahena
+armbruster anson yoho wilcher tinney timberlake thoma thielen sutphin stultz sikora serra schulman
+scheffler santillan rego preciado pinkham mickle luu lomas lizotte lent kellerman keil johanson
+hernadez hartsfield haber gorski farkas eberhardt duquette delano cropper cozart cockerham chamblee
+cartagena cahoon buzzell brister brewton blackshear benfield aston ashburn arruda wetmore weise
+vaccaro tucci sudduth stromberg stoops showalter shears runion rowden rosenblum riffle renfrow peres
+obryant leftwich lark landeros kistler killough kerley kastner hoggard hartung guertin govan gatling
+gailey fullmer fulford flatt esquibel endicott edmiston edelstein dufresne dressler dickman chee
+busse bonnett berard arena yoshida velarde veach vanhouten vachon tolson tolman tennyson stites
+soler shutt ruggles rhone pegues ong neese muro moncrief mefford mcphee mcmorris mceachern mcclurg
+mansour mader leija lecompte lafountain labrie jaquez heald hash hartle gainer frisby farina eidson
+edgerton dyke durrett duhon cuomo cobos cervantez bybee brockway borowski binion beery arguello
+amaro acton yuen winton wigfall weekley vidrine vannoy tardiff shoop shilling schick safford
+prendergast pellerin osuna nissen nalley moller messner messick merrifield mcguinness matherly
+marcano mahone lemos lebrun jara hoffer herren hecker haws haug gwin gober gilliard fredette favela
+echeverria downer donofrio desrochers crozier corson bechtold argueta aparicio zamudio westover
+westerman utter troyer thies tapley slavin shirk sandler roop raymer radcliff otten moorer millet
+mckibben mccutchen mcavoy mcadoo mayorga mastin martineau marek madore leflore kroeger kennon
+jimerson hostetter hornback hendley hance guardado granado gowen goodale flinn fleetwood fitz durkee
+duprey dipietro dilley clyburn brawley beckley arana weatherby vollmer vestal tunnell trigg tingle
+takahashi sweatt storer snapp shiver rooker rathbun poisson perrine perri pastor parmer parke pare
+palmieri nottingham midkiff mecham mccomas mcalpine lovelady lillard lally knopp kile kiger haile
+gupta goldsberry gilreath fulks friesen franzen flack findlay ferland dreyer dore dennard deckard
+debose crim coulombe cork chancey cantor branton bissell barns woolard witham wasserman spiegel
+shoffner scholz ruch rossman petry palacio paez neary mortenson millsap miele menke mckim mcanally
+martines manor lemley larochelle klaus klatt kaufmann kapp helmer hedge halloran glisson frechette
+fontana eagan distefano danley creekmore chartier chaffee carillo burg bolinger berkley benz basso
+bash barrier zelaya woodring witkowski wilmot wilkens wieland verdugo urquhart tsai timms swiger
+swaim sussman pires molnar mcatee lowder loos linker landes kingery hufford higa hendren hammack
+hamann gillam gerhardt edelman eby delk deans curl constantine cleaver claar casiano carruth carlyle
+brophy bolanos bibbs bessette beggs baugher bartel averill andresen amin adames via valente turnbow
+tse swink sublett stroh stringfellow ridgway pugliese poteat ohare neubauer murchison mingo lemmons
+kwon kellam kean jarmon hyden hudak hollinger henkel hemingway hasson hansel halter haire ginsberg
+gillispie fogel flory etter elledge eckman deas currin crafton coomer colter claxton bulter braddock
+bowyer binns bellows baskerville barros ansley woolf wight waldman wadley tull trull tesch stouffer
+stadler slay shubert sedillo santacruz reinke poynter neri neale mowry moralez monger mitchum
+merryman manion macdougall lux litchfield ley levitt lepage lasalle khoury kavanagh karns ivie
+huebner hodgkins halpin garica eversole dutra dunagan duffey dillman dillion deville dearborn damato
+courson coulson burdine bousquet bonin bish atencio westbrooks wages vaca tye toner tillis swett
+struble stanfill solorzano slusher sipple sim silvas shults schexnayder saez rodas rager pulver
+plaza penton paniagua meneses mcfarlin mcauley matz maloy magruder lohman landa lacombe jaimes hom
+holzer holst heil hackler grundy gilkey farnham durfee dunton dunston duda dews craver corriveau
+conwell colella chambless bremer boutte bourassa blaisdell backman babineaux audette alleman towner
+taveras tarango sullins suiter stallard solberg schlueter poulos pimental owsley okelley nations
+moffatt metcalfe meekins medellin mcglynn mccowan marriott marable lennox lamoureux koss kerby karp
+isenberg howze hockenberry highsmith harbour hallmark gusman greeley giddings gaudet gallup fleenor
+eicher edington dimaggio dement demello decastro bushman brundage brooker bourg blackstock bergmann
+beaton banister argo appling wortman watterson villalpando tillotson tighe sundberg sternberg stamey
+shipe seeger scarberry sattler sain rothstein poteet plowman pettiford penland partain pankey oyler
+ogletree ogburn moton merkel lucier lakey kratz kinser kershaw josephson imhoff hendry hammon
+frisbie friedrich frawley fraga forester eskew emmert drennan doyon dandridge cawley carvajal bracey
+belisle batey ahner wysocki weiser veliz tincher sansone sankey sandstrom rohrer risner pridemore
+pfeffer persinger peery oubre nowicki musgrave murdoch mullinax mccary mathieu livengood kyser klink
+kimes kellner kavanaugh kasten imes hoey hinshaw hake gurule grube grillo geter gatto garver
+garretson farwell eiland dunford decarlo corso colman collard cleghorn chasteen cavender carlile
+calvo byerly brogdon broadwater breault bono bergin behr ballenger amick tamez stiffler steinke
+simmon shankle schaller salmons sackett saad rideout ratcliffe rao ranson plascencia petterson
+olszewski olney olguin nilsson nevels morelli montiel monge michaelson mertens mcchesney mcalpin
+mathewson loudermilk lineberry liggett kinlaw kight jost hereford hardeman halpern halliday hafer
+gaul friel freitag forsberg evangelista doering dicarlo dendy delp deguzman dameron curtiss cosper
+cauthen cao bradberry bouton bonnell bixby bieber beveridge bedwell barhorst bannon baltazar baier
+ayotte attaway arenas abrego turgeon tunstall thaxton thai tenorio stotts sthilaire shedd seabolt
+scalf salyers ruhl rowlett robinett pfister perlman parkman nunnally norvell napper modlin mckellar
+mcclean mascarenas leibowitz ledezma kuhlman kobayashi hunley holmquist hinkley hartsell gribble
+gravely fifield eliason doak crossland carleton bridgeman bojorquez boggess auten woosley whiteley
+wexler twomey tullis townley standridge santoyo rueda riendeau revell pless ottinger nigro nickles
+mulvey menefee mcshane mcloughlin mckinzie markey lockridge lipsey knisley knepper kitts kiel jinks
+hathcock godin gallego fikes fecteau estabrook ellinger dunlop dudek countryman chauvin chatham
+bullins brownfield boughton bloodworth bibb baucom barbieri aubin armitage alessi absher abbate zito
+woolery wiggs wacker tynes tolle telles tarter swarey strode stockdale stalnaker spina schiff saari
+risley rameriz rakes pettaway penner paulus palladino omeara montelongo melnick mehta mcgary mccourt
+mccollough marchetti manzanares lowther leiva lauderdale lafontaine kowalczyk knighton joubert
+jaworski ide huth hurdle housley hackman gulick gordy gilstrap gehrke gebhart gaudette foxworth
+essex endres dunkle cimino caddell brauer braley bodine blackmore belden backer ayer andress wisner
+vuong valliere twigg tso tavarez strahan steib staub sowder seiber schutt scharf schade rodriques
+risinger renshaw rahman presnell piatt nieman nevins mcilwain mcgaha mccully mccomb massengale
+macedo lesher kearse jauregui husted hudnall holmberg hertel hardie glidewell frausto fassett
+dalessandro dahlgren corum constantino conlin colquitt colombo claycomb cardin buller boney
+bocanegra biggers benedetto araiza andino albin zorn werth weisman walley vanegas ulibarri towe
+tedford teasley suttle steffens stcyr squire singley sifuentes shuck schram sass rieger ridenhour
+rickert richerson rayborn rabe raab pendley pastore ordway moynihan mellott mckissick mcgann
+mccready mauney marrufo lenhart lazar lafave keele kautz jardine jahnke jacobo hord hardcastle
+hageman giglio gehring fortson duque duplessis dicken derosier deitz dalessio cram castleman
+candelario callison caceres bozarth biles bejarano bashaw avina armentrout alverez acord waterhouse
+vereen vanlandingham uhl strawser shotwell severance seltzer schoonmaker schock schaub schaffner
+roeder rodrigez riffe rhine rasberry rancourt railey quade pursley prouty perdomo oxley osterman
+nickens murphree mounts merida maus mattern masse martinelli mangan lutes ludwick loney laureano
+lasater knighten kissinger kimsey kessinger honea hollingshead hockett heyer heron gurrola gove
+glasscock gillett galan featherstone eckhardt duron dunson dasher culbreth cowden cowans claypoole
+churchwell chabot caviness cater caston callan byington burkey boden beckford atwater archambault
+alvey alsup whisenant weese voyles verret tsang tessier sweitzer sherwin shaughnessy revis remy
+prine philpott peavy paynter parmenter ovalle offutt nightingale newlin nakano myatt muth mohan
+mcmillon mccarley mccaleb maxson marinelli maley liston letendre kain huntsman hirst hagerty
+gulledge greenway grajeda gorton goines gittens frederickson fanelli embree eichelberger dunkin
+dixson dillow defelice chumley burleigh borkowski binette biggerstaff berglund beller audet arbuckle
+allain alfano youngman wittman weintraub vanzant vaden twitty stollings standifer sines shope
+scalise saville posada pisano otte nolasco napoli mier merkle mendiola melcher mejias mcmurry
+mccalla markowitz manis mallette macfarlane lough looper landin kittle kinsella kinnard hobart
+herald helman hellman hartsock halford hage gordan glasser gayton gattis gastelum gaspard frisch
+fitzhugh eckstein eberly dowden despain crumpler crotty cornelison chouinard chamness catlin cann
+bumgardner budde branum bradfield braddy borst birdwell bazan banas bade arango ahearn addis zumwalt
+wurth wilk widener wagstaff urrutia terwilliger tart steinman staats sloat rives riggle revels
+reichard prickett poff pitzer petro pell northrup nicks moline mielke maynor mallon magness lingle
+lindell lieb lesko lebeau lammers lafond kiernan ketron jurado holmgren hilburn hayashi hashimoto
+harbaugh guillot gard froehlich feinberg falco dufour drees doney diep delao daves dail crowson coss
+congdon carner camarena butterworth burlingame bouffard bloch bilyeu barta bakke baillargeon avent
+aquilar ake aho zeringue yarber wolfson vogler voelker truss troxell thrift strouse spielman
+sistrunk sevigny schuller schaaf ruffner routh roseman ricciardi peraza pegram overturf olander
+odaniel neu millner melchor maroney machuca macaluso livesay layfield laskowski kwiatkowski kilby
+hovey heywood hayman havard harville haigh hagood grieco glassman gebhardt fleischer fann elson
+eccles cunha crumb blakley bardwell abshire woodham wines welter wargo varnado tutt traynor swaney
+svoboda stricker stoffel stambaugh sickler shackleford selman seaver sansom sanmiguel royston rourke
+rockett rioux puleo pitchford nardi mulvaney middaugh malek leos lathan kujawa kimbro killebrew
+houlihan hinckley herod hepler hamner hammel hallowell gonsalez gingerich gambill funkhouser fricke
+fewell falkner endsley dulin drennen deaver dambrosio chadwell castanon burkes brune brisco brinker
+bowker boldt berner beaumont beaird bazemore barrick albano younts wunderlich weidman vanness toland
+theobald stickler steiger stanger spies spector sollars smedley seibel scoville saito rye rummel
+rowles rouleau roos rogan roemer ream raya purkey priester perreira penick paulin parkins overcash
+oleson neves muldrow minard midgett michalak melgar mcentire mcauliffe marte lydon lindholm leyba
+langevin lagasse lafayette kesler kelton kao kaminsky jaggers humbert huck howarth hinrichs higley
+gupton guimond gravois giguere fretwell fontes feeley faucher eichhorn ecker earp dole dinger
+derryberry demars deel copenhaver collinsworth colangelo cloyd claiborne caulfield carlsen calzada
+caffey broadus brenneman bouie bodnar blaney blanc beltz behling barahona yockey winkle windom wimer
+villatoro trexler teran taliaferro sydnor swinson snelling smtih simonton simoneaux simoneau sherrer
+seavey scheel rushton rupe ruano rippy reiner reiff rabinowitz quach penley odle nock minnich mckown
+mccarver mcandrew longley laux lamothe lafreniere kropp krick kates jepson huie howse howie
+henriques haydon haught hartzog harkey grimaldo goshorn gormley gluck gilroy gillenwater giffin
+fluker feder eyre eshelman eakins detwiler delrosario davisson catalan canning calton brammer
+botelho blakney bartell averett askins aker zak worcester witmer wiser winkelman widmer whittier
+weitzel wardell wagers ullman tupper tingley tilghman talton simard seda scheller sala rundell rost
+roa ribeiro rabideau primm pinon peart ostrom ober nystrom nussbaum naughton murr moorhead monti
+monteiro melson meissner mclin mcgruder marotta makowski majewski madewell lunt lukens leininger
+lebel lakin kepler jaques hunnicutt hungerford hoopes hertz heins halliburton grosso gravitt glasper
+gallman gallaway funke fulbright falgout eakin dostie dorado dewberry derose cutshall crampton
+costanzo colletti cloninger claytor chiang canterbury campagna burd brokaw broaddus bretz brainard
+binford bilbrey alpert aitken ahlers zajac woolfolk witten windle wayland tramel tittle talavera
+suter straley specht sommerville soloman skeens sigman sibert shavers schuck schmit sartain sabol
+rosenblatt rollo rashid rabb province polston nyberg northrop navarra muldoon mikesell mcdougald
+mcburney mariscal lui lozier lingerfelt legere latour lagunas lacour kurth killen kiely kayser kahle
+isley huertas hower hinz haugh gumm galicia fortunato flake dunleavy duggins doby digiovanni devaney
+deltoro cribb corpuz coronel coen charbonneau caine burchette blakey blakemore bergquist beene
+beaudette bayles ballance bakker bailes asberry arwood zucker willman whitesell wald walcott
+vancleave trump strasser simas shick schleicher schaal saleh rotz resnick rainer partee ollis oller
+oday munday mong millican merwin mazzola mansell magallanes llanes lewellen lepore kisner keesee
+jeanlouis ingham hornbeck hawn hartz harber haffner gutshall guth grays gowan finlay finkelstein
+eyler enloe dungan diez dearman cull crosson chronister cassity campion callihan butz breazeale
+blumenthal berkey batty batton arvizu alderete aldana albaugh abernethy wolter wille tweed tollefson
+thomasson teter testerman sproul spates southwick soukup skelly senter sealey sawicki sargeant
+rossiter rosemond repp pifer ormsby nickelson naumann morabito monzon millsaps millen mcelrath
+marcoux mantooth madson macneil mackinnon louque leister lampley kushner krouse kirwan jessee janson
+jahn jacquez islas hutt holladay hillyer hepburn hensel harrold gingrich geis gales fults finnell
+ferri featherston epley ebersole eames dunigan drye dismuke devaughn delorenzo damiano confer collum
+clower clow claussen clack caylor cawthon casias carreno bluhm bingaman bewley belew beckner auld
+amey wolfenbarger wilkey wicklund waltman villalba valero valdovinos ung ullrich tyus twyman trost
+tardif tanguay stripling steinbach shumpert sasaki sappington sandusky reinhold reinert quijano pye
+placencia pinkard phinney perrotta pernell parrett oxendine owensby orman nuno mori mcroberts
+mcneese mckamey mccullum markel mardis maines lueck lubin lefler leffler larios labarbera kershner
+josey jeanbaptiste izaguirre hermosillo haviland hartshorn hafner ginter getty franck fiske dufrene
+doody davie dangerfield dahlberg cuthbertson crone coffelt chidester chesson cauley caudell cantara
+campo caines bullis bucci brochu bogard bickerstaff benning arzola antonelli adkinson zellers wulf
+worsley woolridge whitton westerfield walczak vassar truett trueblood trawick townsley topping tobar
+telford steverson stagg sitton sill sergent schoenfeld sarabia rutkowski rubenstein rigdon prentiss
+pomerleau plumlee philbrick peer patnode oloughlin obregon nuss morell mikell mele mcinerney
+mcguigan mcbrayer lor lollar lakes kuehl kinzer kamp joplin jacobi howells holstein hedden hassler
+harty halle greig gouge goodrum gerhart geier geddes gast forehand ferree fendley feltner esqueda
+encarnacion eichler egger edmundson eatmon doud donohoe donelson dilorenzo digiacomo diggins
+delozier dejong danford crippen coppage cogswell clardy cioffi cabe brunette bresnahan bramble
+blomquist blackstone biller bevis bevan bethune benbow baty basinger balcom andes aman aguero
+adkisson yandell wilds whisenhunt weigand weeden voight villar trottier tillett suazo setser scurry
+schuh schreck schauer samora roane rinker reimers ratchford popovich parkin natal melville mcbryde
+magdaleno loehr lockman lingo leduc larocca lao lamere laclair krall korte koger jalbert hughs
+higbee henton heaney haith gump greeson goodloe gholston gasper gagliardi fregoso farthing fabrizio
+ensor elswick elgin eklund eaddy drouin dorton dizon derouen deherrera davy dampier cullum culley
+cowgill cardoso cardinale brodsky broadbent brimmer briceno branscum bolyard boley bennington beadle
+baur ballentine azure aultman arciniega aguila aceves yepez yap woodrum wethington weissman veloz
+trusty troup trammel tarpley stivers steck sprayberry spraggins spitler spiers sohn seagraves
+schiffman rudnick rizo riccio rennie quackenbush puma plott pearcy parada paiz munford moskowitz
+mease mcnary mccusker lozoya longmire loesch lasky kuhlmann krieg koziol kowalewski konrad kindle
+jowers jolin jaco hua horgan hine hileman hepner heise heady hawkinson hannigan haberman guilford
+grimaldi garton gagliano fruge follett fiscus ferretti ebner easterday eanes dirks dimarco depalma
+deforest cruce craighead christner candler cadwell burchell buettner brinton brazier brannen brame
+bova bomar blakeslee belknap bangs balzer athey armes alvis alverson alvardo yeung wheelock westlund
+wessels volkman threadgill thelen tague symons swinford sturtevant straka stier stagner segarra
+seawright rutan roux ringler riker ramsdell quattlebaum purifoy poulson permenter peloquin pasley
+pagel osman obannon nygaard newcomer munos motta meadors mcquiston mcniel mcmann mccrae mayne matte
+legault lechner kucera krohn kratzer koopman jeske horrocks hock hibbler hesson hersh harvin
+halvorsen griner grindle gladstone garofalo frampton forbis eddington diorio dingus dewar desalvo
+curcio creasy cortese cordoba connally cluff cascio capuano canaday calabro bussard brayton borja
+bigley arnone arguelles acuff zamarripa wooton widner wideman threatt thiele templin teeters synder
+swint swick sturges stogner stedman spratt siegfried shetler scull savino sather rothwell rook rone
+rhee quevedo privett pouliot poche pickel petrillo pellegrini peaslee partlow otey nunnery morelock
+morello meunier messinger mckie mccubbin mccarron lerch lavine laverty lariviere lamkin kugler krol
+kissel keeter hubble hickox hetzel hayner hagy hadlock groh gottschalk goodsell gassaway garrard
+galligan fye firth fenderson feinstein etienne engleman emrick ellender drews doiron degraw deegan
+dart crissman corr cookson coil cleaves charest chapple chaparro castano carpio byer bufford
+bridgewater bridgers brandes borrero bonanno aube ancheta abarca abad yim wooster wimbush willhite
+willams wigley weisberg wardlaw vigue vanhook unknow torre tasker tarbox strachan slover shamblin
+semple schuyler schrimsher sayer salzman rubalcava riles reneau reichel rayfield rabon pyatt prindle
+poss polito plemmons pesce perrault pereyra ostrowski nilsen niemeyer munsey mundell moncada miceli
+meader mcmasters mckeehan matsumoto marron marden lizarraga lingenfelter lewallen langan lamanna
+kovac kinsler kephart keown kass kammerer jeffreys hysell householder hosmer hardnett hanner guyette
+greening glazer ginder fromm fluellen finkle fey fessler essary eisele duren dittmer crochet
+cosentino cogan coelho cavin carrizales campuzano brough bopp bookman blouin beesley battista bascom
+bakken badgett arneson anselmo ahumada woodyard wolters wireman willison warman waldrup vowell
+vantassel vale twombly toomer tennison teets tedeschi swanner stutz stelly sheehy schermerhorn scala
+sandidge salters salo saechao roseboro rolle ressler renz renn redford raposa rainbolt pelfrey
+orndorff oney nolin nimmons ney nardone myhre morman menjivar mcglone mccammon maxon marciano manus
+lowrance lorenzen lonergan lollis littles lindahl lamas lach kuster krawczyk knuth knecht kirkendall
+keitt keever kantor jarboe hoye houchens holter holsinger hickok helwig helgeson hassett harner
+hamman hames hadfield goree goldfarb gaughan gaudreau gantz gallion frady foti flesher ferrin faught
+engram donegan desouza degroot cutright crowl criner coan clinkscales chewning chavira catchings
+carlock bulger buenrostro bramblett brack boulware bookout bitner birt baranowski baisden augustin
+allmon acklin yoakum wilbourn whisler weinberger washer vasques vanzandt vanatta troxler tomes
+tindle tims throckmorton thach stpeter stlaurent stenson spry spitz songer snavely sly shroyer
+shortridge shenk sevier seabrook scrivner saltzman rosenberry rockwood robeson roan reiser ramires
+raber posner popham piotrowski pinard peterkin pelham peiffer peay nadler musso millett mestas
+mcgowen marques marasco manriquez manos mair lipps leiker krumm knorr kinslow kessel kendricks kelm
+ito irick ickes hurlburt horta hoekstra heuer helmuth heatherly hampson hagar haga greenlaw grau
+godbey gingras gillies gibb gayden gauvin garrow fontanez florio finke fasano ezzell ewers eveland
+eckenrode duclos drumm dimmick delancey defazio dashiell cusack crowther crigger cray coolidge
+coldiron cleland chalfant cassel camire cabrales broomfield brittingham brisson brickey braziel
+brazell bragdon boulanger bos boman bohannan beem barre baptist azar ashbaugh armistead almazan
+adamski zendejas winburn willaims wilhoit westberry wentzel wendling visser vanscoy vankirk vallee
+tweedy thornberry sweeny spradling spano smelser shim sechrist schall scaife rugg rothrock roesler
+riehl ridings render ransdell radke pinero petree pendergast peluso pecoraro pascoe panek oshiro
+navarrette murguia moores moberg michaelis mcwhirter mcsweeney mcquade mccay mauk mariani marceau
+mandeville maeda lunde ludlow loeb lindo linderman leveille leith larock lambrecht kulp kinsley
+kimberlin kesterson hoyos helfrich hanke grisby goyette gouveia glazier gile gerena gelinas gasaway
+funches fujimoto flynt fenske fellers fehr eslinger escalera enciso duley dittman dineen diller
+devault dao collings clymer clowers chavers charland castorena castello camargo bunce bullen boyes
+borchers borchardt birnbaum birdsall billman benites bankhead ange ammerman adkison winegar wickman
+warr warnke villeneuve veasey vassallo vannatta vadnais twilley towery tomblin tippett theiss
+talkington talamantes swart swanger streit stines stabler spurling sobel sine simmers shippy
+shiflett shearin sauter sanderlin rusch runkle ruckman rorie roesch richert rehm randel ragin
+quesenberry puentes plyler plotkin paugh oshaughnessy ohalloran norsworthy niemann nader moorefield
+mooneyham modica miyamoto mickel mebane mckinnie mazurek mancilla lukas lovins loughlin lotz
+lindsley liddle levan lederman leclaire lasseter lapoint lamoreaux lafollette kubiak kirtley keffer
+kaczmarek housman hiers hibbert herrod hegarty hathorn greenhaw grafton govea futch furst franko
+forcier foran flickinger fairfield eure emrich embrey edgington ecklund eckard durante deyo
+delvecchio dade currey creswell cottrill casavant cartier cargile capel cammack calfee burse burruss
+brust brousseau bridwell braaten borkholder bloomquist bjork bartelt arp amburgey yeary yao
+whitefield vinyard vanvalkenburg twitchell timmins tapper stringham starcher spotts slaugh simonsen
+sheffer sequeira rosati rhymes reza quint pollak peirce patillo parkerson paiva nilson nevin
+narcisse nair mitton merriam merced meiners mckain mcelveen mcbeth marsden marez manke mahurin
+mabrey luper krull kees iles hunsicker hornbuckle holtzclaw hirt hinnant heston hering hemenway
+hegwood hearns halterman guiterrez grote granillo grainger glasco gilder garren garlock garey fryar
+fredricks fraizer foxx foshee ferrel felty everitt evens esser elkin eberhart durso duguay driskill
+doster dewall deveau demps demaio delreal deleo deem darrah cumberbatch culberson cranmer cordle
+colgan chesley cavallo castellon castelli carreras carnell carlucci bontrager blumberg blasingame
+becton ayon artrip andujar alkire alder agan zukowski zuckerman zehr wroblewski wrigley woodside
+wigginton westman westgate werts washam wardlow walser waiters tadlock stringfield stimpson stickley
+standish spurlin spindler speller spaeth sotomayor sok sluder shryock shepardson shatley scannell
+santistevan rosner rhode resto reinhard rathburn prisco poulsen pinney phares pennock pastrana
+oviedo ostler noto nauman mulford moise moberly mirabal metoyer metheny mentzer meldrum mcinturff
+mcelyea mcdougle massaro lumpkins loveday lofgren loe lirette lesperance lefkowitz ledger lauzon
+lain lachapelle kurz klassen keough kempton kaelin jeffords huot hsieh hoyer horwitz hopp hoeft
+hennig haskin gourdine golightly girouard fulgham fritsch freer frasher foulk firestone fiorentino
+fedor ensley englehart eells ebel dunphy donahoe dileo dibenedetto dabrowski crick coonrod conder
+coddington chunn choy chaput cerna carreiro calahan braggs bourdon bollman bittle behm bauder batt
+barreras aubuchon anzalone adamo zerbe wirt willcox westberg weikel waymire vroman vinci vallejos
+truesdell troutt trotta tollison toles tichenor symonds surles strayer stgeorge sroka sorrentino
+solares snelson silvestri sikorski shawver schumaker schorr schooley scates satterlee satchell sacks
+rymer roselli robitaille riegel regis reames provenzano priestley plaisance pettey palomares oman
+nowakowski nace monette minyard mclamb mchone mccarroll masson magoon maddy lundin loza licata
+leonhardt lema landwehr kircher kinch karpinski johannsen hussain houghtaling hoskinson hollaway
+holeman hobgood hilt hiebert gros goggin geissler gadbois gabaldon fleshman flannigan fairman epp
+eilers dycus dunmire duffield dowler deloatch dehaan deemer clayborn christofferso chilson chesney
+chatfield carron canale brigman branstetter bosse borton bonar blau biron barroso arispe zacharias
+zabel yaeger woolford whetzel weakley veatch vandeusen tufts troxel troche traver townsel tosh
+talarico swilley sterrett stenger speakman sowards sours souders souder soles sobers snoddy smither
+sias shute shoaf shahan schuetz scaggs santini rosson rolen robidoux rentas recio pixley pawlowski
+pawlak paull overbey orear oliveri oldenburg nutting naugle mote mossman moor misner milazzo
+michelson mcentee mccullar mccree mcaleer mazzone mandell manahan malott maisonet mailloux lumley
+lowrie louviere lipinski lindemann leppert leopold leasure labarge kubik knisely knepp kenworthy
+kennelly kelch karg kanter hyer houchin hosley hosler hollon holleman heitman hebb haggins gwaltney
+guin goulding gorden geraci georges gathers frison feagin falconer espada erving erikson eisenhauer
+eder ebeling durgin dowdle dinwiddie delcastillo dedrick crimmins covell cournoyer coria cohan
+cataldo carpentier canas campa brode brashears blaser bicknell berk bednar barwick ascencio althoff
+almodovar alamo zirkle zabala wolverton winebrenner wetherell westlake wegener weddington vong tuten
+trosclair tressler theroux teske swinehart swensen sundquist southall socha sizer silverberg shortt
+shimizu sherrard shaeffer scheid scheetz saravia sanner rubinstein rozell romer rheaume reisinger
+randles pullum petrella payan papp nordin norcross nicoletti nicholes newbold nakagawa mraz monteith
+milstead milliner mellen mccardle luft liptak lipp leitch latimore larrison landau laborde koval
+izquierdo hymel hoskin holte hoefer hayworth hausman harrill harrel hardt gully groover grinnell
+greenspan graver grandberry gorrell goldenberg goguen gilleland garr fuson foye feldmann everly
+dyess dyal dunnigan downie dolby deatherage cosey cheever celaya caver cashion caplinger cansler
+byrge bruder breuer breslin brazelton botkin bonneau bondurant bohanan bogue boes bodner boatner
+blatt bickley belliveau beiler beier beckstead bachmann atkin altizer alloway allaire albro abron
+zellmer yetter yelverton wiltshire wiens whidden viramontes vanwormer tarantino tanksley sumlin
+strauch strang stice spahn sosebee sigala shrout seamon schrum schneck schantz ruddy romig roehl
+renninger reding pyne polak pohlman pasillas oldfield oldaker ohanlon ogilvie norberg nolette nies
+neufeld nellis mummert mulvihill mullaney monteleone mendonca meisner mcmullan mccluney mattis
+massengill manfredi luedtke lounsbury liberatore leek lamphere laforge kuo koo jourdan ismail iorio
+iniguez ikeda hubler hodgdon hocking heacock haslam haralson hanshaw hannum hallam haden garnes
+garces gammage gambino finkel faucett fahy ehrhardt eggen dusek durrant dubay dones dey depasquale
+delucia degraff decamp davalos cullins conard clouser clontz cifuentes chappel chaffins celis
+carwile byram bruggeman bressler brathwaite brasfield bradburn boose boon bodie blosser blas bise
+bertsch bernardi bernabe bengtson barrette astorga alday albee abrahamson yarnell wiltse wile wiebe
+waguespack vasser upham tyre turek traxler torain tomaszewski tinnin tiner tindell teed styron
+stahlman staab skiba shih sheperd seidl secor schutte sanfilippo ruder rondon rearick procter
+prochaska pettengill pauly neilsen nally mutter mullenax morano meads mcnaughton mcmurtry mcmath
+mckinsey matthes massenburg marlar margolis malin magallon mackin lovette loughran loring longstreet
+loiselle lenihan laub kunze kull koepke kerwin kalinowski kagan innis innes holtzman heinemann
+harshman haider haack guss grondin grissett greenawalt gravel goudy goodlett goldston gokey gardea
+galaviz gafford gabrielson furlow fritch fordyce folger elizalde ehlert eckhoff eccleston ealey
+dubin diemer deschamps delapena decicco debolt daum cullinan crittendon crase cossey coppock coots
+colyer cluck chamberland burkhead bumpus buchan borman bork boe birkholz berardi benda behnke barter
+auer amezquita wotring wirtz wingert wiesner whitesides weyant wainscott venezia varnell tussey
+thurlow tabares stiver stell starke stanhope stanek sisler sinnott siciliano shehan selph seager
+scurlock scranton santucci santangelo saltsman ruel ropp rogge rettig renwick reidy reider redfield
+quam premo peet parente paolucci palmquist orme ohler ogg netherton mutchler morita mistretta minnis
+middendorf menzel mendosa mendelson meaux mcspadden mcquaid mcnatt manigault maney mager lukes
+lopresti liriano lipton letson lechuga lazenby lauria larimore kwok kwak krupp krupa krum kopec
+kinchen kifer kerney kerner kennison kegley kays karcher justis johson jellison janke huskins
+holzman hinojos hefley hatmaker harte halloway hallenbeck goodwyn glaspie geise fullwood fryman frew
+frakes fraire farrer enlow engen ellzey eckles earles ealy dunkley drinkard dreiling draeger dinardo
+dills desroches desantiago curlee crumbley critchlow coury courtright coffield cleek charpentier
+cardone caples cantin buntin bugbee brinkerhoff brackin bourland bohl bogdan blassingame beacham
+banning auguste andreasen amann almon alejo adelman abston zeno yerger wymer woodberry windley
+whiteaker westfield weibel wanner waldrep villani vanarsdale utterback updike triggs topete tolar
+tigner thoms tauber tarvin tally swiney sweatman studebaker stennett starrett stannard stalvey
+sonnenberg smithey sieber sickles shinault segars sanger salmeron rothe rizzi rine ricard restrepo
+ralls ragusa quiroga pero pegg pavlik papenfuss oropeza okane neer nee mudge mozingo molinaro
+mcvicker mcgarvey mcfalls mccraney matus magers llanos livermore liss linehan leto leitner laymon
+lawing lacourse kwong kollar kneeland keo kennett kellett kangas janzen hutter huse huling hoss hohn
+hofmeister hewes hern harjo habib gust guice grullon greggs grayer granier grable gowdy giannini
+getchell gartman garnica ganey gallimore fray fetters fergerson farlow fagundes exley esteves enders
+edenfield easterwood drakeford dipasquale desousa deshields deeter dedmon debord daughtery cutts
+courtemanche coursey copple coomes collis coll cogburn clopton choquette chaidez castrejon calhoon
+burbach bulloch buchman bruhn bohon blough bien baynes barstow zeman zackery yardley yamashita wulff
+wilken wiliams wickersham wible whipkey wedgeworth walmsley walkup vreeland verrill valera umana
+traub swingle summey stroupe stockstill steffey stefanski statler stapp speights solari soderberg
+shunk shorey shewmaker sheilds schiffer schank schaff sagers rochon riser rickett reale raglin polen
+plata pitcock percival palen pahl orona oberle nocera navas nault mullings moos montejano monreal
+minick middlebrook meece mcmillion mccullen mauck marshburn maillet mahaney magner maclin lucey
+litteral lippincott leite leis leaks lamarre kost jurgens jerkins jager hurwitz hughley hotaling
+horstman hohman hocker hively hipps hile hessler hermanson hepworth henn helland hedlund harkless
+haigler gutierez grindstaff glantz giardina gerken gadsden
+`)
@@ -0,0 +1,212 @@
+package keep
+
+import (
+	"bytes"
+	"crypto"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/packet"
+)
+
+const (
+	// ProfileTypeSymmetric is the type of the profiles encrypted with a passphrase instead of GPG keys.
+	ProfileTypeSymmetric = "symmetric"
+
+	// symmetricCacheID is the key under which gpg-agent caches the passphrase of symmetric profiles.
+	symmetricCacheID = "keep:symmetric"
+
+	// PassphraseCheckFile is the file of the AccountDir of a symmetric profile encrypted with the
+	// passphrase, it is decrypted to check the passphrase before an account is encrypted.
+	PassphraseCheckFile = ".keep-passphrase"
+
+	// defaultPassphraseTimeout is the PassphraseTimeout of the Config returned by NewConfig.
+	defaultPassphraseTimeout = 15 * time.Minute
+)
+
+// passphraseCheck is the clear text of the PassphraseCheckFile.
+var passphraseCheck = []byte("keep passphrase check\n")
+
+// ErrWrongPassphrase is returned when a passphrase is rejected, e.g. when the passphrase
+// of a symmetric profile cannot decrypt an account.
+var ErrWrongPassphrase = errors.New("Wrong passphrase")
+
+// symmetricConfig makes the passphrase expensive to brute force: AES-256 and
+// the maximum number of S2K iterations. gpg -d is still able to decrypt the files.
+var symmetricConfig = &packet.Config{
+	DefaultCipher: packet.CipherAES256,
+	DefaultHash:   crypto.SHA512,
+	S2KCount:      65011712,
+}
+
+// IsSymmetric returns true if the accounts are encrypted with a passphrase instead of GPG keys.
+func (c *Config) IsSymmetric() bool {
+	return c.Type == ProfileTypeSymmetric
+}
+
+// symmetricPassphrase returns the passphrase of a symmetric profile.
+// It is requested with the PromptFunction the first time and kept in a Secret until ForgetPassphrase
+// or until the PassphraseTimeout has elapsed.
+func (c *Config) symmetricPassphrase() ([]byte, error) {
+	if c.passphrase != nil && c.PassphraseTimeout > 0 && time.Since(c.passphraseAt) > c.PassphraseTimeout {
+		c.ForgetPassphrase()
+	}
+	if c.passphrase != nil {
+		return c.passphrase.Bytes(), nil
+	}
+	passphrase, err := c.requestPassphrase()
+	if err != nil {
+		return nil, err
+	}
+	c.passphrase = NewSecret(passphrase)
+	c.passphraseAt = time.Now()
+	return passphrase, nil
+}
+
+// requestPassphrase requests the passphrase of a symmetric profile with the PromptFunction.
+func (c *Config) requestPassphrase() ([]byte, error) {
+	if c.PromptFunction == nil {
+		return nil, fmt.Errorf("No PromptFunction to request the passphrase")
+	}
+	passphrase, err := c.PromptFunction(nil, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(passphrase) == 0 {
+		return nil, fmt.Errorf("The passphrase cannot be empty")
+	}
+	return passphrase, nil
+}
+
+// verifiedPassphrase returns the passphrase of a symmetric profile once it has been checked, so that
+// an account is never encrypted with a mistyped passphrase. The passphrase is checked by decrypting
+// the PassphraseCheckFile or, for the vaults without one, an account. The passphrase of a new vault
+// is requested twice instead. The PassphraseCheckFile is written once the passphrase has been checked.
+func (c *Config) verifiedPassphrase() ([]byte, error) {
+	passphrase, err := c.symmetricPassphrase()
+	if err != nil || c.passphraseVerified {
+		return passphrase, err
+	}
+	checkPath := filepath.Join(c.AccountDir, PassphraseCheckFile)
+	if _, err := os.Stat(checkPath); err == nil {
+		err = c.checkPassphraseFile(checkPath)
+		if err != nil {
+			return nil, err
+		}
+		c.passphraseVerified = true
+		return passphrase, nil
+	}
+
+	files, err := c.ListAccountFiles("")
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	if len(files) > 0 {
+		err = c.checkPassphraseFile(filepath.Join(c.AccountDir, files[0].Name()))
+	} else {
+		err = c.confirmPassphrase(passphrase)
+	}
+	if err != nil {
+		return nil, err
+	}
+	c.passphraseVerified = true
+	err = c.writePassphraseCheck()
+	if err != nil {
+		return nil, err
+	}
+	return passphrase, nil
+}
+
+// checkPassphraseFile decrypts a file with the passphrase, ErrWrongPassphrase is returned if it cannot.
+func (c *Config) checkPassphraseFile(path string) error {
+	md, err := c.decodeAccountFile(path)
+	if err != nil {
+		return err
+	}
+	clear, err := ioutil.ReadAll(md.UnverifiedBody)
+	WipeBytes(clear)
+	if err != nil {
+		c.ForgetPassphrase()
+		return ErrWrongPassphrase
+	}
+	return nil
+}
+
+// confirmPassphrase requests the passphrase of a new vault a second time and makes sure both match.
+func (c *Config) confirmPassphrase(passphrase []byte) error {
+	confirmation, err := c.requestPassphrase()
+	if err != nil {
+		c.ForgetPassphrase()
+		return err
+	}
+	defer WipeBytes(confirmation)
+	if !bytes.Equal(passphrase, confirmation) {
+		c.ForgetPassphrase()
+		return fmt.Errorf("The passphrases do not match")
+	}
+	return nil
+}
+
+// writePassphraseCheck writes the PassphraseCheckFile with the passphrase of the Config.
+func (c *Config) writePassphraseCheck() error {
+	content, err := c.encrypt(passphraseCheck, nil, "")
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(c.AccountDir, 0700)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(c.AccountDir, PassphraseCheckFile), content, 0600)
+}
+
+// InitPassphrase sets the passphrase of a new symmetric profile, it has been confirmed by the caller,
+// and writes the PassphraseCheckFile. The Config takes the ownership of the passphrase.
+func (c *Config) InitPassphrase(passphrase []byte) error {
+	if len(passphrase) == 0 {
+		return fmt.Errorf("The passphrase cannot be empty")
+	}
+	c.ForgetPassphrase()
+	c.passphrase = NewSecret(passphrase)
+	c.passphraseAt = time.Now()
+	c.passphraseVerified = true
+	return c.writePassphraseCheck()
+}
+
+// VerifyPassphrase requests the passphrase of the profile and checks it. The passphrase of a symmetric
+// profile, unless it is known, is checked against the vault and ErrWrongPassphrase is returned if it is
+// wrong. For the other profiles it decrypts the secret key of one of the recipients of the profile.
+func (c *Config) VerifyPassphrase() error {
+	if !c.IsSymmetric() {
+		return c.verifyKeyPassphrase()
+	}
+	_, err := c.verifiedPassphrase()
+	return err
+}
+
+// ForgetPassphrase wipes the passphrase of a symmetric profile, it is requested again with the PromptFunction.
+func (c *Config) ForgetPassphrase() {
+	c.passphrase.Wipe()
+	c.passphrase = nil
+	c.passphraseVerified = false
+}
+
+// symmetricPrompt returns a PromptFunction that provides the passphrase only once.
+// openpgp.ReadMessage calls the PromptFunction again as long as the passphrase is
+// wrong, the second call returns an error instead of looping forever.
+func (c *Config) symmetricPrompt() openpgp.PromptFunction {
+	called := false
+	return func(keys []openpgp.Key, symmetric bool) ([]byte, error) {
+		if called {
+			c.ForgetPassphrase()
+			return nil, ErrWrongPassphrase
+		}
+		called = true
+		return c.symmetricPassphrase()
+	}
+}
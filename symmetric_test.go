@@ -0,0 +1,227 @@
+package keep
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/openpgp"
+	"golang.org/x/crypto/openpgp/armor"
+	"golang.org/x/crypto/openpgp/packet"
+	"golang.org/x/crypto/openpgp/s2k"
+)
+
+func newSymmetricConfig(t *testing.T, passphrase string) *Config {
+	dir, err := ioutil.TempDir("", "keep-symmetric")
+	if err != nil {
+		t.Fatal("An error occured while creating the account dir", err)
+	}
+	return &Config{
+		Type:           ProfileTypeSymmetric,
+		AccountDir:     dir,
+		PromptFunction: promptFromString(passphrase),
+	}
+}
+
+func Test_Symmetric_RoundTrip(t *testing.T) {
+	c := newSymmetricConfig(t, "correct horse battery staple")
+	defer os.RemoveAll(c.AccountDir)
+
+	a := Account{
+		config:   c,
+		Name:     "symmetric",
+		Username: "u",
+		Password: NewSecret([]byte("p")),
+		Notes:    "n",
+	}
+	crypt, err := a.Encrypt()
+	if err != nil {
+		t.Fatal("An error occured while encrypting the account", err)
+	}
+	err = ioutil.WriteFile(filepath.Join(c.AccountDir, a.Name), crypt, 0600)
+	if err != nil {
+		t.Fatal("An error occured while writing the account", err)
+	}
+
+	// A fresh Config makes sure that the passphrase is requested again.
+	c.passphrase = nil
+	read, err := NewAccountFromFile(c, a.Name)
+	if err != nil {
+		t.Fatal("An error occured while reading the account", err)
+	}
+	if !bytes.Equal(read.Bytes(), a.Bytes()) {
+		t.Errorf("got : %s - expected : %s", read.Bytes(), a.Bytes())
+	}
+
+	wrong := newSymmetricConfig(t, "wrong")
+	defer os.RemoveAll(wrong.AccountDir)
+	wrong.AccountDir = c.AccountDir
+	_, err = NewAccountFromFile(wrong, a.Name)
+	if err != ErrWrongPassphrase {
+		t.Errorf("got : %v - expected : %v", err, ErrWrongPassphrase)
+	}
+}
+
+func Test_Symmetric_ForgetPassphrase(t *testing.T) {
+	c := newSymmetricConfig(t, "correct horse battery staple")
+	defer os.RemoveAll(c.AccountDir)
+	prompts := 0
+	prompt := c.PromptFunction
+	c.PromptFunction = func(keys []openpgp.Key, symmetric bool) ([]byte, error) {
+		prompts++
+		return prompt(keys, symmetric)
+	}
+	a := NewAccount(c, "forget", "jdoe", "s3cr3t-passw0rd", "")
+	err := a.Save()
+	if err != nil {
+		t.Fatal("An error occured while saving the account", err)
+	}
+	// The passphrase of a new vault is confirmed
+	_, err = NewAccountFromFile(c, a.Name)
+	if err != nil || prompts != 2 {
+		t.Fatalf("got : %d prompts, %v - expected : 2 prompts", prompts, err)
+	}
+	passphrase := c.passphrase.Bytes()
+	c.ForgetPassphrase()
+	if !bytes.Equal(passphrase, make([]byte, len(passphrase))) {
+		t.Errorf("got : %q - expected : the passphrase to be wiped", passphrase)
+	}
+	_, err = NewAccountFromFile(c, a.Name)
+	if err != nil || prompts != 3 {
+		t.Errorf("got : %d prompts, %v - expected : 3 prompts", prompts, err)
+	}
+}
+
+func Test_Symmetric_PassphraseTimeout(t *testing.T) {
+	c := newSymmetricConfig(t, "correct horse battery staple")
+	defer os.RemoveAll(c.AccountDir)
+	prompts := 0
+	prompt := c.PromptFunction
+	c.PromptFunction = func(keys []openpgp.Key, symmetric bool) ([]byte, error) {
+		prompts++
+		return prompt(keys, symmetric)
+	}
+	c.PassphraseTimeout = time.Millisecond
+	_, err := c.symmetricPassphrase()
+	if err != nil {
+		t.Fatal("An error occured while requesting the passphrase", err)
+	}
+	time.Sleep(10 * time.Millisecond)
+	_, err = c.symmetricPassphrase()
+	if err != nil || prompts != 2 {
+		t.Errorf("got : %d prompts, %v - expected : the passphrase to be requested again", prompts, err)
+	}
+}
+
+func Test_Symmetric_ConfirmPassphrase(t *testing.T) {
+	c := newSymmetricConfig(t, "correct horse battery staple")
+	defer os.RemoveAll(c.AccountDir)
+	typed := []string{"correct horse battery staple", "correct horse battery stapel"}
+	c.PromptFunction = func(keys []openpgp.Key, symmetric bool) ([]byte, error) {
+		passphrase := typed[0]
+		typed = typed[1:]
+		return []byte(passphrase), nil
+	}
+	a := NewAccount(c, "typo", "jdoe", "s3cr3t-passw0rd", "")
+	if err := a.Save(); err == nil {
+		t.Fatal("Expected a typo in the passphrase of a new vault to be refused")
+	}
+	if _, err := os.Stat(a.Path()); !os.IsNotExist(err) {
+		t.Errorf("Expected no account to be written; got : %v", err)
+	}
+
+	c.PromptFunction = promptFromString("correct horse battery staple")
+	if err := c.VerifyPassphrase(); err != nil {
+		t.Fatal("An error occured while verifying the passphrase", err)
+	}
+	if _, err := os.Stat(filepath.Join(c.AccountDir, PassphraseCheckFile)); err != nil {
+		t.Fatal("Expected the passphrase check file to be written", err)
+	}
+
+	// The passphrase of an existing vault is checked before an account is encrypted
+	wrong := newSymmetricConfig(t, "wrong passphrase")
+	defer os.RemoveAll(wrong.AccountDir)
+	wrong.AccountDir = c.AccountDir
+	a = NewAccount(wrong, "wrong", "jdoe", "s3cr3t-passw0rd", "")
+	if err := a.Save(); err != ErrWrongPassphrase {
+		t.Errorf("got : %v - expected : %v", err, ErrWrongPassphrase)
+	}
+	if files, _ := c.ListAccountFiles(""); len(files) != 0 {
+		t.Errorf("got : %d accounts - expected : none", len(files))
+	}
+}
+
+// encryptWithSessionKey encrypts data with a random session key, itself encrypted with the passphrase,
+// like gpg does when a message is encrypted to keys and to a passphrase.
+func encryptWithSessionKey(t *testing.T, passphrase string, data []byte) []byte {
+	sessionKey := make([]byte, packet.CipherAES128.KeySize())
+	if _, err := rand.Read(sessionKey); err != nil {
+		t.Fatal(err)
+	}
+	spec := bytes.NewBuffer(nil)
+	kek := make([]byte, packet.CipherAES128.KeySize())
+	err := s2k.Serialize(spec, kek, rand.Reader, []byte(passphrase), &s2k.Config{Hash: crypto.SHA256})
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encryptedKey := append([]byte{byte(packet.CipherAES128)}, sessionKey...)
+	cipher.NewCFBEncrypter(block, make([]byte, block.BlockSize())).XORKeyStream(encryptedKey, encryptedKey)
+
+	// The symmetric key encrypted session key packet, tag 3, in the new format
+	body := append([]byte{4, byte(packet.CipherAES128)}, spec.Bytes()...)
+	body = append(body, encryptedKey...)
+	out := bytes.NewBuffer(nil)
+	armored, err := armor.Encode(out, "PGP MESSAGE", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	armored.Write(append([]byte{0xc0 | 3, byte(len(body))}, body...))
+	encrypted, err := packet.SerializeSymmetricallyEncrypted(armored, packet.CipherAES128, sessionKey, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	literal, err := packet.SerializeLiteral(encrypted, true, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	literal.Write(data)
+	literal.Close()
+	armored.Close()
+	return out.Bytes()
+}
+
+func Test_Symmetric_WrongPassphrase_SessionKey(t *testing.T) {
+	msg := encryptWithSessionKey(t, "correct horse battery staple", []byte("p\nu\nn"))
+
+	c := newSymmetricConfig(t, "correct horse battery staple")
+	defer os.RemoveAll(c.AccountDir)
+	md, err := c.decode(bytes.NewReader(msg))
+	if err != nil {
+		t.Fatal("An error occured while decrypting the message", err)
+	}
+	clear, err := ioutil.ReadAll(md.UnverifiedBody)
+	if err != nil || string(clear) != "p\nu\nn" {
+		t.Fatalf("got : %q, %v - expected : the clear text", clear, err)
+	}
+
+	wrong := newSymmetricConfig(t, "wrong")
+	defer os.RemoveAll(wrong.AccountDir)
+	_, err = wrong.decode(bytes.NewReader(msg))
+	if err != ErrWrongPassphrase {
+		t.Errorf("got : %v - expected : %v", err, ErrWrongPassphrase)
+	}
+	if wrong.passphrase != nil {
+		t.Error("Expected the wrong passphrase to be forgotten")
+	}
+}